@@ -27,6 +27,7 @@ const (
 	ChoreoAPIEmbeddedKey  = "api.choreo.kform.dev/embedded"
 	ChoreoAPIInternalKey  = "api.choreo.kform.dev/internal"
 	ChoreoLoaderOriginKey = "api.choreo.kform.dev/origin"
+	ChoreoSnapshotTagKey  = "snapshot.choreo.kform.dev/tag"
 )
 
 func HasChoreoAPIAnnotation(u *unstructured.Unstructured) bool {
@@ -26,6 +26,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	v1alpha1 "github.com/kform-dev/choreo/apis/selector/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -464,10 +465,38 @@ func (m *DiffStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DiffStatus proto.InternalMessageInfo
 
+func (m *ExternalReconciler) Reset()      { *m = ExternalReconciler{} }
+func (*ExternalReconciler) ProtoMessage() {}
+func (*ExternalReconciler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8dc85a43965ce2f, []int{15}
+}
+func (m *ExternalReconciler) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalReconciler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ExternalReconciler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalReconciler.Merge(m, src)
+}
+func (m *ExternalReconciler) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalReconciler) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalReconciler.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalReconciler proto.InternalMessageInfo
+
 func (m *Library) Reset()      { *m = Library{} }
 func (*Library) ProtoMessage() {}
 func (*Library) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{15}
+	return fileDescriptor_a8dc85a43965ce2f, []int{16}
 }
 func (m *Library) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +524,7 @@ var xxx_messageInfo_Library proto.InternalMessageInfo
 func (m *LibraryList) Reset()      { *m = LibraryList{} }
 func (*LibraryList) ProtoMessage() {}
 func (*LibraryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{16}
+	return fileDescriptor_a8dc85a43965ce2f, []int{17}
 }
 func (m *LibraryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +552,7 @@ var xxx_messageInfo_LibraryList proto.InternalMessageInfo
 func (m *LibrarySpec) Reset()      { *m = LibrarySpec{} }
 func (*LibrarySpec) ProtoMessage() {}
 func (*LibrarySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{17}
+	return fileDescriptor_a8dc85a43965ce2f, []int{18}
 }
 func (m *LibrarySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +580,7 @@ var xxx_messageInfo_LibrarySpec proto.InternalMessageInfo
 func (m *LibraryStatus) Reset()      { *m = LibraryStatus{} }
 func (*LibraryStatus) ProtoMessage() {}
 func (*LibraryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{18}
+	return fileDescriptor_a8dc85a43965ce2f, []int{19}
 }
 func (m *LibraryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,7 +608,7 @@ var xxx_messageInfo_LibraryStatus proto.InternalMessageInfo
 func (m *LoaderAnnotation) Reset()      { *m = LoaderAnnotation{} }
 func (*LoaderAnnotation) ProtoMessage() {}
 func (*LoaderAnnotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{19}
+	return fileDescriptor_a8dc85a43965ce2f, []int{20}
 }
 func (m *LoaderAnnotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +636,7 @@ var xxx_messageInfo_LoaderAnnotation proto.InternalMessageInfo
 func (m *Reconciler) Reset()      { *m = Reconciler{} }
 func (*Reconciler) ProtoMessage() {}
 func (*Reconciler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{20}
+	return fileDescriptor_a8dc85a43965ce2f, []int{21}
 }
 func (m *Reconciler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +664,7 @@ var xxx_messageInfo_Reconciler proto.InternalMessageInfo
 func (m *ReconcilerList) Reset()      { *m = ReconcilerList{} }
 func (*ReconcilerList) ProtoMessage() {}
 func (*ReconcilerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{21}
+	return fileDescriptor_a8dc85a43965ce2f, []int{22}
 }
 func (m *ReconcilerList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +692,7 @@ var xxx_messageInfo_ReconcilerList proto.InternalMessageInfo
 func (m *ReconcilerResource) Reset()      { *m = ReconcilerResource{} }
 func (*ReconcilerResource) ProtoMessage() {}
 func (*ReconcilerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{22}
+	return fileDescriptor_a8dc85a43965ce2f, []int{23}
 }
 func (m *ReconcilerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +720,7 @@ var xxx_messageInfo_ReconcilerResource proto.InternalMessageInfo
 func (m *ReconcilerSpec) Reset()      { *m = ReconcilerSpec{} }
 func (*ReconcilerSpec) ProtoMessage() {}
 func (*ReconcilerSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{23}
+	return fileDescriptor_a8dc85a43965ce2f, []int{24}
 }
 func (m *ReconcilerSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +748,7 @@ var xxx_messageInfo_ReconcilerSpec proto.InternalMessageInfo
 func (m *ReconcilerStatus) Reset()      { *m = ReconcilerStatus{} }
 func (*ReconcilerStatus) ProtoMessage() {}
 func (*ReconcilerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{24}
+	return fileDescriptor_a8dc85a43965ce2f, []int{25}
 }
 func (m *ReconcilerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +776,7 @@ var xxx_messageInfo_ReconcilerStatus proto.InternalMessageInfo
 func (m *ResourceGVK) Reset()      { *m = ResourceGVK{} }
 func (*ResourceGVK) ProtoMessage() {}
 func (*ResourceGVK) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{25}
+	return fileDescriptor_a8dc85a43965ce2f, []int{26}
 }
 func (m *ResourceGVK) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +804,7 @@ var xxx_messageInfo_ResourceGVK proto.InternalMessageInfo
 func (m *Snapshot) Reset()      { *m = Snapshot{} }
 func (*Snapshot) ProtoMessage() {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{26}
+	return fileDescriptor_a8dc85a43965ce2f, []int{27}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +832,7 @@ var xxx_messageInfo_Snapshot proto.InternalMessageInfo
 func (m *SnapshotList) Reset()      { *m = SnapshotList{} }
 func (*SnapshotList) ProtoMessage() {}
 func (*SnapshotList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{27}
+	return fileDescriptor_a8dc85a43965ce2f, []int{28}
 }
 func (m *SnapshotList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +860,7 @@ var xxx_messageInfo_SnapshotList proto.InternalMessageInfo
 func (m *SnapshotSpec) Reset()      { *m = SnapshotSpec{} }
 func (*SnapshotSpec) ProtoMessage() {}
 func (*SnapshotSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{28}
+	return fileDescriptor_a8dc85a43965ce2f, []int{29}
 }
 func (m *SnapshotSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +888,7 @@ var xxx_messageInfo_SnapshotSpec proto.InternalMessageInfo
 func (m *SnapshotStatus) Reset()      { *m = SnapshotStatus{} }
 func (*SnapshotStatus) ProtoMessage() {}
 func (*SnapshotStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{29}
+	return fileDescriptor_a8dc85a43965ce2f, []int{30}
 }
 func (m *SnapshotStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -887,7 +916,7 @@ var xxx_messageInfo_SnapshotStatus proto.InternalMessageInfo
 func (m *UpstreamRef) Reset()      { *m = UpstreamRef{} }
 func (*UpstreamRef) ProtoMessage() {}
 func (*UpstreamRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{30}
+	return fileDescriptor_a8dc85a43965ce2f, []int{31}
 }
 func (m *UpstreamRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -915,7 +944,7 @@ var xxx_messageInfo_UpstreamRef proto.InternalMessageInfo
 func (m *UpstreamRefSpec) Reset()      { *m = UpstreamRefSpec{} }
 func (*UpstreamRefSpec) ProtoMessage() {}
 func (*UpstreamRefSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{31}
+	return fileDescriptor_a8dc85a43965ce2f, []int{32}
 }
 func (m *UpstreamRefSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,7 +972,7 @@ var xxx_messageInfo_UpstreamRefSpec proto.InternalMessageInfo
 func (m *UpstreamReference) Reset()      { *m = UpstreamReference{} }
 func (*UpstreamReference) ProtoMessage() {}
 func (*UpstreamReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8dc85a43965ce2f, []int{32}
+	return fileDescriptor_a8dc85a43965ce2f, []int{33}
 }
 func (m *UpstreamReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,6 +1014,7 @@ func init() {
 	proto.RegisterType((*DiffList)(nil), "github.com.kform_dev.choreo.apis.choreo.v1alpha1.DiffList")
 	proto.RegisterType((*DiffSpec)(nil), "github.com.kform_dev.choreo.apis.choreo.v1alpha1.DiffSpec")
 	proto.RegisterType((*DiffStatus)(nil), "github.com.kform_dev.choreo.apis.choreo.v1alpha1.DiffStatus")
+	proto.RegisterType((*ExternalReconciler)(nil), "github.com.kform_dev.choreo.apis.choreo.v1alpha1.ExternalReconciler")
 	proto.RegisterType((*Library)(nil), "github.com.kform_dev.choreo.apis.choreo.v1alpha1.Library")
 	proto.RegisterType((*LibraryList)(nil), "github.com.kform_dev.choreo.apis.choreo.v1alpha1.LibraryList")
 	proto.RegisterType((*LibrarySpec)(nil), "github.com.kform_dev.choreo.apis.choreo.v1alpha1.LibrarySpec")
@@ -1011,113 +1041,120 @@ func init() {
 }
 
 var fileDescriptor_a8dc85a43965ce2f = []byte{
-	// 1687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0xf2, 0x43, 0xa4, 0x1e, 0xf5, 0x41, 0xaf, 0xdb, 0x9a, 0x15, 0x5a, 0x52, 0xd8, 0x02,
-	0x85, 0x0a, 0xdb, 0x4b, 0x5b, 0x6d, 0x6d, 0x55, 0x70, 0x5b, 0x9b, 0x92, 0x6d, 0xb8, 0x96, 0x6b,
-	0x75, 0x64, 0xbb, 0x40, 0xe2, 0xc4, 0x5e, 0xed, 0x0e, 0xc9, 0x35, 0xc9, 0x9d, 0xc5, 0xec, 0x92,
-	0x0a, 0x73, 0x89, 0x73, 0xc8, 0x35, 0xc8, 0x2d, 0x7f, 0x40, 0x90, 0x5b, 0xae, 0x01, 0x72, 0xca,
-	0x29, 0x01, 0x9c, 0x04, 0x06, 0x1c, 0x20, 0x08, 0x0c, 0x04, 0x20, 0x62, 0xe6, 0x7f, 0xc8, 0xc1,
-	0xa7, 0x60, 0x66, 0xbf, 0x57, 0x5e, 0x59, 0x24, 0x1d, 0xde, 0xb8, 0x6f, 0x7e, 0xf3, 0xde, 0x9b,
-	0xf7, 0x3d, 0x43, 0xb8, 0xd8, 0xd0, 0xed, 0x66, 0x77, 0x4f, 0x56, 0x49, 0xa7, 0xda, 0xaa, 0x13,
-	0xda, 0x39, 0xad, 0xe1, 0x5e, 0x55, 0x6d, 0x12, 0x8a, 0x49, 0x55, 0x31, 0x75, 0xcb, 0xfb, 0xdd,
-	0x3b, 0xab, 0xb4, 0xcd, 0xa6, 0x72, 0xb6, 0xda, 0xc0, 0x06, 0xa6, 0x8a, 0x8d, 0x35, 0xd9, 0xa4,
-	0xc4, 0x26, 0xe2, 0x99, 0x80, 0x83, 0xcc, 0x39, 0xdc, 0xd3, 0x70, 0x4f, 0x76, 0x76, 0xc9, 0x8c,
-	0x83, 0xf7, 0xdb, 0xe3, 0xb0, 0x7c, 0x3a, 0x24, 0xb3, 0x41, 0x1a, 0xa4, 0xca, 0x19, 0xed, 0x75,
-	0xeb, 0xfc, 0x8b, 0x7f, 0xf0, 0x5f, 0x8e, 0x80, 0xe5, 0xda, 0x4b, 0x55, 0xb4, 0x70, 0x1b, 0xab,
-	0x36, 0xa1, 0x89, 0x4a, 0x2e, 0xff, 0xad, 0xb5, 0x6e, 0xc9, 0x3a, 0x87, 0x77, 0x14, 0xb5, 0xa9,
-	0x1b, 0x98, 0xf6, 0xab, 0x66, 0xab, 0xe1, 0xec, 0xef, 0x60, 0x5b, 0xa9, 0xf6, 0x0e, 0xee, 0x3a,
-	0x97, 0xb4, 0x8b, 0x76, 0x0d, 0x5b, 0xef, 0xe0, 0xaa, 0xa5, 0x36, 0x71, 0x47, 0x89, 0xef, 0x93,
-	0xbe, 0x48, 0x41, 0xf1, 0xd2, 0xce, 0x35, 0x84, 0x2d, 0xd2, 0xa5, 0x2a, 0xbe, 0x4a, 0x49, 0xd7,
-	0x14, 0x4f, 0x41, 0x9e, 0xba, 0x84, 0x92, 0xb0, 0x22, 0xac, 0xce, 0xd5, 0x8a, 0x8f, 0x06, 0x95,
-	0x99, 0xe1, 0xa0, 0x92, 0xf7, 0x80, 0xc8, 0x47, 0x88, 0x7f, 0x82, 0x6c, 0x83, 0x6d, 0x2b, 0xa5,
-	0x38, 0x74, 0xc1, 0x85, 0x66, 0x39, 0x2f, 0xe4, 0xac, 0x89, 0x7f, 0x81, 0x5c, 0x0f, 0x53, 0x4b,
-	0x27, 0x46, 0x29, 0xcd, 0x61, 0x4b, 0x2e, 0x2c, 0x77, 0xc7, 0x21, 0x23, 0x6f, 0x5d, 0x5c, 0x81,
-	0x4c, 0x4b, 0x37, 0xb4, 0x52, 0x86, 0xe3, 0xe6, 0x5d, 0x5c, 0xe6, 0xba, 0x6e, 0x68, 0x88, 0xaf,
-	0x30, 0xfd, 0xda, 0xba, 0x65, 0x33, 0x4a, 0x29, 0x1b, 0xd5, 0x6f, 0xdb, 0xa5, 0x23, 0x1f, 0x21,
-	0xae, 0x01, 0x18, 0x4a, 0x07, 0x5b, 0xa6, 0xa2, 0x62, 0xad, 0x34, 0xbb, 0x22, 0xac, 0xe6, 0x6b,
-	0xa2, 0x8b, 0x87, 0xff, 0xfa, 0x2b, 0x28, 0x84, 0x12, 0x65, 0x00, 0x55, 0xb1, 0x71, 0x83, 0x50,
-	0x1d, 0x5b, 0xa5, 0xdc, 0x4a, 0x7a, 0x75, 0xae, 0xb6, 0xc8, 0xf0, 0x9b, 0x3e, 0x15, 0x85, 0x10,
-	0xd2, 0xf7, 0x02, 0xcc, 0x87, 0xcc, 0x68, 0x89, 0xf7, 0x21, 0xcf, 0x5c, 0xa5, 0x29, 0xb6, 0xc2,
-	0x4d, 0x58, 0x58, 0x3b, 0x23, 0x3b, 0x2e, 0x92, 0xc3, 0x2e, 0x92, 0xcd, 0x56, 0xc3, 0x89, 0x3c,
-	0x86, 0x96, 0x7b, 0x67, 0xe5, 0x9b, 0x7b, 0x0f, 0xb0, 0x6a, 0xdf, 0xc0, 0xb6, 0x12, 0x28, 0x19,
-	0xd0, 0x90, 0xcf, 0x55, 0xd4, 0x20, 0x63, 0x99, 0x58, 0xe5, 0x56, 0x2f, 0xac, 0xd5, 0xe4, 0x51,
-	0x63, 0x5b, 0x0e, 0xeb, 0xbb, 0x6b, 0x62, 0x35, 0x30, 0x35, 0xfb, 0x42, 0x9c, 0xbb, 0xf4, 0x76,
-	0x24, 0x3c, 0x38, 0x4e, 0xac, 0xc3, 0x2c, 0x77, 0xaa, 0x55, 0x12, 0x56, 0xd2, 0x13, 0xcb, 0xe6,
-	0x61, 0x52, 0x83, 0xe1, 0xa0, 0x32, 0xcb, 0x7f, 0x5a, 0xc8, 0xe5, 0x2e, 0x3d, 0x80, 0xd9, 0x1a,
-	0x55, 0x0c, 0xb5, 0xf9, 0xeb, 0x5b, 0x53, 0xfa, 0x4a, 0x00, 0x70, 0x84, 0xb1, 0x08, 0x12, 0xef,
-	0x1e, 0x10, 0x28, 0x1f, 0x4d, 0x20, 0xdb, 0xcd, 0xc5, 0x45, 0x22, 0x32, 0xe6, 0xba, 0x37, 0x20,
-	0xab, 0xdb, 0xb8, 0x63, 0x95, 0x52, 0xdc, 0x7e, 0xeb, 0xa3, 0xdb, 0xcf, 0x51, 0x35, 0xc8, 0xb5,
-	0x6b, 0x8c, 0x1d, 0x72, 0xb8, 0x4a, 0x8f, 0x53, 0xb0, 0xb4, 0x49, 0x8c, 0xba, 0xde, 0xb8, 0xea,
-	0x64, 0x3b, 0xa1, 0x53, 0x88, 0xc7, 0x46, 0x24, 0x1e, 0x2f, 0x8f, 0x7e, 0xa6, 0x98, 0xca, 0x49,
-	0x21, 0x29, 0x12, 0x98, 0xb5, 0x6c, 0xc5, 0xee, 0x5a, 0xbc, 0x92, 0x14, 0xd6, 0xae, 0x4e, 0x2e,
-	0x8a, 0xb3, 0xab, 0x2d, 0xba, 0xc2, 0x66, 0x9d, 0x6f, 0xe4, 0x8a, 0x91, 0x7e, 0x10, 0xe0, 0x78,
-	0x6c, 0xc7, 0x14, 0x82, 0xa4, 0x1e, 0x0d, 0x92, 0x4b, 0x13, 0x9f, 0x32, 0x21, 0x5a, 0x1e, 0xa6,
-	0xa1, 0x12, 0x43, 0xee, 0x50, 0xd2, 0xd3, 0x35, 0x4c, 0x77, 0xdd, 0x66, 0x25, 0x1a, 0xb1, 0x86,
-	0x50, 0x58, 0xfb, 0xe7, 0xe8, 0xea, 0xf8, 0x09, 0x7f, 0xe7, 0x7a, 0xed, 0xb8, 0xab, 0x4a, 0x21,
-	0x44, 0x0c, 0xb5, 0x94, 0x77, 0x05, 0xc8, 0x76, 0x14, 0x5b, 0x6d, 0xba, 0x87, 0xbf, 0x3b, 0xf1,
-	0xe1, 0xe3, 0x47, 0x92, 0x6f, 0x30, 0xf6, 0x97, 0x0d, 0x9b, 0xf6, 0x03, 0xbb, 0x70, 0x1a, 0x72,
-	0x24, 0x8b, 0x55, 0x98, 0xab, 0xeb, 0xb8, 0xad, 0xed, 0x28, 0x76, 0xd3, 0xed, 0x59, 0xc7, 0x5c,
-	0xe0, 0xdc, 0x15, 0x6f, 0x01, 0x05, 0x98, 0xe5, 0x75, 0x80, 0x80, 0xa9, 0x58, 0x84, 0x74, 0x0b,
-	0xf7, 0x9d, 0xf6, 0x89, 0xd8, 0x4f, 0xf1, 0x37, 0x90, 0xed, 0x29, 0xed, 0x2e, 0x76, 0xfa, 0x24,
-	0x72, 0x3e, 0x36, 0x52, 0xeb, 0x82, 0xf4, 0xf5, 0xc1, 0x00, 0xe3, 0x85, 0xf6, 0x43, 0x01, 0x8a,
-	0x66, 0x4c, 0x71, 0xd7, 0xfe, 0xff, 0x7b, 0xe5, 0x16, 0xa9, 0x95, 0xdc, 0xd3, 0x15, 0xe3, 0x2b,
-	0xe8, 0x80, 0x12, 0xe2, 0xef, 0x21, 0xad, 0xe9, 0xd4, 0xed, 0xf8, 0xb9, 0xe1, 0xa0, 0x92, 0xde,
-	0xd2, 0x29, 0x62, 0x34, 0xe9, 0x04, 0xfc, 0xf6, 0x85, 0xe9, 0x25, 0x7d, 0x92, 0x82, 0xcc, 0x96,
-	0x5e, 0xaf, 0x4f, 0xa1, 0x16, 0xdd, 0x8d, 0xd4, 0xa2, 0x8d, 0xd1, 0x6d, 0xc5, 0xf4, 0x4c, 0x2c,
-	0x40, 0x5a, 0xac, 0x00, 0x5d, 0x18, 0x93, 0xff, 0xe1, 0x55, 0xe7, 0xe3, 0x14, 0xe4, 0x19, 0x8c,
-	0x25, 0xeb, 0xd4, 0x13, 0x70, 0x05, 0x32, 0x6c, 0x1a, 0x72, 0x1d, 0xec, 0x1b, 0x81, 0x4d, 0x4b,
-	0x88, 0xaf, 0xb0, 0xf4, 0xf0, 0xe7, 0xa5, 0x78, 0x7a, 0xf8, 0x43, 0x15, 0x0a, 0x30, 0xe2, 0xba,
-	0x6f, 0x35, 0x67, 0xb0, 0x5b, 0x89, 0x9e, 0xfb, 0xf9, 0xa0, 0xb2, 0xc8, 0x8e, 0xcb, 0x8a, 0x52,
-	0xd4, 0x12, 0xe2, 0x1f, 0x20, 0xa3, 0xe9, 0xf5, 0xba, 0x3b, 0xea, 0xe5, 0x99, 0x22, 0x0c, 0x89,
-	0x38, 0x55, 0xfa, 0x52, 0x70, 0xec, 0x34, 0x85, 0x92, 0xfc, 0x7a, 0xb4, 0x24, 0x9f, 0x1b, 0xcf,
-	0xef, 0x09, 0x75, 0x18, 0x9c, 0x63, 0xb0, 0x38, 0x93, 0x74, 0x80, 0x20, 0x42, 0x02, 0xb1, 0xce,
-	0xb8, 0x35, 0x66, 0x38, 0x33, 0x61, 0xb5, 0xb9, 0x03, 0x62, 0x3f, 0x4d, 0x41, 0x6e, 0x5b, 0xdf,
-	0xa3, 0x0a, 0xed, 0x4f, 0x21, 0x31, 0xef, 0x45, 0x12, 0x73, 0x8c, 0x18, 0x76, 0x55, 0x4d, 0xcc,
-	0xcd, 0x46, 0x2c, 0x37, 0xff, 0x3d, 0xbe, 0x88, 0xc3, 0xd3, 0xf3, 0x1b, 0x01, 0x0a, 0x2e, 0x72,
-	0x0a, 0x91, 0xf7, 0x66, 0x34, 0xf2, 0xfe, 0x31, 0xf6, 0xa9, 0x12, 0x82, 0xaf, 0xe5, 0x1f, 0x86,
-	0x37, 0x9e, 0x0d, 0xc8, 0xd8, 0x7d, 0xd3, 0xbb, 0xfc, 0xfd, 0xd9, 0xb3, 0xf3, 0xad, 0xbe, 0x89,
-	0x9f, 0x0f, 0x2a, 0xbf, 0xdb, 0x25, 0x75, 0x7b, 0x5f, 0xa1, 0xda, 0x2d, 0xac, 0x36, 0x0d, 0xd2,
-	0x26, 0x8d, 0x3e, 0x5b, 0x41, 0x7c, 0x0f, 0x2b, 0x1d, 0x2a, 0xd1, 0x0e, 0x94, 0x8e, 0x4d, 0xa2,
-	0x61, 0xc4, 0x57, 0xa4, 0x25, 0x58, 0x88, 0xd8, 0x58, 0xfa, 0x48, 0x80, 0xe2, 0x36, 0x51, 0x34,
-	0x4c, 0x2f, 0x19, 0x06, 0xb1, 0x15, 0x3b, 0x7c, 0x0d, 0x14, 0x12, 0xaf, 0x81, 0x7f, 0x84, 0x74,
-	0x97, 0xb6, 0x5d, 0x41, 0x05, 0x17, 0x90, 0xbe, 0x8d, 0xb6, 0x11, 0xa3, 0xb3, 0x0a, 0xa5, 0xe9,
-	0x94, 0xf7, 0xab, 0x7e, 0xbc, 0x42, 0x6d, 0x79, 0x0b, 0x28, 0xc0, 0x30, 0x7e, 0x14, 0xd7, 0xdd,
-	0xf2, 0xe4, 0xf3, 0x43, 0xb8, 0x8e, 0x18, 0x5d, 0xfa, 0x3c, 0x05, 0x80, 0xb0, 0x4a, 0x0c, 0x55,
-	0x6f, 0xe3, 0x69, 0x4c, 0xd4, 0x7b, 0x91, 0x64, 0xb9, 0x38, 0x4e, 0xc1, 0xf7, 0xb4, 0x4d, 0xcc,
-	0x97, 0x07, 0xb1, 0x7c, 0xa9, 0x4d, 0x24, 0xe5, 0xf0, 0x94, 0xf9, 0x56, 0x80, 0xc5, 0x00, 0x3c,
-	0x85, 0xac, 0x51, 0xa2, 0x59, 0x73, 0x61, 0x92, 0xb3, 0x25, 0x24, 0xce, 0xcf, 0x02, 0x88, 0x01,
-	0xc8, 0x6b, 0xa6, 0x53, 0xef, 0xd7, 0x26, 0xe4, 0xbd, 0x97, 0x25, 0x37, 0x5c, 0xae, 0xbc, 0x5c,
-	0x9e, 0xb7, 0x23, 0x90, 0x78, 0xf9, 0x2d, 0x93, 0x62, 0xcb, 0xd2, 0x89, 0xe1, 0x4f, 0x85, 0xf3,
-	0xcc, 0xb6, 0xfe, 0x24, 0xe8, 0x4b, 0x91, 0xde, 0xcf, 0x86, 0x9d, 0xc9, 0xab, 0xc6, 0x79, 0x58,
-	0x50, 0x89, 0xa1, 0xe9, 0x2c, 0x7d, 0x6f, 0x05, 0xe5, 0xe3, 0xd8, 0x70, 0x50, 0x59, 0xd8, 0x0c,
-	0x2f, 0xa0, 0x28, 0x4e, 0x94, 0x01, 0x58, 0x30, 0xde, 0x36, 0x35, 0xc5, 0x76, 0x0a, 0x47, 0xde,
-	0x79, 0x6d, 0xd9, 0xf5, 0xa9, 0x28, 0x84, 0x10, 0x55, 0x48, 0xd7, 0x09, 0x75, 0x23, 0x76, 0x6b,
-	0x12, 0xaf, 0x7a, 0xd6, 0x0c, 0xd2, 0xfd, 0x0a, 0xa1, 0x88, 0x71, 0x67, 0xd9, 0x47, 0xf6, 0x0d,
-	0x36, 0xad, 0xa4, 0x5f, 0x99, 0x14, 0x3e, 0xbb, 0xdc, 0xdc, 0x37, 0x2c, 0xc4, 0x79, 0x8b, 0x2d,
-	0xc8, 0xed, 0xb3, 0x2b, 0x03, 0xb6, 0x4a, 0xd9, 0x57, 0x28, 0xa6, 0x30, 0x1c, 0x54, 0x72, 0xff,
-	0x77, 0x18, 0x23, 0x4f, 0x82, 0x78, 0xce, 0x2d, 0xea, 0xb3, 0xdc, 0x2b, 0xd2, 0x91, 0x0b, 0x7a,
-	0xdb, 0x2d, 0xe8, 0x39, 0xae, 0xe1, 0x7f, 0x26, 0x2d, 0x43, 0x32, 0xeb, 0x04, 0xce, 0xc5, 0xeb,
-	0x05, 0xcd, 0x61, 0xf9, 0x3c, 0xcc, 0xf9, 0x80, 0x91, 0x2e, 0x51, 0x22, 0x14, 0xe3, 0x95, 0x48,
-	0x7a, 0x07, 0xc2, 0xf9, 0x12, 0xbc, 0x54, 0x0a, 0x47, 0x7b, 0xa9, 0x4c, 0x1d, 0xf1, 0xa5, 0x32,
-	0x9d, 0xd4, 0xa2, 0xa4, 0xcf, 0x52, 0x90, 0xdf, 0x35, 0x14, 0xd3, 0x6a, 0x12, 0x7b, 0x0a, 0x1d,
-	0xe3, 0x7e, 0xa4, 0x63, 0xfc, 0x6b, 0x74, 0x57, 0x79, 0xba, 0x26, 0xf6, 0x8b, 0x66, 0xac, 0x5f,
-	0x5c, 0x9c, 0x40, 0xc6, 0xe1, 0xdd, 0xe2, 0xb1, 0x00, 0xf3, 0x1e, 0x74, 0x0a, 0xbd, 0xe2, 0x5e,
-	0xb4, 0x57, 0x6c, 0x8c, 0x7f, 0xae, 0x84, 0x4e, 0xb1, 0x18, 0x1c, 0x87, 0xcf, 0xf8, 0x45, 0x58,
-	0x8c, 0x5a, 0x42, 0xfa, 0x4e, 0x80, 0xc2, 0x6d, 0xd3, 0xb2, 0x29, 0x56, 0x3a, 0x08, 0x4f, 0xe3,
-	0x9e, 0xac, 0x46, 0xe2, 0x65, 0x8c, 0x27, 0xa6, 0x90, 0xba, 0x89, 0x4f, 0xc8, 0xef, 0xa5, 0x61,
-	0x29, 0x86, 0x13, 0xff, 0x1a, 0x19, 0x30, 0x2b, 0xb1, 0x01, 0x33, 0x0c, 0x0f, 0x15, 0xa2, 0x53,
-	0x90, 0x37, 0xa9, 0x4e, 0xa8, 0x6e, 0xf7, 0xb9, 0xc6, 0xe9, 0xc0, 0xa1, 0x3b, 0x2e, 0x1d, 0xf9,
-	0x08, 0x6f, 0x3a, 0x4c, 0x27, 0x4c, 0x87, 0x27, 0xc3, 0xd3, 0xa1, 0x33, 0xf2, 0x2d, 0x24, 0x4e,
-	0x86, 0x7b, 0xce, 0x64, 0x98, 0xe5, 0x66, 0xda, 0x9c, 0xc8, 0x4c, 0x98, 0x62, 0x23, 0xdc, 0x6f,
-	0xbc, 0xf1, 0x52, 0xfc, 0x3b, 0x14, 0x54, 0x8a, 0x35, 0x6c, 0xd8, 0xba, 0xd2, 0xb6, 0xdc, 0x2a,
-	0xed, 0xb7, 0xfd, 0xcd, 0x60, 0x09, 0x85, 0x71, 0xe2, 0x2a, 0xe4, 0x75, 0x43, 0x6d, 0x77, 0x35,
-	0xff, 0x7f, 0x0a, 0xde, 0xb1, 0xaf, 0xb9, 0x34, 0xe4, 0xaf, 0x4a, 0x7b, 0x70, 0xec, 0x80, 0x1e,
-	0xe2, 0xc9, 0x88, 0x23, 0x4e, 0xc4, 0x1c, 0x91, 0x8b, 0x3a, 0xe0, 0xa5, 0xaf, 0x02, 0xb5, 0x3b,
-	0x8f, 0x9e, 0x95, 0x67, 0x9e, 0x3c, 0x2b, 0xcf, 0x3c, 0x7d, 0x56, 0x9e, 0x79, 0x38, 0x2c, 0x0b,
-	0x8f, 0x86, 0x65, 0xe1, 0xc9, 0xb0, 0x2c, 0x3c, 0x1d, 0x96, 0x85, 0x1f, 0x87, 0x65, 0xe1, 0x83,
-	0x9f, 0xca, 0x33, 0xaf, 0x9d, 0x19, 0xf5, 0x9f, 0xbc, 0x5f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x88,
-	0xb4, 0x89, 0xe6, 0xfc, 0x1b, 0x00, 0x00,
+	// 1795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x8f, 0x1b, 0x49,
+	0x15, 0x9f, 0xb6, 0x3d, 0x63, 0xcf, 0x73, 0x66, 0xe2, 0xd4, 0x02, 0xdb, 0x8c, 0xc0, 0x1e, 0x35,
+	0x02, 0x0d, 0xda, 0xdd, 0x76, 0x32, 0x40, 0x76, 0x88, 0x16, 0x48, 0x3c, 0x93, 0x44, 0x61, 0x13,
+	0x36, 0xd4, 0x24, 0x41, 0x82, 0x40, 0xb6, 0xa7, 0xbb, 0x6c, 0x77, 0xc6, 0xee, 0x6a, 0x55, 0xb7,
+	0x27, 0x31, 0x17, 0x96, 0x03, 0x77, 0x6e, 0xfc, 0x01, 0x88, 0x1b, 0x57, 0x24, 0x4e, 0x9c, 0x40,
+	0x0a, 0xa0, 0x95, 0x16, 0x09, 0xa1, 0x95, 0x90, 0x46, 0xc4, 0xfc, 0x0f, 0x1c, 0xf6, 0xc2, 0xaa,
+	0x3e, 0xfa, 0xd3, 0xdb, 0x49, 0x6c, 0x27, 0xbe, 0xb9, 0xdf, 0xfb, 0xd5, 0x7b, 0xaf, 0x5e, 0xbd,
+	0xaf, 0x2a, 0xc3, 0xe5, 0x9e, 0x1b, 0xf6, 0x47, 0x47, 0xa6, 0x4d, 0x87, 0xed, 0xe3, 0x2e, 0x65,
+	0xc3, 0xb7, 0x1c, 0x72, 0xd2, 0xb6, 0xfb, 0x94, 0x11, 0xda, 0xb6, 0x7c, 0x37, 0x88, 0x7e, 0x9f,
+	0x5c, 0xb0, 0x06, 0x7e, 0xdf, 0xba, 0xd0, 0xee, 0x11, 0x8f, 0x30, 0x2b, 0x24, 0x8e, 0xe9, 0x33,
+	0x1a, 0x52, 0x74, 0x3e, 0x91, 0x60, 0x0a, 0x09, 0x0f, 0x1c, 0x72, 0x62, 0xca, 0x55, 0x26, 0x97,
+	0x10, 0xfd, 0x8e, 0x24, 0x6c, 0xbd, 0x95, 0xd2, 0xd9, 0xa3, 0x3d, 0xda, 0x16, 0x82, 0x8e, 0x46,
+	0x5d, 0xf1, 0x25, 0x3e, 0xc4, 0x2f, 0xa9, 0x60, 0xab, 0xf3, 0x5c, 0x13, 0x03, 0x32, 0x20, 0x76,
+	0x48, 0x59, 0xa1, 0x91, 0x5b, 0xdf, 0x3c, 0xde, 0x0b, 0x4c, 0x57, 0xc0, 0x87, 0x96, 0xdd, 0x77,
+	0x3d, 0xc2, 0xc6, 0x6d, 0xff, 0xb8, 0x27, 0xd7, 0x0f, 0x49, 0x68, 0xb5, 0x4f, 0xa6, 0x57, 0x5d,
+	0x2c, 0x5a, 0xc5, 0x46, 0x5e, 0xe8, 0x0e, 0x49, 0x3b, 0xb0, 0xfb, 0x64, 0x68, 0xe5, 0xd7, 0x19,
+	0x7f, 0x2e, 0x41, 0xe3, 0xca, 0xed, 0x1b, 0x98, 0x04, 0x74, 0xc4, 0x6c, 0x72, 0x9d, 0xd1, 0x91,
+	0x8f, 0xde, 0x84, 0x1a, 0x53, 0x04, 0x5d, 0xdb, 0xd6, 0x76, 0xd6, 0x3b, 0x8d, 0x27, 0xa7, 0xad,
+	0x95, 0xc9, 0x69, 0xab, 0x16, 0x01, 0x71, 0x8c, 0x40, 0x5f, 0x81, 0xd5, 0x1e, 0x5f, 0xa6, 0x97,
+	0x04, 0x74, 0x43, 0x41, 0x57, 0x85, 0x2c, 0x2c, 0x79, 0xe8, 0xeb, 0x50, 0x3d, 0x21, 0x2c, 0x70,
+	0xa9, 0xa7, 0x97, 0x05, 0xec, 0xac, 0x82, 0x55, 0xef, 0x49, 0x32, 0x8e, 0xf8, 0x68, 0x1b, 0x2a,
+	0xc7, 0xae, 0xe7, 0xe8, 0x15, 0x81, 0x3b, 0xa3, 0x70, 0x95, 0x77, 0x5d, 0xcf, 0xc1, 0x82, 0xc3,
+	0xed, 0x1b, 0xb8, 0x41, 0xc8, 0x29, 0xfa, 0x6a, 0xd6, 0xbe, 0x9b, 0x8a, 0x8e, 0x63, 0x04, 0xda,
+	0x05, 0xf0, 0xac, 0x21, 0x09, 0x7c, 0xcb, 0x26, 0x8e, 0xbe, 0xb6, 0xad, 0xed, 0xd4, 0x3a, 0x48,
+	0xe1, 0xe1, 0x07, 0x31, 0x07, 0xa7, 0x50, 0xc8, 0x04, 0xb0, 0xad, 0x90, 0xf4, 0x28, 0x73, 0x49,
+	0xa0, 0x57, 0xb7, 0xcb, 0x3b, 0xeb, 0x9d, 0x4d, 0x8e, 0xdf, 0x8f, 0xa9, 0x38, 0x85, 0x30, 0xfe,
+	0xa5, 0xc1, 0x99, 0x94, 0x1b, 0x03, 0xf4, 0x3e, 0xd4, 0xf8, 0x51, 0x39, 0x56, 0x68, 0x09, 0x17,
+	0xd6, 0x77, 0xcf, 0x9b, 0xf2, 0x88, 0xcc, 0xf4, 0x11, 0x99, 0xfe, 0x71, 0x4f, 0x46, 0x1e, 0x47,
+	0x9b, 0x27, 0x17, 0xcc, 0xf7, 0x8e, 0x1e, 0x12, 0x3b, 0xbc, 0x45, 0x42, 0x2b, 0x31, 0x32, 0xa1,
+	0xe1, 0x58, 0x2a, 0x72, 0xa0, 0x12, 0xf8, 0xc4, 0x16, 0x5e, 0xaf, 0xef, 0x76, 0xcc, 0x59, 0x63,
+	0xdb, 0x4c, 0xdb, 0x7b, 0xe8, 0x13, 0x3b, 0x71, 0x35, 0xff, 0xc2, 0x42, 0xba, 0xf1, 0x73, 0x68,
+	0xe4, 0x71, 0xa8, 0x0b, 0x6b, 0xe2, 0x50, 0x03, 0x5d, 0xdb, 0x2e, 0x2f, 0xac, 0x5b, 0x84, 0x49,
+	0x07, 0x26, 0xa7, 0xad, 0x35, 0xf1, 0x33, 0xc0, 0x4a, 0xba, 0xf1, 0x10, 0xd6, 0x3a, 0xcc, 0xf2,
+	0xec, 0xfe, 0xab, 0xf7, 0xa6, 0xf1, 0x57, 0x0d, 0x40, 0x2a, 0xe3, 0x11, 0x84, 0xee, 0x4f, 0x29,
+	0x34, 0x5f, 0x4c, 0x21, 0x5f, 0x2d, 0xd4, 0x65, 0x22, 0x32, 0x77, 0x74, 0x3f, 0x85, 0x55, 0x37,
+	0x24, 0xc3, 0x40, 0x2f, 0x09, 0xff, 0xed, 0xcd, 0xee, 0x3f, 0x69, 0x6a, 0x92, 0x6b, 0x37, 0xb8,
+	0x38, 0x2c, 0xa5, 0x1a, 0x1f, 0x96, 0xe0, 0xec, 0x3e, 0xf5, 0xba, 0x6e, 0xef, 0xba, 0xcc, 0x76,
+	0xca, 0x96, 0x10, 0x8f, 0xbd, 0x4c, 0x3c, 0x5e, 0x9d, 0x7d, 0x4f, 0x39, 0x93, 0x8b, 0x42, 0x12,
+	0x51, 0x58, 0x0b, 0x42, 0x2b, 0x1c, 0x05, 0xa2, 0x92, 0xd4, 0x77, 0xaf, 0x2f, 0xae, 0x4a, 0x88,
+	0xeb, 0x6c, 0x2a, 0x65, 0x6b, 0xf2, 0x1b, 0x2b, 0x35, 0xc6, 0xbf, 0x35, 0x78, 0x2d, 0xb7, 0x62,
+	0x09, 0x41, 0xd2, 0xcd, 0x06, 0xc9, 0x95, 0x85, 0x77, 0x59, 0x10, 0x2d, 0x1f, 0x94, 0xa1, 0x95,
+	0x43, 0xde, 0x66, 0xf4, 0xc4, 0x75, 0x08, 0x3b, 0x54, 0xcd, 0x0a, 0x79, 0xb9, 0x86, 0x50, 0xdf,
+	0xfd, 0xce, 0xec, 0xe6, 0xc4, 0x09, 0x7f, 0xef, 0xdd, 0xce, 0x6b, 0xca, 0x94, 0x7a, 0x8a, 0x98,
+	0x6a, 0x29, 0xbf, 0xd4, 0x60, 0x75, 0x68, 0x85, 0x76, 0x5f, 0x6d, 0xfe, 0xfe, 0xc2, 0x9b, 0xcf,
+	0x6f, 0xc9, 0xbc, 0xc5, 0xc5, 0x5f, 0xf5, 0x42, 0x36, 0x4e, 0xfc, 0x22, 0x68, 0x58, 0x6a, 0x46,
+	0x6d, 0x58, 0xef, 0xba, 0x64, 0xe0, 0xdc, 0xb6, 0xc2, 0xbe, 0xea, 0x59, 0xe7, 0x14, 0x70, 0xfd,
+	0x5a, 0xc4, 0xc0, 0x09, 0x66, 0x6b, 0x0f, 0x20, 0x11, 0x8a, 0x1a, 0x50, 0x3e, 0x26, 0x63, 0xd9,
+	0x3e, 0x31, 0xff, 0x89, 0x3e, 0x07, 0xab, 0x27, 0xd6, 0x60, 0x44, 0x64, 0x9f, 0xc4, 0xf2, 0xe3,
+	0x52, 0x69, 0x4f, 0x33, 0xfe, 0x36, 0x1d, 0x60, 0xa2, 0xd0, 0xfe, 0x46, 0x83, 0x86, 0x9f, 0x33,
+	0x5c, 0xf9, 0xff, 0x87, 0x2f, 0xdd, 0x23, 0x1d, 0x5d, 0xed, 0xae, 0x91, 0xe7, 0xe0, 0x29, 0x23,
+	0xd0, 0x17, 0xa1, 0xec, 0xb8, 0x4c, 0x75, 0xfc, 0xea, 0xe4, 0xb4, 0x55, 0x3e, 0x70, 0x19, 0xe6,
+	0x34, 0xe3, 0x75, 0xf8, 0xfc, 0x67, 0xa6, 0x97, 0xf1, 0xfb, 0x12, 0x54, 0x0e, 0xdc, 0x6e, 0x77,
+	0x09, 0xb5, 0xe8, 0x7e, 0xa6, 0x16, 0x5d, 0x9a, 0xdd, 0x57, 0xdc, 0xce, 0xc2, 0x02, 0xe4, 0xe4,
+	0x0a, 0xd0, 0x3b, 0x73, 0xca, 0x7f, 0x76, 0xd5, 0xf9, 0x5d, 0x09, 0x6a, 0x1c, 0xc6, 0x93, 0x75,
+	0xe9, 0x09, 0xb8, 0x0d, 0x15, 0x3e, 0x0d, 0xa9, 0x03, 0x8e, 0x9d, 0xc0, 0xa7, 0x25, 0x2c, 0x38,
+	0x3c, 0x3d, 0xe2, 0x79, 0x29, 0x9f, 0x1e, 0xf1, 0x50, 0x85, 0x13, 0x0c, 0xda, 0x8b, 0xbd, 0x26,
+	0x07, 0xbb, 0xed, 0xec, 0xbe, 0x3f, 0x39, 0x6d, 0x6d, 0xf2, 0xed, 0xf2, 0xa2, 0x94, 0xf5, 0x04,
+	0xfa, 0x12, 0x54, 0x1c, 0xb7, 0xdb, 0x55, 0xa3, 0x5e, 0x8d, 0x1b, 0xc2, 0x91, 0x58, 0x50, 0x8d,
+	0xbf, 0x68, 0xd2, 0x4f, 0x4b, 0x28, 0xc9, 0x3f, 0xc9, 0x96, 0xe4, 0x8b, 0xf3, 0x9d, 0x7b, 0x41,
+	0x1d, 0x06, 0xb9, 0x0d, 0x1e, 0x67, 0x86, 0x0b, 0x90, 0x44, 0x48, 0xa2, 0x56, 0x8e, 0x5b, 0x73,
+	0x86, 0x33, 0x57, 0xd6, 0x59, 0x9f, 0x52, 0xdb, 0x05, 0x74, 0xf5, 0x71, 0x48, 0x98, 0x67, 0x0d,
+	0x30, 0xb1, 0xa9, 0x67, 0xbb, 0x03, 0xc2, 0xf8, 0xb8, 0x6e, 0x39, 0x0e, 0x23, 0x41, 0xa0, 0x6b,
+	0xd9, 0x71, 0xfd, 0x8a, 0x24, 0xe3, 0x88, 0x8f, 0xbe, 0x0a, 0x55, 0x9b, 0x0e, 0x87, 0x96, 0xe7,
+	0x08, 0xb7, 0xac, 0x77, 0xea, 0x1c, 0xb6, 0x2f, 0x49, 0x38, 0xe2, 0x19, 0x7f, 0x28, 0x41, 0xf5,
+	0xa6, 0x7b, 0xc4, 0x2c, 0x36, 0x5e, 0x42, 0x01, 0x78, 0x90, 0x29, 0x00, 0x73, 0xe4, 0x8a, 0x32,
+	0xb5, 0xb0, 0x06, 0xf4, 0x72, 0x35, 0xe0, 0x7b, 0xf3, 0xab, 0x78, 0x76, 0x19, 0xf8, 0xbb, 0x06,
+	0x75, 0x85, 0x5c, 0x42, 0x84, 0xff, 0x2c, 0x1b, 0xe1, 0xdf, 0x9e, 0x7b, 0x57, 0x05, 0x41, 0x7e,
+	0x1c, 0x6f, 0x46, 0x34, 0xb8, 0x4b, 0x50, 0x09, 0xc7, 0x7e, 0x74, 0xc9, 0xfc, 0x5a, 0xe4, 0xe7,
+	0x3b, 0x63, 0x9f, 0x7c, 0x72, 0xda, 0xfa, 0xc2, 0x21, 0xed, 0x86, 0x8f, 0x2c, 0xe6, 0xdc, 0x21,
+	0x76, 0xdf, 0xa3, 0x03, 0xda, 0x1b, 0x73, 0x0e, 0x16, 0x6b, 0x78, 0x89, 0xb2, 0xa9, 0x33, 0x55,
+	0xa2, 0xf6, 0xa9, 0x43, 0xb0, 0xe0, 0x18, 0x67, 0x61, 0x23, 0xe3, 0x63, 0xe3, 0xb7, 0x1a, 0x34,
+	0x6e, 0x52, 0xcb, 0x21, 0xec, 0x8a, 0xe7, 0xd1, 0xd0, 0x0a, 0xd3, 0xd7, 0x4d, 0xad, 0xf0, 0xba,
+	0xf9, 0x65, 0x28, 0x8f, 0xd8, 0x40, 0x29, 0xaa, 0x2b, 0x40, 0xf9, 0x2e, 0xbe, 0x89, 0x39, 0x9d,
+	0x57, 0x42, 0xc7, 0x65, 0xa2, 0x2f, 0x8e, 0xf3, 0x95, 0xf0, 0x20, 0x62, 0xe0, 0x04, 0xc3, 0xe5,
+	0x31, 0xd2, 0xd5, 0x2b, 0x59, 0x79, 0x98, 0x74, 0x31, 0xa7, 0x1b, 0x7f, 0x2a, 0x01, 0xa4, 0x52,
+	0xf1, 0xd5, 0x27, 0xcb, 0x51, 0x26, 0x59, 0x2e, 0xcf, 0xd3, 0x58, 0x22, 0x6b, 0x0b, 0xf3, 0xe5,
+	0x61, 0x2e, 0x5f, 0x3a, 0x0b, 0x69, 0x79, 0x76, 0xca, 0xfc, 0x43, 0x83, 0xcd, 0x04, 0xbc, 0x84,
+	0xac, 0xb1, 0xb2, 0x59, 0xf3, 0xce, 0x22, 0x7b, 0x2b, 0x48, 0x9c, 0xff, 0x69, 0x80, 0x12, 0x50,
+	0xd4, 0xb4, 0x97, 0x3e, 0x17, 0xf8, 0x50, 0x8b, 0x5e, 0xb0, 0x54, 0xb8, 0x5c, 0x7b, 0xbe, 0xbe,
+	0x68, 0x45, 0xa2, 0xf1, 0xea, 0x63, 0x9f, 0xf7, 0x0e, 0x97, 0x7a, 0xf1, 0xf4, 0x79, 0x86, 0xfb,
+	0x36, 0xfa, 0xc2, 0xb1, 0x16, 0xe3, 0xff, 0x6b, 0xe9, 0xc3, 0x14, 0x55, 0xe3, 0x6d, 0xd8, 0xb0,
+	0xa9, 0xe7, 0xb8, 0x3c, 0x7d, 0xef, 0x24, 0xe5, 0xe3, 0xdc, 0xe4, 0xb4, 0xb5, 0xb1, 0x9f, 0x66,
+	0xe0, 0x2c, 0x8e, 0xbf, 0xea, 0xf0, 0x60, 0xbc, 0xeb, 0x3b, 0x56, 0x28, 0x0b, 0x47, 0x4d, 0xbe,
+	0xea, 0x1c, 0xc6, 0x54, 0x9c, 0x42, 0x20, 0x1b, 0xca, 0x5d, 0xca, 0x54, 0xc4, 0x1e, 0x2c, 0x72,
+	0xaa, 0x91, 0x37, 0x93, 0x74, 0xbf, 0x46, 0x19, 0xe6, 0xd2, 0x79, 0xf6, 0xd1, 0x47, 0x1e, 0x9f,
+	0x8a, 0xca, 0x2f, 0x4d, 0x8b, 0x98, 0x91, 0xde, 0x7b, 0xe4, 0x05, 0x58, 0xc8, 0x46, 0xc7, 0x50,
+	0x7d, 0xc4, 0xaf, 0x26, 0x24, 0xd0, 0x57, 0x5f, 0xa2, 0x1a, 0xd1, 0xe9, 0x7f, 0x24, 0x05, 0xe3,
+	0x48, 0x03, 0xba, 0xa8, 0x8a, 0xfa, 0x9a, 0x38, 0x15, 0xe3, 0x85, 0x0b, 0xfa, 0x40, 0x15, 0xf4,
+	0xaa, 0xb0, 0xf0, 0xfb, 0x8b, 0x96, 0x21, 0x93, 0x77, 0x02, 0x79, 0xc1, 0xfb, 0x8c, 0xe6, 0x80,
+	0x76, 0xa0, 0x36, 0xb4, 0x1e, 0x1f, 0x86, 0xc4, 0x0f, 0xf4, 0xda, 0xb6, 0xb6, 0x53, 0x91, 0x11,
+	0x78, 0x4b, 0xd1, 0x70, 0xcc, 0x45, 0x77, 0xa1, 0x1a, 0xba, 0x43, 0x42, 0x47, 0xa1, 0xbe, 0x3e,
+	0x4b, 0xe9, 0x38, 0x18, 0x31, 0xd1, 0x61, 0xa4, 0x9b, 0xee, 0x48, 0x11, 0x38, 0x92, 0xc5, 0x53,
+	0x97, 0xa8, 0xc1, 0x4b, 0x87, 0x79, 0x23, 0x6c, 0x7a, 0x74, 0x93, 0xdb, 0x88, 0xe9, 0xb1, 0x8e,
+	0xad, 0xb7, 0x61, 0x3d, 0xf6, 0xc8, 0x4c, 0xb7, 0x53, 0x04, 0x8d, 0x7c, 0xe9, 0x35, 0x7e, 0x01,
+	0xe9, 0x02, 0x91, 0x3c, 0x01, 0x6b, 0x2f, 0xf6, 0x04, 0x5c, 0x7a, 0xc1, 0x27, 0xe0, 0x72, 0x51,
+	0x4f, 0x36, 0xfe, 0x58, 0x82, 0xda, 0xa1, 0x67, 0xf9, 0x41, 0x9f, 0x86, 0x4b, 0x68, 0x91, 0xef,
+	0x67, 0x5a, 0xe4, 0x77, 0x67, 0x3f, 0xa8, 0xc8, 0xd6, 0xc2, 0x06, 0xd9, 0xcf, 0x35, 0xc8, 0xcb,
+	0x0b, 0xe8, 0x78, 0x76, 0x7b, 0xfc, 0x50, 0x83, 0x33, 0x11, 0x74, 0x09, 0xcd, 0xf1, 0x41, 0xb6,
+	0x39, 0x5e, 0x9a, 0x7f, 0x5f, 0x05, 0xad, 0x71, 0x33, 0xd9, 0x8e, 0xb8, 0x3c, 0x35, 0x60, 0x33,
+	0xeb, 0x09, 0xe3, 0x9f, 0x1a, 0xd4, 0xef, 0xfa, 0x41, 0xc8, 0x88, 0x35, 0xc4, 0x64, 0x19, 0x0f,
+	0x10, 0x76, 0x26, 0x5e, 0xe6, 0x78, 0xbb, 0x4b, 0x99, 0x5b, 0xf8, 0x36, 0xff, 0xab, 0x32, 0x9c,
+	0xcd, 0xe1, 0xd0, 0x37, 0x32, 0x13, 0x75, 0x2b, 0x37, 0x51, 0xa7, 0xe1, 0xa9, 0xca, 0xfb, 0x26,
+	0xd4, 0x7c, 0xe6, 0x52, 0xe6, 0x86, 0x63, 0x61, 0x71, 0x39, 0x39, 0xd0, 0xdb, 0x8a, 0x8e, 0x63,
+	0x44, 0x34, 0x0e, 0x97, 0x0b, 0xc6, 0xe1, 0x37, 0xd2, 0xe3, 0xb0, 0x9c, 0x71, 0x37, 0x0a, 0x47,
+	0xe1, 0x23, 0x39, 0x0a, 0xaf, 0x0a, 0x37, 0xed, 0x2f, 0xe4, 0x26, 0xc2, 0x88, 0x67, 0x93, 0xe9,
+	0x79, 0x1a, 0x7d, 0x0b, 0xea, 0x36, 0x23, 0x0e, 0xf1, 0x42, 0xd7, 0x1a, 0x04, 0xaa, 0x2d, 0xc5,
+	0x73, 0xce, 0x7e, 0xc2, 0xc2, 0x69, 0x1c, 0x6f, 0x10, 0xae, 0x67, 0x0f, 0x46, 0x4e, 0xfc, 0x07,
+	0x90, 0xa8, 0xac, 0x37, 0x14, 0x0d, 0xc7, 0x5c, 0xe3, 0x08, 0xce, 0x4d, 0xd9, 0x81, 0xde, 0xc8,
+	0x1c, 0xc4, 0xeb, 0xb9, 0x83, 0xa8, 0x66, 0x0f, 0xe0, 0xb9, 0xcf, 0x2d, 0x9d, 0x7b, 0x4f, 0x9e,
+	0x36, 0x57, 0x3e, 0x7a, 0xda, 0x5c, 0xf9, 0xf8, 0x69, 0x73, 0xe5, 0x83, 0x49, 0x53, 0x7b, 0x32,
+	0x69, 0x6a, 0x1f, 0x4d, 0x9a, 0xda, 0xc7, 0x93, 0xa6, 0xf6, 0x9f, 0x49, 0x53, 0xfb, 0xf5, 0x7f,
+	0x9b, 0x2b, 0x3f, 0x3e, 0x3f, 0xeb, 0x5f, 0xa4, 0x9f, 0x0e, 0x00, 0x42, 0xe4, 0x20, 0xc4, 0x55,
+	0x1d, 0x00, 0x00,
 }
 
 func (m *APIResourceGroup) Marshal() (dAtA []byte, err error) {
@@ -1785,6 +1822,43 @@ func (m *DiffStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExternalReconciler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalReconciler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalReconciler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Command) > 0 {
+		for iNdEx := len(m.Command) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Command[iNdEx])
+			copy(dAtA[i:], m.Command[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Command[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Library) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2149,6 +2223,35 @@ func (m *ReconcilerSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.External != nil {
+		{
+			size, err := m.External.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxSteps != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxSteps))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Code) > 0 {
 		keysForCode := make([]string, 0, len(m.Code))
 		for k := range m.Code {
@@ -2835,6 +2938,23 @@ func (m *DiffStatus) Size() (n int) {
 	return n
 }
 
+func (m *ExternalReconciler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Library) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2992,6 +3112,17 @@ func (m *ReconcilerSpec) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.MaxSteps != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxSteps))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.External != nil {
+		l = m.External.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3333,6 +3464,17 @@ func (this *DiffStatus) String() string {
 	}, "")
 	return s
 }
+func (this *ExternalReconciler) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExternalReconciler{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Library) String() string {
 	if this == nil {
 		return "nil"
@@ -3465,6 +3607,9 @@ func (this *ReconcilerSpec) String() string {
 		`Watches:` + repeatedStringForWatches + `,`,
 		`Type:` + valueToStringGenerated(this.Type) + `,`,
 		`Code:` + mapStringForCode + `,`,
+		`MaxSteps:` + valueToStringGenerated(this.MaxSteps) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
+		`External:` + strings.Replace(this.External.String(), "ExternalReconciler", "ExternalReconciler", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5530,6 +5675,120 @@ func (m *DiffStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExternalReconciler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalReconciler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalReconciler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Library) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6867,6 +7126,98 @@ func (m *ReconcilerSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Code[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSteps", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSteps = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &v1.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field External", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.External == nil {
+				m.External = &ExternalReconciler{}
+			}
+			if err := m.External.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44,6 +44,7 @@ const (
 	SoftwardTechnologyType_GoTemplate    SoftwardTechnologyType = "gotemplate"
 	SoftwardTechnologyType_JinjaTemplate SoftwardTechnologyType = "jinjatemplate"
 	SoftwardTechnologyType_Internal      SoftwardTechnologyType = "internal"
+	SoftwardTechnologyType_External      SoftwardTechnologyType = "external"
 )
 
 func (r SoftwardTechnologyType) String() string {
@@ -56,6 +57,8 @@ func (r SoftwardTechnologyType) String() string {
 		return "gotmplate"
 	case SoftwardTechnologyType_JinjaTemplate:
 		return "jinjatmplate"
+	case SoftwardTechnologyType_External:
+		return "external"
 	case SoftwardTechnologyType_Invalid:
 		return "internal"
 	default:
@@ -69,6 +72,8 @@ func GetSoftwardTechnologyType(s string) SoftwardTechnologyType {
 		return SoftwardTechnologyType_Starlark
 	case "kform":
 		return SoftwardTechnologyType_Kform
+	case "external":
+		return SoftwardTechnologyType_External
 	default:
 		return SoftwardTechnologyType_Invalid
 	}
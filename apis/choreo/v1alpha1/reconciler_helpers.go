@@ -19,8 +19,10 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/kform-dev/choreo/pkg/server/selector"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
@@ -58,11 +60,46 @@ func (r *Reconciler) Validate() error {
 		}
 	}
 
+	if r.Spec.Timeout != nil && r.Spec.Timeout.Duration < 0 {
+		errm = errors.Join(errm, fmt.Errorf("reconciler %s timeout cannot be negative", name))
+	}
+	if r.Spec.Type != nil && *r.Spec.Type == SoftwardTechnologyType_External {
+		if r.Spec.External == nil || r.Spec.External.Address == "" {
+			errm = errors.Join(errm, fmt.Errorf("reconciler %s of type external requires an external address", name))
+		}
+	}
+
 	// TODO check if the for gvk is not used by the watch and own gvks
 	// TODO check if the own/watch are unique
 	return errm
 }
 
+// SetDefaultExecutionLimits sets the execution limits that are not specified by the reconciler
+func (r *Reconciler) SetDefaultExecutionLimits(maxSteps uint64, timeout time.Duration) {
+	if r.Spec.MaxSteps == nil {
+		r.Spec.MaxSteps = &maxSteps
+	}
+	if r.Spec.Timeout == nil {
+		r.Spec.Timeout = &metav1.Duration{Duration: timeout}
+	}
+}
+
+// GetMaxSteps returns the maximum amount of execution steps of a reconcile, 0 means unlimited
+func (r *Reconciler) GetMaxSteps() uint64 {
+	if r.Spec.MaxSteps == nil {
+		return 0
+	}
+	return *r.Spec.MaxSteps
+}
+
+// GetTimeout returns the maximum duration of a reconcile, 0 means unlimited
+func (r *Reconciler) GetTimeout() time.Duration {
+	if r.Spec.Timeout == nil {
+		return 0
+	}
+	return r.Spec.Timeout.Duration
+}
+
 func (r *Reconciler) GetGVKs() sets.Set[schema.GroupVersionKind] {
 	gvks := sets.New[schema.GroupVersionKind]()
 	gvks.Insert(r.Spec.For.ResourceGVK.GetGVK())
@@ -43,6 +43,28 @@ type ReconcilerSpec struct {
 	Type *SoftwardTechnologyType `json:"type,omitempty" protobuf:"bytes,6,opt,name=type"`
 	// Code supporting the reconciler
 	Code map[string]string `json:"code,omitempty" protobuf:"bytes,7,rep,name=code"`
+	// MaxSteps defines the maximum amount of execution steps of a single reconcile.
+	// When not specified the server default applies, 0 means unlimited.
+	// Only supported by starlark reconcilers.
+	MaxSteps *uint64 `json:"maxSteps,omitempty" protobuf:"varint,8,opt,name=maxSteps"`
+	// Timeout defines the maximum duration of a single reconcile.
+	// When not specified the server default applies, 0 means unlimited.
+	// Only supported by starlark and external reconcilers.
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,9,opt,name=timeout"`
+	// External defines how to reach the gRPC service of an external reconciler.
+	// Only used by external reconcilers.
+	External *ExternalReconciler `json:"external,omitempty" protobuf:"bytes,10,opt,name=external"`
+}
+
+// ExternalReconciler defines a reconciler that runs out of process and implements the
+// reconcilerpb gRPC service
+type ExternalReconciler struct {
+	// Address of the gRPC service, e.g. localhost:9000 or unix:///tmp/reconciler.sock
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Command starts the reconciler as a subprocess when specified. The address is provided to
+	// the subprocess through the CHOREO_RECONCILER_ADDRESS environment variable.
+	// +optional
+	Command []string `json:"command,omitempty" protobuf:"bytes,2,rep,name=command"`
 }
 
 type ReconcilerResource struct {
@@ -52,6 +52,10 @@ type SnapshotList struct {
 	Items           []Snapshot `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// SnapshotEmptyID identifies the empty inventory, which can be used as a baseline
+// when diffing snapshots
+const SnapshotEmptyID = "empty"
+
 var (
 	SnapshotKind     = reflect.TypeOf(Snapshot{}).Name()
 	SnapshotListKind = reflect.TypeOf(SnapshotList{}).Name()
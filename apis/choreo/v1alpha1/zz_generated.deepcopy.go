@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	selectorv1alpha1 "github.com/kform-dev/choreo/apis/selector/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -393,6 +394,26 @@ func (in *DiffStatus) DeepCopy() *DiffStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalReconciler) DeepCopyInto(out *ExternalReconciler) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalReconciler.
+func (in *ExternalReconciler) DeepCopy() *ExternalReconciler {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalReconciler)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Library) DeepCopyInto(out *Library) {
 	*out = *in
@@ -625,6 +646,21 @@ func (in *ReconcilerSpec) DeepCopyInto(out *ReconcilerSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.MaxSteps != nil {
+		in, out := &in.MaxSteps, &out.MaxSteps
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalReconciler)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcilerSpec.
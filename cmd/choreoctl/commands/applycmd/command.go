@@ -29,6 +29,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
 )
 
@@ -60,6 +61,7 @@ func NewCmdApply(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Co
 
 type ApplyFlags struct {
 	FileNameFlags *genericclioptions.FileNameFlags
+	DryRunFlags   *genericclioptions.DryRunFlags
 }
 
 // NewApplyFlags determines which flags will be added to the command
@@ -68,12 +70,14 @@ func NewApplyFlags() *ApplyFlags {
 	usage := "The files that contain the configuration to apply."
 	return &ApplyFlags{
 		FileNameFlags: genericclioptions.NewFileNameFlags(usage),
+		DryRunFlags:   genericclioptions.NewDryRunFlags(),
 	}
 }
 
 // AddFlags add flags to the command
 func (r *ApplyFlags) AddFlags(cmd *cobra.Command) {
 	r.FileNameFlags.AddFlags(cmd.Flags())
+	r.DryRunFlags.AddFlags(cmd.Flags())
 }
 
 // ToOptions renders the options based on the flags that were set and will be the base context used to run the command
@@ -83,13 +87,19 @@ func (r *ApplyFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *gene
 		Streams: streams,
 	}
 	options.FileNameFlags = r.FileNameFlags
+	dryRunStrategy, err := r.DryRunFlags.ToStrategy()
+	if err != nil {
+		return nil, err
+	}
+	options.DryRunStrategy = dryRunStrategy
 	return options, nil
 }
 
 type ApplyOptions struct {
-	Factory       util.Factory
-	Streams       *genericclioptions.IOStreams
-	FileNameFlags *genericclioptions.FileNameFlags
+	Factory        util.Factory
+	Streams        *genericclioptions.IOStreams
+	FileNameFlags  *genericclioptions.FileNameFlags
+	DryRunStrategy genericclioptions.DryRunStrategy
 }
 
 func (r *ApplyOptions) Validate(args []string) error {
@@ -113,9 +123,13 @@ func (r *ApplyOptions) Run(ctx context.Context, args []string) error {
 		u := &unstructured.Unstructured{
 			Object: ru.UnstructuredContent(),
 		}
-		fmt.Println("applying", u.GetAPIVersion(), u.GetKind(), u.GetName())
+		fmt.Printf("applying %s %s %s%s\n", u.GetAPIVersion(), u.GetKind(), u.GetName(), r.dryRunSuffix())
 		if err := r.applyOneObject(ctx, u); err != nil {
 			errs = errors.Join(errs, err)
+			continue
+		}
+		if err := r.printDryRunObject(u); err != nil {
+			errs = errors.Join(errs, err)
 		}
 	}
 	return errs
@@ -139,5 +153,26 @@ func (r *ApplyOptions) applyOneObject(ctx context.Context, ru runtime.Unstructur
 		FieldManager: "inputfileloader",
 		Branch:       r.Factory.GetBranch(),
 		Proxy:        r.Factory.GetProxy(),
+		DryRun:       r.DryRunStrategy.DryRun(),
 	})
 }
+
+func (r *ApplyOptions) dryRunSuffix() string {
+	if r.DryRunStrategy == genericclioptions.DryRunServer {
+		return " (server dry run)"
+	}
+	return ""
+}
+
+// printDryRunObject prints the object returned by the server for a server dry run
+func (r *ApplyOptions) printDryRunObject(u *unstructured.Unstructured) error {
+	if r.DryRunStrategy != genericclioptions.DryRunServer {
+		return nil
+	}
+	b, err := yaml.Marshal(u.UnstructuredContent())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(r.Streams.Out, "%s\n", string(b))
+	return err
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/client/go/auditclient"
+	"github.com/kform-dev/choreo/pkg/client/go/util"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
+)
+
+// NewCmdAudit returns a cobra command.
+func NewCmdAudit(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Command {
+	flags := NewAuditFlags()
+
+	cmd := &cobra.Command{
+		Use:   "audit [RESOURCE.GROUP [NAME]] [flags]",
+		Short: "show the audit trail of the resource mutations",
+		Long:  "show the create, update, apply and delete operations recorded by the server, oldest first",
+		Example: `  choreoctl audit
+  choreoctl audit nodes.example.com node1 --show-patch
+  choreoctl audit --origin choreoctl --since 1h`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o, err := flags.ToOptions(cmd, f, streams)
+			if err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+	}
+	flags.AddFlags(cmd)
+	return cmd
+}
+
+type AuditFlags struct {
+	Origin    *string
+	Operation *string
+	Since     *time.Duration
+	Limit     *int64
+	ShowPatch *bool
+	Output    *string
+}
+
+func NewAuditFlags() *AuditFlags {
+	return &AuditFlags{
+		Origin:    ptr.To(""),
+		Operation: ptr.To(""),
+		Since:     ptr.To(time.Duration(0)),
+		Limit:     ptr.To(int64(0)),
+		ShowPatch: ptr.To(false),
+		Output:    ptr.To(""),
+	}
+}
+
+// AddFlags add flags to the command
+func (r *AuditFlags) AddFlags(cmd *cobra.Command) {
+	if r.Origin != nil {
+		cmd.Flags().StringVar(r.Origin, "origin", *r.Origin,
+			"only show the entries of the origin (reconciler name, choreoctl, ...)")
+	}
+	if r.Operation != nil {
+		cmd.Flags().StringVar(r.Operation, "operation", *r.Operation,
+			"only show the entries of the operation: create, update, apply or delete")
+	}
+	if r.Since != nil {
+		cmd.Flags().DurationVar(r.Since, "since", *r.Since,
+			"only show the entries newer than a relative duration like 5s, 2m, or 3h, 0 means all")
+	}
+	if r.Limit != nil {
+		cmd.Flags().Int64Var(r.Limit, "limit", *r.Limit,
+			"only show the latest entries, 0 means all")
+	}
+	if r.ShowPatch != nil {
+		cmd.Flags().BoolVar(r.ShowPatch, "show-patch", *r.ShowPatch,
+			"show the patch of every entry")
+	}
+	if r.Output != nil {
+		cmd.Flags().StringVarP(r.Output, genericclioptions.FlagOutputFormat, "o", *r.Output,
+			"output format, one of: json; by default a line per entry is printed")
+	}
+}
+
+// ToOptions renders the options based on the flags that were set and will be the base context used to run the command
+func (r *AuditFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *genericclioptions.IOStreams) (*AuditOptions, error) {
+	options := &AuditOptions{
+		Factory:   f,
+		Streams:   streams,
+		Origin:    *r.Origin,
+		Operation: *r.Operation,
+		Since:     *r.Since,
+		Limit:     *r.Limit,
+		ShowPatch: *r.ShowPatch,
+		Output:    *r.Output,
+	}
+	// the namespace only filters the entries when it is set explicitly
+	if flag := cmd.Flags().Lookup(genericclioptions.FlagNamespace); flag != nil && flag.Changed {
+		options.Namespace = flag.Value.String()
+	}
+	return options, nil
+}
+
+type AuditOptions struct {
+	Factory   util.Factory
+	Streams   *genericclioptions.IOStreams
+	Namespace string
+	Origin    string
+	Operation string
+	Since     time.Duration
+	Limit     int64
+	ShowPatch bool
+	Output    string
+}
+
+func (r *AuditOptions) Validate(args []string) error {
+	if len(args) > 0 && len(strings.SplitN(args[0], ".", 2)) != 2 {
+		return fmt.Errorf("expecting <resource>.<group>, got: %s", args[0])
+	}
+	if _, err := parseOperation(r.Operation); err != nil {
+		return err
+	}
+	if r.Output != "" && r.Output != "json" {
+		return fmt.Errorf("unsupported output format %q, supported: json", r.Output)
+	}
+	if r.Limit < 0 {
+		return fmt.Errorf("limit must be 0 or a positive number, got: %d", r.Limit)
+	}
+	return nil
+}
+
+func (r *AuditOptions) Run(ctx context.Context, args []string) error {
+	proxy := r.Factory.GetProxy()
+	op, _ := parseOperation(r.Operation)
+
+	opts := &auditclient.ListOptions{
+		Proxy:     proxy,
+		Branch:    r.Factory.GetBranch(),
+		Namespace: r.Namespace,
+		Origin:    r.Origin,
+		Operation: op,
+		Limit:     r.Limit,
+	}
+	if r.Since > 0 {
+		opts.Since = time.Now().Add(-r.Since)
+	}
+	if len(args) > 0 {
+		parts := strings.SplitN(args[0], ".", 2)
+		gvk, err := r.Factory.GetResourceMapper().KindFor(ctx, schema.GroupResource{Group: parts[1], Resource: parts[0]}, proxy, opts.Branch)
+		if err != nil {
+			return err
+		}
+		opts.Group = gvk.Group
+		opts.Kind = gvk.Kind
+	}
+	if len(args) > 1 {
+		opts.Name = args[1]
+	}
+
+	entries, err := r.Factory.GetAuditClient().List(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	w := r.Streams.Out
+	var errm error
+	for _, entry := range entries {
+		if r.Output == "json" {
+			b, err := protojson.Marshal(entry)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "%s\n", string(b)); err != nil {
+				errm = errors.Join(errm, err)
+			}
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", formatEntry(entry, r.ShowPatch)); err != nil {
+			errm = errors.Join(errm, err)
+		}
+	}
+	return errm
+}
+
+func parseOperation(op string) (auditpb.Operation, error) {
+	if op == "" {
+		return auditpb.Operation_UNKNOWN, nil
+	}
+	value, ok := auditpb.Operation_value[strings.ToUpper(op)]
+	if !ok || value == int32(auditpb.Operation_UNKNOWN) {
+		return auditpb.Operation_UNKNOWN, fmt.Errorf("unsupported operation %q, supported: create, update, apply or delete", op)
+	}
+	return auditpb.Operation(value), nil
+}
+
+func formatEntry(entry *auditpb.Entry, showPatch bool) string {
+	name := entry.Name
+	if entry.Namespace != "" {
+		name = entry.Namespace + "/" + entry.Name
+	}
+	origin := entry.Origin
+	if entry.FieldManager != "" && entry.FieldManager != entry.Origin {
+		origin = fmt.Sprintf("%s (%s)", entry.Origin, entry.FieldManager)
+	}
+	line := fmt.Sprintf("%s %s %s %s.%s %s %s rv: %s -> %s",
+		entry.Time.AsTime().Local().Format(time.RFC3339),
+		entry.Branch,
+		strings.ToLower(entry.Operation.String()),
+		entry.Kind,
+		entry.ApiVersion,
+		name,
+		origin,
+		valueOrNone(entry.ResourceVersionBefore),
+		valueOrNone(entry.ResourceVersionAfter),
+	)
+	if showPatch && len(entry.Patch) != 0 {
+		line = fmt.Sprintf("%s\n  %s", line, string(entry.Patch))
+	}
+	return line
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
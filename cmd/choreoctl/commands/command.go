@@ -24,9 +24,11 @@ import (
 
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/apiresourcescmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/applycmd"
+	"github.com/kform-dev/choreo/cmd/choreoctl/commands/auditcmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/branchcmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/deletecmd.go"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/devcmd"
+	"github.com/kform-dev/choreo/cmd/choreoctl/commands/explaincmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/getcmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/servercmd"
@@ -99,8 +101,10 @@ func GetMain(ctx context.Context) (*cobra.Command, util.Factory) {
 
 		"apiresources": apiresourcescmd.NewCmdAPIResources(f, streams),
 		"apply":        applycmd.NewCmdApply(f, streams),
+		"audit":        auditcmd.NewCmdAudit(f, streams),
 		"branch":       branchcmd.NewCmdBranch(f, streams),
 		"dev":          devcmd.NewCmdDev(choreoConfig),
+		"explain":      explaincmd.NewCmdExplain(f, streams),
 		"get":          getcmd.NewCmdGet(f, streams),
 
 		"delete": deletecmd.NewCmdDelete(f, streams),
@@ -27,8 +27,11 @@ import (
 	"github.com/kform-dev/choreo/pkg/client/go/util"
 	"github.com/kform-dev/choreo/pkg/util/object"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
 )
 
@@ -62,6 +65,8 @@ func NewCmdDelete(f util.Factory, streams *genericclioptions.IOStreams) *cobra.C
 type DeleteFlags struct {
 	FileNameFlags *genericclioptions.FileNameFlags
 	Streams       *genericclioptions.IOStreams
+	DryRunFlags   *genericclioptions.DryRunFlags
+	Cascade       *string
 }
 
 // NewDeleteFlags determines which flags will be added to the command
@@ -71,28 +76,47 @@ func NewDeleteFlags() *DeleteFlags {
 
 	return &DeleteFlags{
 		FileNameFlags: genericclioptions.NewFileNameFlags(usage),
+		DryRunFlags:   genericclioptions.NewDryRunFlags(),
+		Cascade:       ptr.To("background"),
 	}
 }
 
 // AddFlags add flags tp the command
 func (r *DeleteFlags) AddFlags(cmd *cobra.Command) {
 	r.FileNameFlags.AddFlags(cmd.Flags())
+	r.DryRunFlags.AddFlags(cmd.Flags())
+	cmd.Flags().StringVar(r.Cascade, "cascade", *r.Cascade,
+		"Must be \"background\", \"orphan\", or \"foreground\". Selects the deletion cascading strategy for the dependents of the object.")
 }
 
 // ToOptions renders the options based on the flags that were set and will be the base context used to run the command
 func (r *DeleteFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *genericclioptions.IOStreams) (*DeleteOptions, error) {
 	options := &DeleteOptions{
-		Factory: f,
-		Streams: streams,
+		Factory:   f,
+		Streams:   streams,
+		Namespace: cmd.Flags().Lookup(genericclioptions.FlagNamespace).Value.String(),
 	}
 	options.FileNameFlags = r.FileNameFlags
+	policy, err := getPropagationPolicy(*r.Cascade)
+	if err != nil {
+		return nil, err
+	}
+	options.PropagationPolicy = policy
+	dryRunStrategy, err := r.DryRunFlags.ToStrategy()
+	if err != nil {
+		return nil, err
+	}
+	options.DryRunStrategy = dryRunStrategy
 	return options, nil
 }
 
 type DeleteOptions struct {
-	Factory       util.Factory
-	Streams       *genericclioptions.IOStreams
-	FileNameFlags *genericclioptions.FileNameFlags
+	Factory           util.Factory
+	Streams           *genericclioptions.IOStreams
+	FileNameFlags     *genericclioptions.FileNameFlags
+	Namespace         string
+	PropagationPolicy metav1.DeletionPropagation
+	DryRunStrategy    genericclioptions.DryRunStrategy
 }
 
 func (r *DeleteOptions) Validate(args []string) error {
@@ -119,9 +143,13 @@ func (r *DeleteOptions) Run(ctx context.Context, args []string) error {
 		u := &unstructured.Unstructured{
 			Object: ru.UnstructuredContent(),
 		}
-		fmt.Println("deleting", u.GetAPIVersion(), u.GetKind(), u.GetName())
+		fmt.Printf("deleting %s %s %s%s\n", u.GetAPIVersion(), u.GetKind(), u.GetName(), r.dryRunSuffix())
 		if err := r.deleteOneObject(ctx, u); err != nil {
 			errs = errors.Join(errs, err)
+			continue
+		}
+		if err := r.printDryRunObject(u); err != nil {
+			errs = errors.Join(errs, err)
 		}
 	}
 	return errs
@@ -131,6 +159,7 @@ func (r *DeleteOptions) GetObjects(args []string) ([]*resource.Info, error) {
 	b := resource.NewBuilder(r.Factory.GetResourceMapper(), r.Factory.GetProxy(), r.Factory.GetBranch()).
 		Unstructured().
 		ContinueOnError().
+		NamespaceParam(r.Namespace).
 		FilenameParam(&resource.FilenameOptions{Filenames: *r.FileNameFlags.Filenames, Recursive: *r.FileNameFlags.Recursive}).
 		ResourceTypeOrNameArgs(args...).
 		Flatten().
@@ -142,8 +171,43 @@ func (r *DeleteOptions) GetObjects(args []string) ([]*resource.Info, error) {
 func (r *DeleteOptions) deleteOneObject(ctx context.Context, ru runtime.Unstructured) error {
 	client := r.Factory.GetResourceClient()
 	return client.Delete(ctx, ru, &resourceclient.DeleteOptions{
-		Origin: "choreoctl",
-		Branch: r.Factory.GetBranch(),
-		Proxy:  r.Factory.GetProxy(),
+		Origin:            "choreoctl",
+		Branch:            r.Factory.GetBranch(),
+		Proxy:             r.Factory.GetProxy(),
+		PropagationPolicy: r.PropagationPolicy,
+		DryRun:            r.DryRunStrategy.DryRun(),
 	})
 }
+
+func (r *DeleteOptions) dryRunSuffix() string {
+	if r.DryRunStrategy == genericclioptions.DryRunServer {
+		return " (server dry run)"
+	}
+	return ""
+}
+
+// printDryRunObject prints the object returned by the server for a server dry run
+func (r *DeleteOptions) printDryRunObject(u *unstructured.Unstructured) error {
+	if r.DryRunStrategy != genericclioptions.DryRunServer {
+		return nil
+	}
+	b, err := yaml.Marshal(u.UnstructuredContent())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(r.Streams.Out, "%s\n", string(b))
+	return err
+}
+
+func getPropagationPolicy(cascade string) (metav1.DeletionPropagation, error) {
+	switch cascade {
+	case "background":
+		return metav1.DeletePropagationBackground, nil
+	case "foreground":
+		return metav1.DeletePropagationForeground, nil
+	case "orphan":
+		return metav1.DeletePropagationOrphan, nil
+	default:
+		return "", fmt.Errorf("invalid cascade value %q, must be background, foreground or orphan", cascade)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package explaincmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kform-dev/choreo/pkg/cli/explain"
+	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/client/go/util"
+	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
+)
+
+// NewCmdExplain returns a cobra command.
+func NewCmdExplain(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Command {
+	flags := NewExplainFlags()
+
+	cmd := &cobra.Command{
+		Use:   "explain RESOURCE[.GROUP][.FIELD.PATH] [flags]",
+		Short: "describe the fields of a resource",
+		Long:  "describe the fields of a resource based on its openapi schema: descriptions, types, enums, defaults and required fields",
+		Example: `  choreoctl explain nodes.example.com
+  choreoctl explain nodes.example.com.spec.interfaces`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o, err := flags.ToOptions(cmd, f, streams)
+			if err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+	}
+	flags.AddFlags(cmd)
+	return cmd
+}
+
+type ExplainFlags struct {
+	Recursive *bool
+}
+
+func NewExplainFlags() *ExplainFlags {
+	return &ExplainFlags{
+		Recursive: ptr.To(false),
+	}
+}
+
+// AddFlags add flags to the command
+func (r *ExplainFlags) AddFlags(cmd *cobra.Command) {
+	if r.Recursive != nil {
+		cmd.Flags().BoolVar(r.Recursive, "recursive", *r.Recursive,
+			"print the names and types of all nested fields")
+	}
+}
+
+// ToOptions renders the options based on the flags that were set and will be the base context used to run the command
+func (r *ExplainFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *genericclioptions.IOStreams) (*ExplainOptions, error) {
+	options := &ExplainOptions{
+		Factory:   f,
+		Streams:   streams,
+		Recursive: *r.Recursive,
+	}
+	return options, nil
+}
+
+type ExplainOptions struct {
+	Factory   util.Factory
+	Streams   *genericclioptions.IOStreams
+	Recursive bool
+}
+
+func (r *ExplainOptions) Validate(args []string) error {
+	if len(args) != 1 || args[0] == "" {
+		return fmt.Errorf("expecting a resource, got: %v", args)
+	}
+	return nil
+}
+
+func (r *ExplainOptions) Run(ctx context.Context, args []string) error {
+	branch := r.Factory.GetBranch()
+	proxy := r.Factory.GetProxy()
+
+	apiResources, err := r.Factory.GetDiscoveryClient().APIResources(ctx, proxy, branch)
+	if err != nil {
+		if grpcerrors.IsNotFound(err) {
+			return fmt.Errorf("cannot get apiresources, branch %s not found", branch)
+		}
+		return err
+	}
+	apiResource, fieldPath, err := explain.SplitFieldPath(args[0], apiResources)
+	if err != nil {
+		return err
+	}
+	_, openAPISchema, err := r.Factory.GetDiscoveryClient().Schema(ctx, proxy, branch, schema.GroupVersionKind{
+		Group:   apiResource.Group,
+		Version: apiResource.Version,
+		Kind:    apiResource.Kind,
+	})
+	if err != nil {
+		return err
+	}
+	return explain.Explain(r.Streams.Out, apiResource, openAPISchema, fieldPath, r.Recursive)
+}
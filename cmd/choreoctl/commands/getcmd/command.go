@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
 )
@@ -65,6 +66,8 @@ func NewCmdGet(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Comm
 
 type GetFlags struct {
 	ResourceOuput *genericclioptions.ResourceOutputFlags
+	AllNamespaces *bool
+	ChunkSize     *int64
 }
 
 // NewGetFlags determines which flags will be added to the command
@@ -72,12 +75,18 @@ type GetFlags struct {
 func NewGetFlags() *GetFlags {
 	return &GetFlags{
 		ResourceOuput: genericclioptions.NewResourceOutputFlags(),
+		AllNamespaces: ptr.To(false),
+		ChunkSize:     ptr.To(int64(500)),
 	}
 }
 
 // AddFlags add flags tp the command
 func (r *GetFlags) AddFlags(cmd *cobra.Command) {
 	r.ResourceOuput.AddFlags(cmd.Flags())
+	cmd.Flags().BoolVarP(r.AllNamespaces, genericclioptions.FlagAllNamespaces, "A", *r.AllNamespaces,
+		"If present, list the requested resources across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().Int64Var(r.ChunkSize, "chunk-size", *r.ChunkSize,
+		"Return large lists in chunks rather than all at once. Pass 0 to disable.")
 }
 
 // ToOptions renders the options based on the flags that were set and will be the base context used to run the command
@@ -87,6 +96,8 @@ func (r *GetFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *generi
 		Streams:           streams,
 		OutputFormat:      *r.ResourceOuput.Output,
 		Namespace:         cmd.Flags().Lookup(genericclioptions.FlagNamespace).Value.String(),
+		AllNamespaces:     *r.AllNamespaces,
+		ChunkSize:         *r.ChunkSize,
 		ShowManagedFields: *r.ResourceOuput.ShowManagedFields,
 	}
 	return options, nil
@@ -97,10 +108,18 @@ type GetOptions struct {
 	Streams           *genericclioptions.IOStreams
 	OutputFormat      string
 	Namespace         string
+	AllNamespaces     bool
+	ChunkSize         int64
 	ShowManagedFields bool
 }
 
 func (r *GetOptions) Validate(args []string) error {
+	if r.AllNamespaces && len(args) > 1 {
+		return fmt.Errorf("a resource cannot be retrieved by name across all namespaces")
+	}
+	if r.ChunkSize < 0 {
+		return fmt.Errorf("chunk-size must be 0 or a positive number, got: %d", r.ChunkSize)
+	}
 	return nil
 }
 
@@ -117,16 +136,34 @@ func (r *GetOptions) Run(ctx context.Context, args []string) error {
 		return err
 	}
 	if len(args) == 1 {
+		namespace := r.Namespace
+		if r.AllNamespaces {
+			namespace = ""
+		}
 		ul := &unstructured.UnstructuredList{}
 		ul.SetGroupVersionKind(gvk)
-		if err := r.Factory.GetResourceClient().List(ctx, ul, &resourceclient.ListOptions{
-			ExprSelector:      &resourcepb.ExpressionSelector{},
-			ShowManagedFields: r.ShowManagedFields,
-			Origin:            "choreoctl",
-			Branch:            branch,
-			Proxy:             proxy,
-		}); err != nil {
-			return err
+		// retrieve the list in chunks of ChunkSize items and merge the pages
+		continueToken := ""
+		for {
+			page := &unstructured.UnstructuredList{}
+			page.SetGroupVersionKind(gvk)
+			if err := r.Factory.GetResourceClient().List(ctx, page, &resourceclient.ListOptions{
+				Namespace:         namespace,
+				ExprSelector:      &resourcepb.ExpressionSelector{},
+				ShowManagedFields: r.ShowManagedFields,
+				Origin:            "choreoctl",
+				Branch:            branch,
+				Proxy:             proxy,
+				Limit:             r.ChunkSize,
+				Continue:          continueToken,
+			}); err != nil {
+				return err
+			}
+			ul.Items = append(ul.Items, page.Items...)
+			continueToken = page.GetContinue()
+			if continueToken == "" {
+				break
+			}
 		}
 		return r.parseOutput(ul)
 
@@ -182,12 +219,19 @@ func (r *GetOptions) parseOutput(obj runtime.Unstructured) error {
 			})
 
 			sort.Slice(us, func(i, j int) bool {
+				if us[i].GetNamespace() != us[j].GetNamespace() {
+					return us[i].GetNamespace() < us[j].GetNamespace()
+				}
 				return us[i].GetName() < us[j].GetName()
 			})
 
 			var errm error
 			for _, u := range us {
-				if _, err := fmt.Fprintf(w, "%s.%s %s\n", u.GetKind(), u.GetAPIVersion(), u.GetName()); err != nil {
+				name := u.GetName()
+				if r.AllNamespaces && u.GetNamespace() != "" {
+					name = fmt.Sprintf("%s/%s", u.GetNamespace(), u.GetName())
+				}
+				if _, err := fmt.Fprintf(w, "%s.%s %s\n", u.GetKind(), u.GetAPIVersion(), name); err != nil {
 					errm = errors.Join(errm, err)
 				}
 			}
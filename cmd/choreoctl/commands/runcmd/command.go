@@ -24,9 +24,12 @@ import (
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/loadcmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/oncecmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/pushcmd"
+	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/restorecmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/resultcmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/startcmd"
+	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/statuscmd"
 	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/stopcmd"
+	"github.com/kform-dev/choreo/cmd/choreoctl/commands/runcmd/tagcmd"
 	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
 	"github.com/kform-dev/choreo/pkg/client/go/util"
 	"github.com/spf13/cobra"
@@ -59,8 +62,11 @@ func NewCmdRun(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Comm
 		loadcmd.NewCmdLoad(f, streams),
 		oncecmd.NewCmdOnce(f, streams),
 		pushcmd.NewCmdPush(f, streams),
+		restorecmd.NewCmdRestore(f, streams),
 		startcmd.NewCmdStart(f, streams),
+		statuscmd.NewCmdStatus(f, streams),
 		stopcmd.NewCmdStop(f, streams),
+		tagcmd.NewCmdTag(f, streams),
 	)
 	return cmd
 }
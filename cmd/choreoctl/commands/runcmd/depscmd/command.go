@@ -18,11 +18,17 @@ package depscmd
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/client/go/runnerclient"
 	"github.com/kform-dev/choreo/pkg/client/go/util"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/kform-dev/choreo/pkg/util/inventory"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/utils/ptr"
 	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
 )
 
@@ -31,7 +37,7 @@ func NewCmdDeps(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Com
 
 	cmd := &cobra.Command{
 		Use:   "deps",
-		Short: "get dependencies between resources",
+		Short: "get dependencies between resources or reconcilers",
 		//Args:  cobra.ExactArgs(1),
 		//Short:   docs.InitShort,
 		//Long:    docs.InitShort + "\n" + docs.InitLong,
@@ -53,19 +59,31 @@ func NewCmdDeps(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Com
 }
 
 type DepsFlags struct {
-	RunOuput *genericclioptions.RunOutputFlags
+	RunOuput    *genericclioptions.RunOutputFlags
+	Reconcilers *bool
+	Output      *string
 }
 
 // The defaults are determined here
 func NewDepsFlags() *DepsFlags {
 	return &DepsFlags{
-		RunOuput: genericclioptions.NewRunOutputFlags(),
+		RunOuput:    genericclioptions.NewRunOutputFlags(),
+		Reconcilers: ptr.To(false),
+		Output:      ptr.To(""),
 	}
 }
 
 // AddFlags add flags tp the command
 func (r *DepsFlags) AddFlags(cmd *cobra.Command) {
 	r.RunOuput.AddFlags(cmd.Flags())
+	if r.Reconcilers != nil {
+		cmd.Flags().BoolVar(r.Reconcilers, "reconcilers", *r.Reconcilers,
+			"if true, show the dependency graph of the reconcilers instead of the resources")
+	}
+	if r.Output != nil {
+		cmd.Flags().StringVarP(r.Output, genericclioptions.FlagOutputFormat, "o", *r.Output,
+			"output format of the reconciler dependency graph, one of: json; by default the reconcilers are printed per wave")
+	}
 }
 
 // ToOptions renders the options based on the flags that were set and will be the base context used to run the command
@@ -74,6 +92,8 @@ func (r *DepsFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *gener
 		Factory:        f,
 		Streams:        streams,
 		ShowChoreoAPIs: *r.RunOuput.ShowChoreoAPIs,
+		Reconcilers:    *r.Reconcilers,
+		Output:         *r.Output,
 	}
 	return options, nil
 }
@@ -82,13 +102,21 @@ type DepsOptions struct {
 	Factory        util.Factory
 	Streams        *genericclioptions.IOStreams
 	ShowChoreoAPIs bool
+	Reconcilers    bool
+	Output         string
 }
 
 func (r *DepsOptions) Validate(args []string) error {
+	if r.Output != "" && r.Output != "json" {
+		return fmt.Errorf("unsupported output format %q, supported: json", r.Output)
+	}
 	return nil
 }
 
 func (r *DepsOptions) Run(ctx context.Context, args []string) error {
+	if r.Reconcilers {
+		return r.runReconcilers(ctx)
+	}
 	branch := r.Factory.GetBranch()
 	proxy := r.Factory.GetProxy()
 
@@ -108,3 +136,52 @@ func (r *DepsOptions) Run(ctx context.Context, args []string) error {
 	inv.Print()
 	return nil
 }
+
+func (r *DepsOptions) runReconcilers(ctx context.Context) error {
+	rsp, err := r.Factory.GetRunnerClient().Deps(ctx, &runnerclient.DepsOptions{
+		Proxy:  r.Factory.GetProxy(),
+		Branch: r.Factory.GetBranch(),
+	})
+	if err != nil {
+		return err
+	}
+
+	w := r.Streams.Out
+	if r.Output == "json" {
+		b, err := protojson.Marshal(rsp)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", string(b))
+		return err
+	}
+
+	var sb strings.Builder
+	for _, graph := range rsp.Graphs {
+		fmt.Fprintf(&sb, "runner %s\n", graph.ReconcilerRunner)
+		wave := int32(-1)
+		for _, reconciler := range graph.Reconcilers {
+			if reconciler.Wave != wave {
+				wave = reconciler.Wave
+				fmt.Fprintf(&sb, "  wave %d\n", wave)
+			}
+			fmt.Fprintf(&sb, "    %s (for: %s", reconciler.Name, formatGroupKind(reconciler.For))
+			if len(reconciler.DependsOn) > 0 {
+				fmt.Fprintf(&sb, ", depends on: %s", strings.Join(reconciler.DependsOn, ", "))
+			}
+			sb.WriteString(")\n")
+		}
+		for _, cycle := range graph.Cycles {
+			fmt.Fprintf(&sb, "  cycle: %s\n", strings.Join(cycle.Reconcilers, ", "))
+		}
+	}
+	_, err = fmt.Fprint(w, sb.String())
+	return err
+}
+
+func formatGroupKind(gk *runnerpb.Deps_GroupKind) string {
+	if gk.Group == "" {
+		return gk.Kind
+	}
+	return fmt.Sprintf("%s.%s", gk.Kind, gk.Group)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/kform-dev/choreo/pkg/client/go/snapshotclient"
 	"github.com/kform-dev/choreo/pkg/client/go/util"
 	"github.com/spf13/cobra"
+	"k8s.io/utils/ptr"
 	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
 )
 
@@ -59,18 +60,26 @@ func NewCmdDiff(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Com
 
 type DiffFlags struct {
 	RunOuput *genericclioptions.RunOutputFlags
+	From     *string
+	To       *string
 }
 
 // The defaults are determined here
 func NewDiffFlags() *DiffFlags {
 	return &DiffFlags{
 		RunOuput: genericclioptions.NewRunOutputFlags(),
+		From:     ptr.To(""),
+		To:       ptr.To(""),
 	}
 }
 
 // AddFlags add flags tp the command
 func (r *DiffFlags) AddFlags(cmd *cobra.Command) {
 	r.RunOuput.AddFlags(cmd.Flags())
+	cmd.Flags().StringVar(r.From, "from", *r.From,
+		fmt.Sprintf("the snapshot id used as the base of the diff, %q refers to the empty inventory; defaults to the predecessor of the to snapshot", choreov1alpha1.SnapshotEmptyID))
+	cmd.Flags().StringVar(r.To, "to", *r.To,
+		"the snapshot id compared against the base; defaults to the latest snapshot")
 }
 
 // ToOptions renders the options based on the flags that were set and will be the base context used to run the command
@@ -82,6 +91,8 @@ func (r *DiffFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *gener
 		ShowManagedFields: *r.RunOuput.ShowManagedFields,
 		ShowDiffDetails:   *r.RunOuput.ShowDiffDetails,
 		ShowFinalConfig:   *r.RunOuput.ShowFinalConfig,
+		From:              *r.From,
+		To:                *r.To,
 	}
 	return options, nil
 }
@@ -93,6 +104,8 @@ type DiffOptions struct {
 	ShowManagedFields bool
 	ShowDiffDetails   bool
 	ShowFinalConfig   bool
+	From              string
+	To                string
 }
 
 func (r *DiffOptions) Validate(args []string) error {
@@ -108,6 +121,8 @@ func (r *DiffOptions) Run(ctx context.Context, args []string) error {
 		ShowManagedFields: r.ShowManagedFields,
 		ShowChoreoAPIs:    r.ShowChoreoAPIs,
 		ShowFinalConfig:   r.ShowFinalConfig,
+		From:              r.From,
+		To:                r.To,
 	})
 	if err != nil {
 		return err
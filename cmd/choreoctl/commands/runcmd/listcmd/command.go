@@ -114,7 +114,7 @@ func (r *ListOptions) Run(ctx context.Context, args []string) error {
 
 	var errm error
 	for _, u := range us {
-		if _, err := fmt.Fprintf(w, "%s %s\n", u.GetName(), u.GetCreationTimestamp()); err != nil {
+		if _, err := fmt.Fprintf(w, "%s %s %s\n", u.GetName(), u.GetCreationTimestamp(), u.GetLabels()[choreov1alpha1.ChoreoSnapshotTagKey]); err != nil {
 			errm = errors.Join(errm, err)
 		}
 	}
@@ -85,7 +85,8 @@ func (r *LoadOptions) Validate(args []string) error {
 func (r *LoadOptions) Run(ctx context.Context, args []string) error {
 	runnerClient := r.Factory.GetRunnerClient()
 	if err := runnerClient.Load(ctx, &runnerclient.LoadOptions{
-		Proxy: r.Factory.GetProxy(),
+		Proxy:  r.Factory.GetProxy(),
+		Branch: r.Factory.GetBranch(),
 	}); err != nil {
 		return err
 	}
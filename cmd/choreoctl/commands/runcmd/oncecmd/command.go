@@ -22,13 +22,17 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/cli/trace"
 	"github.com/kform-dev/choreo/pkg/client/go/runnerclient"
 	"github.com/kform-dev/choreo/pkg/client/go/util"
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
 )
 
@@ -60,18 +64,42 @@ func NewCmdOnce(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Com
 
 type OnceFlags struct {
 	RunResultsFlags *genericclioptions.RunResultFlags
+	MaxIterations   *int64
+	Deadline        *time.Duration
+	Trace           *bool
+	Incremental     *bool
 }
 
 // The defaults are determined here
 func NewOnceFlags() *OnceFlags {
 	return &OnceFlags{
 		RunResultsFlags: genericclioptions.NewRunResultFlags(),
+		MaxIterations:   ptr.To(int64(0)),
+		Deadline:        ptr.To(time.Duration(0)),
+		Trace:           ptr.To(false),
+		Incremental:     ptr.To(false),
 	}
 }
 
 // AddFlags add flags tp the command
 func (r *OnceFlags) AddFlags(cmd *cobra.Command) {
 	r.RunResultsFlags.AddFlags(cmd.Flags())
+	if r.MaxIterations != nil {
+		cmd.Flags().Int64Var(r.MaxIterations, "max-iterations", *r.MaxIterations,
+			"the amount of times a reconciler can reconcile the same object before the run fails, 0 uses the server default")
+	}
+	if r.Deadline != nil {
+		cmd.Flags().DurationVar(r.Deadline, "deadline", *r.Deadline,
+			"the maximum duration the reconcilers get to converge before the run fails, 0 uses the server default")
+	}
+	if r.Trace != nil {
+		cmd.Flags().BoolVar(r.Trace, "trace", *r.Trace,
+			"if true, print a trace per reconcile; the traces are stored with the snapshot and shown by run result --trace")
+	}
+	if r.Incremental != nil {
+		cmd.Flags().BoolVar(r.Incremental, "incremental", *r.Incremental,
+			"if true, only reload the input that changed since the last load and only run the affected reconcilers; falls back to a full run when apis or reconcilers changed")
+	}
 }
 
 // ToOptions renders the options based on the flags that were set and will be the base context used to run the command
@@ -80,6 +108,10 @@ func (r *OnceFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *gener
 		Factory:            f,
 		Streams:            streams,
 		ResultOutputFormat: *r.RunResultsFlags.ResultOutputFormat,
+		MaxIterations:      *r.MaxIterations,
+		Deadline:           *r.Deadline,
+		Trace:              *r.Trace,
+		Incremental:        *r.Incremental,
 	}
 	return options, nil
 }
@@ -88,12 +120,22 @@ type OnceOptions struct {
 	Factory            util.Factory
 	Streams            *genericclioptions.IOStreams
 	ResultOutputFormat string
+	MaxIterations      int64
+	Deadline           time.Duration
+	Trace              bool
+	Incremental        bool
 }
 
 func (r *OnceOptions) Validate(args []string) error {
 	if !genericclioptions.SupportedResultOutputFormats.Has(r.ResultOutputFormat) {
 		return fmt.Errorf("unsupported result output format %s, supported output formats %v", r.ResultOutputFormat, sets.List(genericclioptions.SupportedResultOutputFormats))
 	}
+	if r.MaxIterations < 0 {
+		return fmt.Errorf("max-iterations cannot be negative, got %d", r.MaxIterations)
+	}
+	if r.Deadline < 0 {
+		return fmt.Errorf("deadline cannot be negative, got %s", r.Deadline)
+	}
 	return nil
 }
 
@@ -102,7 +144,12 @@ func (r *OnceOptions) Run(ctx context.Context, args []string) error {
 
 	runnerClient := r.Factory.GetRunnerClient()
 	stream, err := runnerClient.Once(ctx, &runnerclient.OnceOptions{
-		Proxy: r.Factory.GetProxy(),
+		Proxy:         r.Factory.GetProxy(),
+		Branch:        r.Factory.GetBranch(),
+		MaxIterations: r.MaxIterations,
+		Deadline:      r.Deadline,
+		Trace:         r.Trace,
+		Incremental:   r.Incremental,
 	})
 	if err != nil {
 		return err
@@ -124,6 +171,8 @@ func (r *OnceOptions) Run(ctx context.Context, args []string) error {
 			return nil
 		case runnerpb.Once_RUN_RESPONSE:
 			r.handleRunResponse(rsp.GetRunResponse())
+		case runnerpb.Once_TRACE:
+			trace.Print(w, rsp.GetTrace())
 		case runnerpb.Once_SDC_RESPONSE:
 			fmt.Fprintf(w, "%s\n", "to be updated")
 		case runnerpb.Once_COMPLETED:
@@ -141,6 +190,13 @@ func (r *OnceOptions) handleRunResponse(rsp *runnerpb.Once_RunResponse) {
 		fmt.Println("execution failed")
 		for _, result := range rsp.Results {
 			if !result.Success {
+				if len(result.ConvergenceIssues) > 0 {
+					fmt.Println("  reconcilers did not converge", "runner", result.ReconcilerRunner)
+					for _, issue := range result.ConvergenceIssues {
+						printConvergenceIssue(issue)
+					}
+					continue
+				}
 				fmt.Println("  reason", "task", result.TaskId, "message", result.Message)
 			}
 		}
@@ -173,6 +229,19 @@ func (r *OnceOptions) handleRunResponse(rsp *runnerpb.Once_RunResponse) {
 
 }
 
+func printConvergenceIssue(issue *runnerpb.Once_ConvergenceIssue) {
+	fmt.Printf("    %s: %s\n", issue.Type.String(), issue.Message)
+	if issue.Resource != nil {
+		fmt.Printf("      resource: %s.%s %s\n", issue.Resource.Kind, issue.Resource.Group, types.NamespacedName{Namespace: issue.Resource.Namespace, Name: issue.Resource.Name}.String())
+	}
+	if len(issue.Reconcilers) > 0 {
+		fmt.Printf("      reconcilers: %s\n", strings.Join(issue.Reconcilers, ", "))
+	}
+	if len(issue.Fields) > 0 {
+		fmt.Printf("      fields: %s\n", strings.Join(issue.Fields, ", "))
+	}
+}
+
 type SummaryPrinter interface {
 	CollectData(result *runnerpb.Once_RunResult)
 	PrintSummary()
@@ -0,0 +1,90 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorecmd
+
+import (
+	"context"
+
+	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/client/go/snapshotclient"
+	"github.com/kform-dev/choreo/pkg/client/go/util"
+	"github.com/spf13/cobra"
+	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
+)
+
+func NewCmdRestore(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Command {
+	flags := NewRestoreFlags()
+
+	cmd := &cobra.Command{
+		Use:   "restore ID [flags]",
+		Short: "restore the checked out branch to a snapshot",
+		Args:  cobra.ExactArgs(1),
+		//Short:   docs.InitShort,
+		//Long:    docs.InitShort + "\n" + docs.InitLong,
+		//Example: docs.InitExamples,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			o, err := flags.ToOptions(cmd, f, streams)
+			if err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(ctx, args)
+		},
+	}
+	flags.AddFlags(cmd)
+	return cmd
+}
+
+type RestoreFlags struct {
+}
+
+// The defaults are determined here
+func NewRestoreFlags() *RestoreFlags {
+	return &RestoreFlags{}
+}
+
+// AddFlags add flags tp the command
+func (r *RestoreFlags) AddFlags(cmd *cobra.Command) {
+}
+
+// ToOptions renders the options based on the flags that were set and will be the base context used to run the command
+func (r *RestoreFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *genericclioptions.IOStreams) (*RestoreOptions, error) {
+	options := &RestoreOptions{
+		Factory: f,
+		Streams: streams,
+	}
+	return options, nil
+}
+
+type RestoreOptions struct {
+	Factory util.Factory
+	Streams *genericclioptions.IOStreams
+}
+
+func (r *RestoreOptions) Validate(args []string) error {
+	return nil
+}
+
+func (r *RestoreOptions) Run(ctx context.Context, args []string) error {
+	snapshotClient := r.Factory.GetSnapshotClient()
+	return snapshotClient.Restore(ctx, args[0], &snapshotclient.RestoreOptions{
+		Proxy: r.Factory.GetProxy(),
+	})
+}
@@ -23,10 +23,12 @@ import (
 	"strings"
 
 	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/cli/trace"
 	"github.com/kform-dev/choreo/pkg/client/go/snapshotclient"
 	"github.com/kform-dev/choreo/pkg/client/go/util"
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/spf13/cobra"
+	"k8s.io/utils/ptr"
 	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
 )
 
@@ -34,8 +36,9 @@ func NewCmdResult(f util.Factory, streams *genericclioptions.IOStreams) *cobra.C
 	flags := NewResultFlags()
 
 	cmd := &cobra.Command{
-		Use:   "result [flags]",
+		Use:   "result [<kind>[.<group>]/<name>] [flags]",
 		Short: "show result of a snapshot",
+		Long:  "show result of a snapshot; with --trace the reconcile traces are shown, optionally only the traces of the reconciles that reconciled or changed the given resource",
 		//Args:  cobra.ExactArgs(1),
 		//Short:   docs.InitShort,
 		//Long:    docs.InitShort + "\n" + docs.InitLong,
@@ -58,18 +61,24 @@ func NewCmdResult(f util.Factory, streams *genericclioptions.IOStreams) *cobra.C
 
 type ResultFlags struct {
 	RunResultsFlags *genericclioptions.RunResultFlags
+	Trace           *bool
 }
 
 // The defaults are determined here
 func NewResultFlags() *ResultFlags {
 	return &ResultFlags{
 		RunResultsFlags: genericclioptions.NewRunResultFlags(),
+		Trace:           ptr.To(false),
 	}
 }
 
 // AddFlags add flags tp the command
 func (r *ResultFlags) AddFlags(cmd *cobra.Command) {
 	r.RunResultsFlags.AddFlags(cmd.Flags())
+	if r.Trace != nil {
+		cmd.Flags().BoolVar(r.Trace, "trace", *r.Trace,
+			"if true, show the reconcile traces of the snapshot; requires a run once with --trace")
+	}
 }
 
 // ToOptions renders the options based on the flags that were set and will be the base context used to run the command
@@ -78,6 +87,7 @@ func (r *ResultFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *gen
 		Factory:            f,
 		Streams:            streams,
 		ResultOutputFormat: *r.RunResultsFlags.ResultOutputFormat,
+		Trace:              *r.Trace,
 	}
 	return options, nil
 }
@@ -86,9 +96,26 @@ type ResultOptions struct {
 	Factory            util.Factory
 	Streams            *genericclioptions.IOStreams
 	ResultOutputFormat string
+	Trace              bool
+	// filter selects the traces of a resource, set by Validate
+	filter *trace.Filter
 }
 
 func (r *ResultOptions) Validate(args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	if !r.Trace {
+		return fmt.Errorf("a resource can only be provided with --trace")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("expected at most one resource, got %d", len(args))
+	}
+	filter, err := trace.ParseFilter(args[0])
+	if err != nil {
+		return err
+	}
+	r.filter = filter
 	return nil
 }
 
@@ -101,11 +128,34 @@ func (r *ResultOptions) Run(ctx context.Context, args []string) error {
 	if err != nil {
 		return err
 	}
+	if r.Trace {
+		r.printTraces(rsp)
+		return nil
+	}
 	r.handleRunResponse(rsp)
 
 	return nil
 }
 
+func (r *ResultOptions) printTraces(rsp *runnerpb.Once_RunResponse) {
+	w := r.Streams.Out
+	traced := false
+	for _, runResult := range rsp.Results {
+		for _, result := range runResult.Results {
+			if result.Trace == nil {
+				continue
+			}
+			traced = true
+			if r.filter.Matches(result) {
+				trace.Print(w, result)
+			}
+		}
+	}
+	if !traced {
+		fmt.Fprintf(w, "no traces recorded, use run once --trace\n")
+	}
+}
+
 func (r *ResultOptions) handleRunResponse(rsp *runnerpb.Once_RunResponse) {
 	if !rsp.Success {
 		// failed
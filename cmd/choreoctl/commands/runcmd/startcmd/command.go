@@ -85,7 +85,8 @@ func (r *StartOptions) Validate(args []string) error {
 func (r *StartOptions) Run(ctx context.Context, args []string) error {
 	runnerClient := r.Factory.GetRunnerClient()
 	if err := runnerClient.Start(ctx, &runnerclient.StartOptions{
-		Proxy: r.Factory.GetProxy(),
+		Proxy:  r.Factory.GetProxy(),
+		Branch: r.Factory.GetBranch(),
 	}); err != nil {
 		return err
 	}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statuscmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/client/go/runnerclient"
+	"github.com/kform-dev/choreo/pkg/client/go/util"
+	"github.com/spf13/cobra"
+	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
+)
+
+func NewCmdStatus(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Command {
+	flags := NewStatusFlags()
+
+	cmd := &cobra.Command{
+		Use:   "status [flags]",
+		Short: "show the status of the runner per branch",
+		//Args:  cobra.ExactArgs(1),
+		//Short:   docs.InitShort,
+		//Long:    docs.InitShort + "\n" + docs.InitLong,
+		//Example: docs.InitExamples,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			o, err := flags.ToOptions(cmd, f, streams)
+			if err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(ctx, args)
+		},
+	}
+	flags.AddFlags(cmd)
+	return cmd
+}
+
+type StatusFlags struct {
+}
+
+// The defaults are determined here
+func NewStatusFlags() *StatusFlags {
+	return &StatusFlags{}
+}
+
+// AddFlags add flags tp the command
+func (r *StatusFlags) AddFlags(cmd *cobra.Command) {
+}
+
+// ToOptions renders the options based on the flags that were set and will be the base context used to run the command
+func (r *StatusFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *genericclioptions.IOStreams) (*StatusOptions, error) {
+	options := &StatusOptions{
+		Factory: f,
+		Streams: streams,
+	}
+	return options, nil
+}
+
+type StatusOptions struct {
+	Factory util.Factory
+	Streams *genericclioptions.IOStreams
+}
+
+func (r *StatusOptions) Validate(args []string) error {
+	return nil
+}
+
+func (r *StatusOptions) Run(ctx context.Context, args []string) error {
+	w := r.Streams.Out
+
+	runnerClient := r.Factory.GetRunnerClient()
+	rsp, err := runnerClient.Status(ctx, &runnerclient.StatusOptions{
+		Proxy:  r.Factory.GetProxy(),
+		Branch: r.Factory.GetBranch(),
+	})
+	if err != nil {
+		return err
+	}
+	var errm error
+	for _, branch := range rsp.GetBranches() {
+		checkedOut := ""
+		if branch.GetCheckedOut() {
+			checkedOut = "*"
+		}
+		if _, err := fmt.Fprintf(w, "%s%s %s\n", checkedOut, branch.GetBranch(), branch.GetStatus()); err != nil {
+			errm = errors.Join(errm, err)
+		}
+	}
+	return errm
+}
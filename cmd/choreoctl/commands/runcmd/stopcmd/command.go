@@ -85,7 +85,8 @@ func (r *StopOptions) Validate(args []string) error {
 func (r *StopOptions) Run(ctx context.Context, args []string) error {
 	runnerClient := r.Factory.GetRunnerClient()
 	if err := runnerClient.Stop(ctx, &runnerclient.StopOptions{
-		Proxy: r.Factory.GetProxy(),
+		Proxy:  r.Factory.GetProxy(),
+		Branch: r.Factory.GetBranch(),
 	}); err != nil {
 		return err
 	}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tagcmd
+
+import (
+	"context"
+
+	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/client/go/snapshotclient"
+	"github.com/kform-dev/choreo/pkg/client/go/util"
+	"github.com/spf13/cobra"
+	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
+)
+
+func NewCmdTag(f util.Factory, streams *genericclioptions.IOStreams) *cobra.Command {
+	flags := NewTagFlags()
+
+	cmd := &cobra.Command{
+		Use:   "tag ID [TAG] [flags]",
+		Short: "tag a snapshot, omitting the tag removes it",
+		Args:  cobra.RangeArgs(1, 2),
+		//Short:   docs.InitShort,
+		//Long:    docs.InitShort + "\n" + docs.InitLong,
+		//Example: docs.InitExamples,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			o, err := flags.ToOptions(cmd, f, streams)
+			if err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(ctx, args)
+		},
+	}
+	flags.AddFlags(cmd)
+	return cmd
+}
+
+type TagFlags struct {
+}
+
+// The defaults are determined here
+func NewTagFlags() *TagFlags {
+	return &TagFlags{}
+}
+
+// AddFlags add flags tp the command
+func (r *TagFlags) AddFlags(cmd *cobra.Command) {
+}
+
+// ToOptions renders the options based on the flags that were set and will be the base context used to run the command
+func (r *TagFlags) ToOptions(cmd *cobra.Command, f util.Factory, streams *genericclioptions.IOStreams) (*TagOptions, error) {
+	options := &TagOptions{
+		Factory: f,
+		Streams: streams,
+	}
+	return options, nil
+}
+
+type TagOptions struct {
+	Factory util.Factory
+	Streams *genericclioptions.IOStreams
+}
+
+func (r *TagOptions) Validate(args []string) error {
+	return nil
+}
+
+func (r *TagOptions) Run(ctx context.Context, args []string) error {
+	tag := ""
+	if len(args) > 1 {
+		tag = args[1]
+	}
+
+	snapshotClient := r.Factory.GetSnapshotClient()
+	return snapshotClient.Tag(ctx, args[0], tag, &snapshotclient.TagOptions{
+		Proxy: r.Factory.GetProxy(),
+	})
+}
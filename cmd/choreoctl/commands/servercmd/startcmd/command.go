@@ -24,9 +24,12 @@ import (
 	"github.com/henderiw/logger/log"
 	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
 	"github.com/kform-dev/choreo/pkg/proto/choreopb"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/registry"
 	"github.com/kform-dev/choreo/pkg/server/choreo"
 	"github.com/kform-dev/choreo/pkg/server/grpcserver"
 	"github.com/kform-dev/choreo/pkg/server/health"
+	"github.com/kform-dev/choreo/pkg/server/httpserver"
 	"github.com/kform-dev/kform/pkg/fsys"
 	"github.com/spf13/cobra"
 	//docs "github.com/kform-dev/kform/internal/docs/generated/applydocs"
@@ -85,6 +88,11 @@ type StartOptions struct {
 }
 
 func (r *StartOptions) Validate(args []string) error {
+	if r.cfg.ServerFlags.DBBackend != nil {
+		if _, err := registry.ParseBackend(*r.cfg.ServerFlags.DBBackend); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -106,6 +114,20 @@ func (r *StartOptions) Run(ctx context.Context, args []string) error {
 		}
 	}()
 
+	if r.cfg.ServerFlags.HTTPAddress != nil && *r.cfg.ServerFlags.HTTPAddress != "" {
+		// build the http server which exposes the resources with the kubernetes api conventions
+		httpserver := httpserver.New(&httpserver.Config{
+			Name:    r.ServerName,
+			Address: *r.cfg.ServerFlags.HTTPAddress,
+			Choreo:  choreo,
+		})
+		go func() {
+			if err := httpserver.Run(ctx); err != nil {
+				log.Error("http server failed", "err", err)
+			}
+		}()
+	}
+
 	time.Sleep(1 * time.Second)
 	if !health.IsServerReady(ctx, &health.Config{Address: *r.cfg.ChoreoFlags.Address}) {
 		return fmt.Errorf("server is not ready")
@@ -130,5 +152,9 @@ func (r *StartOptions) Run(ctx context.Context, args []string) error {
 
 	<-ctx.Done()
 	log.Debug("context concelled")
+	// release the database files of the bolt backend
+	if err := boltstore.CloseAll(); err != nil {
+		log.Error("cannot close db", "err", err)
+	}
 	return nil
 }
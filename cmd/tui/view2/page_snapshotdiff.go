@@ -0,0 +1,166 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/client/go/snapshotclient"
+	"github.com/rivo/tview"
+)
+
+type SnapshotDiffPage struct {
+	*tview.TextView
+
+	parent Page
+	app    *App
+	name   string
+	title  string
+	from   string
+	to     string
+	view   *tview.Flex
+	style  tcell.Style
+}
+
+func NewSnapshotDiffPage(ctx context.Context, parent Page, from, to string) Page {
+	app, err := extractApp(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	r := &SnapshotDiffPage{
+		name:     "snapshotdiff",
+		title:    fmt.Sprintf("  Diff: %s -> %s  ", tview.Escape(from), tview.Escape(to)),
+		TextView: tview.NewTextView(),
+		app:      app,
+		from:     from,
+		to:       to,
+		style: tcell.StyleDefault.
+			Foreground(tcell.ColorLightSkyBlue).
+			Background(tcell.ColorBlack),
+		parent: parent,
+	}
+	r.SetTextView(ctx)
+	// TODO Mousecapture
+	r.SetView()
+	app.pages.AddPage(r.name, r.view, true, true)
+	return r
+}
+
+func (r *SnapshotDiffPage) SetTextView(ctx context.Context) {
+	r.TextView.
+		SetDynamicColors(true).
+		SetDoneFunc(func(key tcell.Key) {
+			r.DeActivatePage(ctx)
+		}).
+		SetTextStyle(r.style)
+}
+
+func (r *SnapshotDiffPage) SetView() {
+	view := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(r.TextView, 0, 1, true)
+	view.
+		SetBorder(true).
+		SetTitle(r.title).
+		SetBorderStyle(r.style)
+	r.view = view
+}
+
+func (r *SnapshotDiffPage) RegisterPageAction(ctx context.Context) {
+	// this would register local keys of the page to help actions
+}
+
+func (r *SnapshotDiffPage) DeActivatePage(ctx context.Context) {
+	r.parent.ActivatePage(ctx)
+}
+
+func (r *SnapshotDiffPage) ActivatePage(ctx context.Context) {
+	// init action
+	r.app.actions = NewKeyActions()
+	r.app.header.InitPageAction()
+	r.app.pages.RegisterPageAction(ctx)
+
+	// activate page action
+	r.app.header.ActivatePageAction("") //
+
+	// activate local page
+	r.app.pages.SwitchToPage(r.name)
+	r.app.SetFocus(r.TextView)
+	r.TextView.SetInputCapture(r.HandleInput)
+	if err := r.Update(ctx); err != nil {
+		r.TextView.SetText(tview.Escape(err.Error()))
+	}
+	r.app.ForceDraw()
+}
+
+// In your page implementation
+func (r *SnapshotDiffPage) HandleInput(event *tcell.EventKey) *tcell.EventKey {
+	return event // Propagate to global handler
+}
+
+func (r *SnapshotDiffPage) Update(ctx context.Context) error {
+	b, err := r.app.factory.GetSnapshotClient().Diff(ctx, &snapshotclient.DiffOptions{
+		Proxy: r.app.factory.GetProxy(),
+		From:  r.from,
+		To:    r.to,
+	})
+	if err != nil {
+		return err
+	}
+	diff := &choreov1alpha1.Diff{}
+	if err := json.Unmarshal(b, diff); err != nil {
+		return err
+	}
+
+	diffItems := diff.Status.Items
+	sort.Slice(diffItems, func(i, j int) bool {
+		return fmt.Sprintf("%s.%s", diffItems[i].GetGVK().String(), diffItems[i].Name) <
+			fmt.Sprintf("%s.%s", diffItems[j].GetGVK().String(), diffItems[j].Name)
+	})
+
+	var sb strings.Builder
+	for _, diffItem := range diffItems {
+		sb.WriteString(fmt.Sprintf("[%s]%s %s %s[-]\n", getDiffItemColor(diffItem.Status), diffItem.GetStatusSymbol(), diffItem.GetGVK().String(), diffItem.Name))
+		if diffItem.Diff != nil {
+			sb.WriteString(tview.Escape(*diffItem.Diff))
+			sb.WriteString("\n")
+		}
+	}
+	r.TextView.SetText(sb.String())
+	r.TextView.ScrollToBeginning()
+	return nil
+}
+
+func getDiffItemColor(status choreov1alpha1.DiffitemStatus) string {
+	switch status {
+	case choreov1alpha1.DiffitemStatus_Added:
+		return "green"
+	case choreov1alpha1.DiffitemStatus_Deleted:
+		return "red"
+	case choreov1alpha1.DiffitemStatus_Modified:
+		return "yellow"
+	default:
+		return "white"
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/client/go/snapshotclient"
+	"github.com/rivo/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type SnapshotsPage struct {
+	HeaderTable *tview.Table
+	DataTable   *tview.Table
+
+	app   *App
+	name  string
+	title string
+	view  *tview.Flex
+	style tcell.Style
+	// from and to identify the snapshots that are compared
+	// an empty value selects the default of the server
+	from string
+	to   string
+}
+
+func NewSnapshotsPage(ctx context.Context, pages *Pages) Page {
+	app, err := extractApp(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	r := &SnapshotsPage{
+		name:        "snapshots",
+		title:       "Snapshots",
+		HeaderTable: tview.NewTable(),
+		DataTable:   tview.NewTable(),
+		app:         app,
+		style: tcell.StyleDefault.
+			Foreground(tcell.ColorLightSkyBlue).
+			Background(tcell.ColorBlack),
+	}
+	r.SetTable(ctx)
+	// TODO Mousecapture
+	r.SetView()
+	pages.AddPage(r.name, r.view, true, true)
+	return r
+}
+
+func (r *SnapshotsPage) SetTable(ctx context.Context) {
+	r.HeaderTable.SetBackgroundColor(tcell.ColorBlack)
+	r.HeaderTable.SetFixed(1, 0) // set header fixed
+	r.DataTable.SetBackgroundColor(tcell.ColorBlack)
+	r.DataTable.SetFixed(0, 0)
+	r.DataTable.
+		SetSelectable(true, false).
+		Select(0, 0).
+		SetSelectedFunc(func(row, column int) {
+			r.actvateChildPage(ctx)
+		}).
+		ScrollToBeginning()
+}
+
+func (r *SnapshotsPage) SetView() {
+	view := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(r.HeaderTable, 1, 1, false).
+		AddItem(r.DataTable, 0, 1, true)
+	view.
+		SetBorder(true).
+		SetTitle(r.getTitle()).
+		SetBlurFunc(func() {
+			if r.app.ticker != nil {
+				r.app.ticker.stop()
+				r.app.ticker = nil
+			}
+		}).
+		SetBorderStyle(r.style)
+	r.view = view
+}
+
+func (r *SnapshotsPage) RegisterPageAction(ctx context.Context) {
+	ka := KeyAction{
+		Key:       KeyS,
+		ShortName: r.name,
+		Action: func() {
+			r.ActivatePage(ctx)
+		},
+	}
+	// propagate keys
+	r.app.actions.Add(ka)
+	// add key to menu table
+	r.app.header.cmdMenu.actions.Add(ka)
+}
+
+// registerLocalPageAction registers the keys to select the snapshots that are compared
+func (r *SnapshotsPage) registerLocalPageAction() {
+	for _, ka := range []KeyAction{
+		{Key: KeyF, ShortName: "diff from", Action: func() { r.setFrom(r.getSelectedID()) }},
+		{Key: KeyT, ShortName: "diff to", Action: func() { r.setTo(r.getSelectedID()) }},
+		{Key: KeyE, ShortName: "diff from empty", Action: func() { r.setFrom(choreov1alpha1.SnapshotEmptyID) }},
+	} {
+		r.app.actions.Add(ka)
+		r.app.header.cmdMenu.actions.Add(ka)
+	}
+}
+
+func (r *SnapshotsPage) ActivatePage(ctx context.Context) {
+	// init action
+	r.app.actions = NewKeyActions()
+	r.app.header.InitPageAction()
+	r.app.pages.RegisterPageAction(ctx) // central main page keys
+	r.registerLocalPageAction()
+
+	// activate page action
+	r.app.header.ActivatePageAction("main")
+
+	r.app.pages.SwitchToPage(r.name)
+	r.app.SetFocus(r.DataTable)
+	r.DataTable.SetInputCapture(r.HandleInput)
+	if err := r.Update(ctx); err != nil {
+		r.view.SetTitle(fmt.Sprintf("  %s: %s  ", r.title, err.Error()))
+	}
+	r.app.ForceDraw()
+}
+
+// In your page implementation
+func (r *SnapshotsPage) HandleInput(event *tcell.EventKey) *tcell.EventKey {
+	return event // Propagate to global handler
+}
+
+func (r *SnapshotsPage) Update(ctx context.Context) error {
+	ul := &unstructured.UnstructuredList{}
+	ul.SetAPIVersion(choreov1alpha1.SchemeGroupVersion.String())
+	ul.SetKind(choreov1alpha1.SnapshotListKind)
+	if err := r.app.factory.GetSnapshotClient().List(ctx, ul, &snapshotclient.ListOptions{
+		Proxy: r.app.factory.GetProxy(),
+	}); err != nil {
+		return err
+	}
+
+	r.DataTable.Clear()
+	for i, u := range ul.Items {
+		u := u
+		InsertRowAt(r.DataTable, i, &u, GetSnapshotRowData(&u), r.style)
+	}
+	columnWidth := CalculateMaxWidths(r.DataTable)
+	SetTableHeader(r.HeaderTable, columnWidth, "ID", "Created", "Tag")
+	r.view.SetTitle(r.getTitle())
+	r.HeaderTable.ScrollToBeginning()
+	r.DataTable.ScrollToBeginning()
+	return nil
+}
+
+func (r *SnapshotsPage) getSelectedID() string {
+	row, _ := r.DataTable.GetSelection()
+	u, ok := r.DataTable.GetCell(row, 0).GetReference().(*unstructured.Unstructured)
+	if !ok {
+		return ""
+	}
+	return u.GetName()
+}
+
+func (r *SnapshotsPage) setFrom(id string) {
+	r.from = id
+	r.view.SetTitle(r.getTitle())
+}
+
+func (r *SnapshotsPage) setTo(id string) {
+	r.to = id
+	r.view.SetTitle(r.getTitle())
+}
+
+func (r *SnapshotsPage) getTitle() string {
+	from := r.from
+	if from == "" {
+		from = "previous"
+	}
+	to := r.to
+	if to == "" {
+		to = "latest"
+	}
+	return fmt.Sprintf("  %s [diff %s -> %s]  ", r.title, tview.Escape(from), tview.Escape(to))
+}
+
+func (r *SnapshotsPage) actvateChildPage(ctx context.Context) {
+	p := NewSnapshotDiffPage(ctx, r, r.from, r.to)
+	p.ActivatePage(ctx)
+}
+
+func GetSnapshotRowData(u *unstructured.Unstructured) []string {
+	row := []string{}
+	row = append(row, u.GetName())
+	row = append(row, u.GetCreationTimestamp().String())
+	row = append(row, u.GetLabels()[choreov1alpha1.ChoreoSnapshotTagKey])
+	return row
+}
@@ -46,6 +46,7 @@ func NewPages(ctx context.Context) *Pages {
 	r.mainPages["dummy"] = NewDummy(ctx, r)
 	r.mainPages["resources"] = NewResources(ctx, r)
 	r.mainPages["branch"] = NewBranchPage(ctx, r)
+	r.mainPages["snapshots"] = NewSnapshotsPage(ctx, r)
 
 	return r
 }
@@ -0,0 +1,226 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package explain
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+const indent = "  "
+
+// SplitFieldPath splits the argument <resource>.<group>[.field.path] in the resource and the
+// field path, based on the resources known to the server. A resource can also be referenced
+// without group when the name is unique.
+func SplitFieldPath(arg string, apiResources []*discoverypb.APIResource) (*discoverypb.APIResource, []string, error) {
+	var match *discoverypb.APIResource
+	var rest string
+	// the longest <resource>.<group> prefix wins
+	for _, apiResource := range apiResources {
+		name := fmt.Sprintf("%s.%s", apiResource.Resource, apiResource.Group)
+		if arg != name && !strings.HasPrefix(arg, name+".") {
+			continue
+		}
+		if match == nil || len(name) > len(match.Resource)+len(match.Group)+1 {
+			match = apiResource
+			rest = strings.TrimPrefix(strings.TrimPrefix(arg, name), ".")
+		}
+	}
+	if match == nil {
+		resource, path, _ := strings.Cut(arg, ".")
+		for _, apiResource := range apiResources {
+			if apiResource.Resource != resource {
+				continue
+			}
+			if match != nil {
+				return nil, nil, fmt.Errorf("resource %s is ambiguous, use <resource>.<group>", resource)
+			}
+			match = apiResource
+			rest = path
+		}
+	}
+	if match == nil {
+		return nil, nil, fmt.Errorf("cannot find a resource for %s", arg)
+	}
+	if rest == "" {
+		return match, []string{}, nil
+	}
+	return match, strings.Split(rest, "."), nil
+}
+
+// LookupField returns the schema of the field path; arrays are traversed transparently
+func LookupField(schema *apiextensionsv1.JSONSchemaProps, fieldPath []string) (*apiextensionsv1.JSONSchemaProps, error) {
+	current := schema
+	for i, field := range fieldPath {
+		current = elementSchema(current)
+		prop, ok := current.Properties[field]
+		if !ok {
+			if current.AdditionalProperties != nil && current.AdditionalProperties.Schema != nil {
+				// the key of a map
+				current = current.AdditionalProperties.Schema
+				continue
+			}
+			return nil, fmt.Errorf("field %q does not exist", strings.Join(fieldPath[:i+1], "."))
+		}
+		current = &prop
+	}
+	return current, nil
+}
+
+// elementSchema returns the schema of the elements of an array
+func elementSchema(schema *apiextensionsv1.JSONSchemaProps) *apiextensionsv1.JSONSchemaProps {
+	for schema.Type == "array" && schema.Items != nil && schema.Items.Schema != nil {
+		schema = schema.Items.Schema
+	}
+	return schema
+}
+
+// TypeName returns the type of the schema as displayed by explain
+func TypeName(schema *apiextensionsv1.JSONSchemaProps) string {
+	switch {
+	case schema.XIntOrString:
+		return "IntOrString"
+	case schema.Type == "array":
+		if schema.Items != nil && schema.Items.Schema != nil {
+			return "[]" + TypeName(schema.Items.Schema)
+		}
+		return "[]Object"
+	case schema.Type == "object" || (schema.Type == "" && len(schema.Properties) > 0):
+		if len(schema.Properties) == 0 && schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			return "map[string]" + TypeName(schema.AdditionalProperties.Schema)
+		}
+		return "Object"
+	case schema.Type == "":
+		return "Object"
+	default:
+		return schema.Type
+	}
+}
+
+// Explain writes the documentation of the field path of the resource
+func Explain(w io.Writer, apiResource *discoverypb.APIResource, schema *apiextensionsv1.JSONSchemaProps, fieldPath []string, recursive bool) error {
+	field, err := LookupField(schema, fieldPath)
+	if err != nil {
+		return err
+	}
+	p := &printer{w: w}
+	p.printf("GROUP:      %s\n", apiResource.Group)
+	p.printf("KIND:       %s\n", apiResource.Kind)
+	p.printf("VERSION:    %s\n\n", apiResource.Version)
+	if len(fieldPath) > 0 {
+		p.printf("FIELD: %s <%s>\n\n", fieldPath[len(fieldPath)-1], TypeName(field))
+	}
+	p.printf("DESCRIPTION:\n")
+	p.printDescription(indent+indent, field)
+
+	element := elementSchema(field)
+	if len(element.Properties) == 0 {
+		return p.err
+	}
+	p.printf("\nFIELDS:\n")
+	if recursive {
+		p.printFieldsRecursive(indent, element)
+	} else {
+		p.printFields(element)
+	}
+	return p.err
+}
+
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (r *printer) printf(format string, a ...any) {
+	if r.err != nil {
+		return
+	}
+	_, r.err = fmt.Fprintf(r.w, format, a...)
+}
+
+func (r *printer) printDescription(prefix string, schema *apiextensionsv1.JSONSchemaProps) {
+	description := strings.TrimSpace(schema.Description)
+	if description == "" {
+		description = "<empty>"
+	}
+	for _, line := range strings.Split(description, "\n") {
+		r.printf("%s%s\n", prefix, strings.TrimSpace(line))
+	}
+	if len(schema.Enum) > 0 {
+		values := make([]string, 0, len(schema.Enum))
+		for _, v := range schema.Enum {
+			values = append(values, string(v.Raw))
+		}
+		r.printf("%senum: %s\n", prefix, strings.Join(values, ", "))
+	}
+	if schema.Default != nil {
+		r.printf("%sdefault: %s\n", prefix, string(schema.Default.Raw))
+	}
+	if schema.Format != "" {
+		r.printf("%sformat: %s\n", prefix, schema.Format)
+	}
+	if schema.Pattern != "" {
+		r.printf("%spattern: %s\n", prefix, schema.Pattern)
+	}
+}
+
+func sortedFields(schema *apiextensionsv1.JSONSchemaProps) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func isRequired(schema *apiextensionsv1.JSONSchemaProps, name string) bool {
+	for _, required := range schema.Required {
+		if required == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *printer) printFields(schema *apiextensionsv1.JSONSchemaProps) {
+	for _, name := range sortedFields(schema) {
+		prop := schema.Properties[name]
+		r.printf("%s%s\t<%s>%s\n", indent, name, TypeName(&prop), requiredSuffix(schema, name))
+		r.printDescription(indent+indent, &prop)
+		r.printf("\n")
+	}
+}
+
+func (r *printer) printFieldsRecursive(prefix string, schema *apiextensionsv1.JSONSchemaProps) {
+	for _, name := range sortedFields(schema) {
+		prop := schema.Properties[name]
+		r.printf("%s%s\t<%s>%s\n", prefix, name, TypeName(&prop), requiredSuffix(schema, name))
+		r.printFieldsRecursive(prefix+indent, elementSchema(&prop))
+	}
+}
+
+func requiredSuffix(schema *apiextensionsv1.JSONSchemaProps, name string) string {
+	if isRequired(schema, name) {
+		return " -required-"
+	}
+	return ""
+}
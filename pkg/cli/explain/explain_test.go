@@ -0,0 +1,215 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package explain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+var apiResources = []*discoverypb.APIResource{
+	{Resource: "nodes", Group: "example.com", Version: "v1alpha1", Kind: "Node"},
+	{Resource: "nodes", Group: "infra.example.com", Version: "v1alpha1", Kind: "Node"},
+	{Resource: "links", Group: "example.com", Version: "v1alpha1", Kind: "Link"},
+}
+
+func TestSplitFieldPath(t *testing.T) {
+	cases := map[string]struct {
+		arg               string
+		expectedGroup     string
+		expectedResource  string
+		expectedFieldPath []string
+		expectedErr       bool
+	}{
+		"Resource": {
+			arg:               "nodes.example.com",
+			expectedGroup:     "example.com",
+			expectedResource:  "nodes",
+			expectedFieldPath: []string{},
+		},
+		"FieldPath": {
+			arg:               "nodes.infra.example.com.spec.interfaces",
+			expectedGroup:     "infra.example.com",
+			expectedResource:  "nodes",
+			expectedFieldPath: []string{"spec", "interfaces"},
+		},
+		"WithoutGroup": {
+			arg:               "links.spec",
+			expectedGroup:     "example.com",
+			expectedResource:  "links",
+			expectedFieldPath: []string{"spec"},
+		},
+		"Ambiguous": {
+			arg:         "nodes.spec",
+			expectedErr: true,
+		},
+		"Unknown": {
+			arg:         "unknowns.example.com",
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apiResource, fieldPath, err := SplitFieldPath(tc.arg, apiResources)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error for %s", tc.arg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if apiResource.Group != tc.expectedGroup || apiResource.Resource != tc.expectedResource {
+				t.Errorf("expected %s.%s, got %s.%s", tc.expectedResource, tc.expectedGroup, apiResource.Resource, apiResource.Group)
+			}
+			if diff := cmp.Diff(tc.expectedFieldPath, fieldPath); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func testSchema() *apiextensionsv1.JSONSchemaProps {
+	return &apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"spec": {
+				Type:        "object",
+				Description: "NodeSpec defines the desired state of a Node",
+				Required:    []string{"provider"},
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"provider": {
+						Type:        "string",
+						Description: "Provider of the node",
+						Enum:        []apiextensionsv1.JSON{{Raw: []byte(`"srl"`)}, {Raw: []byte(`"sros"`)}},
+					},
+					"interfaces": {
+						Type: "array",
+						Items: &apiextensionsv1.JSONSchemaPropsOrArray{Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"mtu": {
+									Type:    "integer",
+									Default: &apiextensionsv1.JSON{Raw: []byte(`1500`)},
+								},
+							},
+						}},
+					},
+					"labels": {
+						Type: "object",
+						AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "string",
+						}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExplain(t *testing.T) {
+	cases := map[string]struct {
+		fieldPath   []string
+		recursive   bool
+		expected    string
+		expectedErr bool
+	}{
+		"Field": {
+			fieldPath: []string{"spec"},
+			expected: `GROUP:      example.com
+KIND:       Node
+VERSION:    v1alpha1
+
+FIELD: spec <Object>
+
+DESCRIPTION:
+    NodeSpec defines the desired state of a Node
+
+FIELDS:
+  interfaces	<[]Object>
+    <empty>
+
+  labels	<map[string]string>
+    <empty>
+
+  provider	<string> -required-
+    Provider of the node
+    enum: "srl", "sros"
+
+`,
+		},
+		"ArrayElement": {
+			fieldPath: []string{"spec", "interfaces", "mtu"},
+			expected: `GROUP:      example.com
+KIND:       Node
+VERSION:    v1alpha1
+
+FIELD: mtu <integer>
+
+DESCRIPTION:
+    <empty>
+    default: 1500
+`,
+		},
+		"Recursive": {
+			fieldPath: []string{"spec"},
+			recursive: true,
+			expected: `GROUP:      example.com
+KIND:       Node
+VERSION:    v1alpha1
+
+FIELD: spec <Object>
+
+DESCRIPTION:
+    NodeSpec defines the desired state of a Node
+
+FIELDS:
+  interfaces	<[]Object>
+    mtu	<integer>
+  labels	<map[string]string>
+  provider	<string> -required-
+`,
+		},
+		"UnknownField": {
+			fieldPath:   []string{"spec", "unknown"},
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			err := Explain(b, apiResources[0], testSchema(), tc.fieldPath, tc.recursive)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expected, b.String()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
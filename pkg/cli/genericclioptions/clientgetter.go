@@ -17,6 +17,7 @@ limitations under the License.
 package genericclioptions
 
 import (
+	"github.com/kform-dev/choreo/pkg/client/go/auditclient"
 	"github.com/kform-dev/choreo/pkg/client/go/branchclient"
 	"github.com/kform-dev/choreo/pkg/client/go/choreoclient"
 	"github.com/kform-dev/choreo/pkg/client/go/config"
@@ -45,6 +46,8 @@ type ClientGetter interface {
 	ToRunnerClient() (runnerclient.Client, error)
 	// TosnapshotClient returns snapshot client
 	ToSnapshotClient() (snapshotclient.Client, error)
+	// ToAuditClient returns audit client
+	ToAuditClient() (auditclient.Client, error)
 	// Branch()
 	ToBranch() string
 	// Proxy()
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/kform-dev/choreo/pkg/client/go/auditclient"
 	"github.com/kform-dev/choreo/pkg/client/go/branchclient"
 	"github.com/kform-dev/choreo/pkg/client/go/choreoclient"
 	"github.com/kform-dev/choreo/pkg/client/go/config"
@@ -132,6 +133,11 @@ func (r *ChoreoConfig) ToSnapshotClient() (snapshotclient.Client, error) {
 	return snapshotclient.NewClient(config)
 }
 
+func (r *ChoreoConfig) ToAuditClient() (auditclient.Client, error) {
+	config := r.toConfig()
+	return auditclient.NewClient(config)
+}
+
 func (r *ChoreoConfig) ToBranch() string {
 	if r.ClientFlags.Branch == nil {
 		return ""
@@ -19,6 +19,7 @@ package genericclioptions
 import (
 	"fmt"
 
+	"github.com/kform-dev/choreo/pkg/client/go/auditclient"
 	"github.com/kform-dev/choreo/pkg/client/go/branchclient"
 	"github.com/kform-dev/choreo/pkg/client/go/choreoclient"
 	"github.com/kform-dev/choreo/pkg/client/go/config"
@@ -71,6 +72,11 @@ func (NoopClientGetter) ToSnapshotClient() (snapshotclient.Client, error) {
 	return nil, fmt.Errorf("local operation only")
 }
 
+// ToAuditClient returns audit client
+func (NoopClientGetter) ToAuditClient() (auditclient.Client, error) {
+	return nil, fmt.Errorf("local operation only")
+}
+
 // Branch()
 func (NoopClientGetter) ToBranch() string { return "" }
 
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package genericclioptions
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	FlagDryRun = "dry-run"
+)
+
+type DryRunStrategy int
+
+const (
+	// DryRunNone indicates the request is persisted
+	DryRunNone DryRunStrategy = iota
+	// DryRunServer indicates the request is processed by the server, which
+	// returns the resulting object without persisting it
+	DryRunServer
+)
+
+// DryRun returns the dryRun values of a request for the strategy
+func (r DryRunStrategy) DryRun() []string {
+	if r == DryRunServer {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// DryRunFlags are flags for requests that can be processed without persisting the result.
+type DryRunFlags struct {
+	DryRun *string
+}
+
+func NewDryRunFlags() *DryRunFlags {
+	return &DryRunFlags{
+		DryRun: ptr.To("none"),
+	}
+}
+
+// AddFlags binds dry run flags to a given flagset
+func (r *DryRunFlags) AddFlags(flags *pflag.FlagSet) {
+	if r == nil {
+		return
+	}
+
+	if r.DryRun != nil {
+		flags.StringVar(r.DryRun, FlagDryRun, *r.DryRun,
+			`Must be "none" or "server". If server strategy, submit server-side request without persisting the resource.`)
+	}
+}
+
+// ToStrategy returns the dry run strategy of the flags
+func (r *DryRunFlags) ToStrategy() (DryRunStrategy, error) {
+	if r == nil || r.DryRun == nil {
+		return DryRunNone, nil
+	}
+	switch *r.DryRun {
+	case "", "none":
+		return DryRunNone, nil
+	case "server":
+		return DryRunServer, nil
+	default:
+		return DryRunNone, fmt.Errorf(`invalid dry-run value %q, must be "none" or "server"`, *r.DryRun)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package genericclioptions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+)
+
+func TestToStrategy(t *testing.T) {
+	cases := map[string]struct {
+		dryRun      *string
+		strategy    DryRunStrategy
+		values      []string
+		expectedErr bool
+	}{
+		"Nil": {
+			dryRun:   nil,
+			strategy: DryRunNone,
+		},
+		"None": {
+			dryRun:   ptr.To("none"),
+			strategy: DryRunNone,
+		},
+		"Server": {
+			dryRun:   ptr.To("server"),
+			strategy: DryRunServer,
+			values:   []string{"All"},
+		},
+		"Client": {
+			dryRun:      ptr.To("client"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			flags := NewDryRunFlags()
+			flags.DryRun = tc.dryRun
+			strategy, err := flags.ToStrategy()
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("%s, expected error got nil", name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("%s, unexpected error: %v", name, err)
+			}
+			if strategy != tc.strategy {
+				t.Errorf("%s, expected strategy %d got %d", name, tc.strategy, strategy)
+			}
+			if diff := cmp.Diff(tc.values, strategy.DryRun()); diff != "" {
+				t.Errorf("%s, dryRun values -want +got:\n%s", name, diff)
+			}
+		})
+	}
+}
@@ -22,8 +22,9 @@ import (
 )
 
 const (
-	FlagNamespace    = "namespace"
-	defaultNamespace = "default"
+	FlagNamespace     = "namespace"
+	FlagAllNamespaces = "all-namespaces"
+	defaultNamespace  = "default"
 )
 
 // ResourceFlags are flags for generic resources.
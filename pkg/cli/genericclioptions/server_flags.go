@@ -17,6 +17,8 @@ limitations under the License.
 package genericclioptions
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 	"k8s.io/utils/ptr"
 )
@@ -25,6 +27,7 @@ const (
 	FlagServerName          = "servername"
 	flagAPIs                = "apis"
 	flagDB                  = "db"
+	flagDBBackend           = "dbBackend"
 	flagReconcilers         = "reconcilers"
 	flagLibraries           = "libraries"
 	flagInput               = "input"
@@ -35,6 +38,18 @@ const (
 	flagRunningConfigs      = "runningConfigs"
 	flagInternalReconcilers = "internalReconcilers"
 	flagSDC                 = "sdc"
+	flagSnapshotMaxCount    = "snapshotMaxCount"
+	flagSnapshotMaxAge      = "snapshotMaxAge"
+	flagSnapshotKeepTagged  = "snapshotKeepTagged"
+	flagReconcilerMaxSteps  = "reconcilerMaxSteps"
+	flagReconcilerTimeout   = "reconcilerTimeout"
+	flagReconcilerWaves     = "reconcilerWaves"
+	flagReconcilerCycles    = "rejectReconcilerCycles"
+	flagOnceMaxIterations   = "onceMaxIterations"
+	flagOnceDeadline        = "onceDeadline"
+	flagHTTPAddress         = "httpAddress"
+	flagAuditMaxSize        = "auditMaxSize"
+	flagAuditMaxFiles       = "auditMaxFiles"
 )
 
 // ResourceFlags are flags for generic resources.
@@ -42,6 +57,7 @@ type ServerFlags struct {
 	ServerName          *string
 	CRDPath             *string
 	DBPath              *string
+	DBBackend           *string
 	ReconcilerPath      *string
 	LibraryPath         *string
 	PostProcessingPath  *string
@@ -52,6 +68,18 @@ type ServerFlags struct {
 	RunningConfigsPath  *string
 	InternalReconcilers *bool
 	SDC                 *bool
+	SnapshotMaxCount    *int
+	SnapshotMaxAge      *time.Duration
+	SnapshotKeepTagged  *bool
+	ReconcilerMaxSteps  *uint64
+	ReconcilerTimeout   *time.Duration
+	ReconcilerWaves     *bool
+	RejectCycles        *bool
+	OnceMaxIterations   *int64
+	OnceDeadline        *time.Duration
+	HTTPAddress         *string
+	AuditMaxSize        *int
+	AuditMaxFiles       *int
 }
 
 func NewServerFlags() *ServerFlags {
@@ -59,6 +87,7 @@ func NewServerFlags() *ServerFlags {
 		ServerName:          ptr.To("choreo"),
 		CRDPath:             ptr.To("crds"),
 		DBPath:              ptr.To("db"),
+		DBBackend:           ptr.To("git"),
 		ReconcilerPath:      ptr.To("reconcilers"),
 		LibraryPath:         ptr.To("libs"),
 		PostProcessingPath:  ptr.To("post"),
@@ -69,6 +98,18 @@ func NewServerFlags() *ServerFlags {
 		RunningConfigsPath:  ptr.To("runningconfigs"),
 		InternalReconcilers: ptr.To(false),
 		SDC:                 ptr.To(false),
+		SnapshotMaxCount:    ptr.To(50),
+		SnapshotMaxAge:      ptr.To(time.Duration(0)),
+		SnapshotKeepTagged:  ptr.To(true),
+		ReconcilerMaxSteps:  ptr.To(uint64(100_000_000)),
+		ReconcilerTimeout:   ptr.To(time.Minute),
+		ReconcilerWaves:     ptr.To(false),
+		RejectCycles:        ptr.To(false),
+		OnceMaxIterations:   ptr.To(int64(100)),
+		OnceDeadline:        ptr.To(10 * time.Minute),
+		HTTPAddress:         ptr.To(""),
+		AuditMaxSize:        ptr.To(10),
+		AuditMaxFiles:       ptr.To(5),
 	}
 }
 
@@ -89,6 +130,10 @@ func (r *ServerFlags) AddFlags(flags *pflag.FlagSet) {
 		flags.StringVar(r.DBPath, flagDB, *r.DBPath,
 			"the path where the db manifests are located")
 	}
+	if r.DBBackend != nil {
+		flags.StringVar(r.DBBackend, flagDBBackend, *r.DBBackend,
+			"the storage backend of the db: git (yaml files in the repo), bolt (single file database in the db path, no branch history) or memory")
+	}
 	if r.ReconcilerPath != nil {
 		flags.StringVar(r.ReconcilerPath, flagReconcilers, *r.ReconcilerPath,
 			"the path where the reconciler manifests are located")
@@ -125,4 +170,52 @@ func (r *ServerFlags) AddFlags(flags *pflag.FlagSet) {
 		flags.BoolVarP(r.SDC, flagSDC, "s", *r.SDC,
 			"enable sdc")
 	}
+	if r.SnapshotMaxCount != nil {
+		flags.IntVar(r.SnapshotMaxCount, flagSnapshotMaxCount, *r.SnapshotMaxCount,
+			"the maximum amount of snapshots that are retained, 0 means unlimited")
+	}
+	if r.SnapshotMaxAge != nil {
+		flags.DurationVar(r.SnapshotMaxAge, flagSnapshotMaxAge, *r.SnapshotMaxAge,
+			"the maximum age of the snapshots that are retained, 0 means unlimited")
+	}
+	if r.SnapshotKeepTagged != nil {
+		flags.BoolVar(r.SnapshotKeepTagged, flagSnapshotKeepTagged, *r.SnapshotKeepTagged,
+			"if true, tagged snapshots are never pruned")
+	}
+	if r.ReconcilerMaxSteps != nil {
+		flags.Uint64Var(r.ReconcilerMaxSteps, flagReconcilerMaxSteps, *r.ReconcilerMaxSteps,
+			"the default maximum amount of execution steps of a single reconcile, 0 means unlimited")
+	}
+	if r.ReconcilerTimeout != nil {
+		flags.DurationVar(r.ReconcilerTimeout, flagReconcilerTimeout, *r.ReconcilerTimeout,
+			"the default maximum duration of a single reconcile, 0 means unlimited")
+	}
+	if r.ReconcilerWaves != nil {
+		flags.BoolVar(r.ReconcilerWaves, flagReconcilerWaves, *r.ReconcilerWaves,
+			"if true, run once starts the reconcilers in the topological waves of their dependency graph")
+	}
+	if r.RejectCycles != nil {
+		flags.BoolVar(r.RejectCycles, flagReconcilerCycles, *r.RejectCycles,
+			"if true, loading reconcilers with dependency cycles fails, otherwise a warning is logged")
+	}
+	if r.OnceMaxIterations != nil {
+		flags.Int64Var(r.OnceMaxIterations, flagOnceMaxIterations, *r.OnceMaxIterations,
+			"the default amount of times run once reconciles the same object before failing, 0 means unlimited")
+	}
+	if r.OnceDeadline != nil {
+		flags.DurationVar(r.OnceDeadline, flagOnceDeadline, *r.OnceDeadline,
+			"the default maximum duration run once waits for the reconcilers to converge, 0 means unlimited")
+	}
+	if r.HTTPAddress != nil {
+		flags.StringVar(r.HTTPAddress, flagHTTPAddress, *r.HTTPAddress,
+			"the address of the kubernetes compatible http api (e.g. 127.0.0.1:51001), empty disables the http api")
+	}
+	if r.AuditMaxSize != nil {
+		flags.IntVar(r.AuditMaxSize, flagAuditMaxSize, *r.AuditMaxSize,
+			"the maximum size in megabytes of the audit log before it gets rotated, 0 means no rotation")
+	}
+	if r.AuditMaxFiles != nil {
+		flags.IntVar(r.AuditMaxFiles, flagAuditMaxFiles, *r.AuditMaxFiles,
+			"the maximum amount of rotated audit log files that are retained")
+	}
 }
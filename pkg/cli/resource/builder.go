@@ -46,8 +46,9 @@ const defaultHttpGetAttempts = 3
 const pathNotExistError = "the path %q does not exist"
 
 type Builder struct {
-	proxy  types.NamespacedName
-	branch string
+	proxy     types.NamespacedName
+	branch    string
+	namespace string
 	// local indicates that the builder does not interact with the server
 	//local bool
 	mapper *mapper
@@ -93,11 +94,18 @@ func newBuilder(resourceMapper resourcemapper.Mapper, proxy types.NamespacedName
 		mapper: &mapper{
 			resourceMapper: resourceMapper,
 		},
-		branch: branch,
-		proxy:  proxy,
+		branch:    branch,
+		proxy:     proxy,
+		namespace: "default",
 	}
 }
 
+// NamespaceParam sets the namespace of the resources selected by name
+func (b *Builder) NamespaceParam(namespace string) *Builder {
+	b.namespace = namespace
+	return b
+}
+
 // Unstructured updates the builder so that it will request and send unstructured
 // objects. Unstructured objects preserve all fields sent by the server in a map format
 // based on the object's JSON structure which means no data is lost when the client
@@ -358,7 +366,7 @@ func (b *Builder) visitByName() *Result {
 		return result.withError(fmt.Errorf("cannot get resource mapping for %s: %v", b.gr.String(), err))
 	}
 
-	namespace := "default"
+	namespace := b.namespace
 	visitors := []Visitor{}
 	for _, name := range b.names {
 		u := &unstructured.Unstructured{}
@@ -0,0 +1,165 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+)
+
+const (
+	indent     = "  "
+	timeFormat = "2006-01-02 15:04:05.000000 UTC"
+)
+
+// writeVerbs are the client calls that change the referenced resource
+var writeVerbs = map[string]bool{
+	"apply":        true,
+	"applyStatus":  true,
+	"create":       true,
+	"update":       true,
+	"updateStatus": true,
+	"delete":       true,
+}
+
+// Filter selects the traces of the reconciles that reconciled or changed a resource,
+// referenced as <kind>[.<group>]/<name>; the kind is matched case insensitive
+type Filter struct {
+	Kind  string
+	Group string
+	Name  string
+}
+
+func ParseFilter(arg string) (*Filter, error) {
+	kindGroup, name, ok := strings.Cut(arg, "/")
+	if !ok || kindGroup == "" || name == "" {
+		return nil, fmt.Errorf("invalid resource %q, expected <kind>[.<group>]/<name>", arg)
+	}
+	kind, group, _ := strings.Cut(kindGroup, ".")
+	return &Filter{Kind: kind, Group: group, Name: name}, nil
+}
+
+func (r *Filter) matchesResource(resource *runnerpb.Resource) bool {
+	if resource == nil {
+		return false
+	}
+	if !strings.EqualFold(r.Kind, resource.Kind) || r.Name != resource.Name {
+		return false
+	}
+	return r.Group == "" || r.Group == resource.Group
+}
+
+// Matches returns true when the result reconciled the resource or when one of its
+// client calls changed the resource; a nil filter matches all results
+func (r *Filter) Matches(result *runnerpb.ReconcileResult) bool {
+	if r == nil {
+		return true
+	}
+	if r.matchesResource(result.Resource) {
+		return true
+	}
+	for _, call := range result.GetTrace().GetCalls() {
+		if writeVerbs[call.Verb] && call.Error == "" && r.matchesResource(call.Resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// Print writes the trace of the reconcile result
+func Print(w io.Writer, result *runnerpb.ReconcileResult) {
+	trace := result.GetTrace()
+	if trace == nil {
+		return
+	}
+	fmt.Fprintf(w, "%s reconciler %s %s %s (%s)\n",
+		result.EventTime.AsTime().Format(timeFormat),
+		result.ReconcilerName,
+		resourceString(result.Resource),
+		result.Operation.String(),
+		trace.Duration.AsDuration(),
+	)
+	if result.Message != "" {
+		fmt.Fprintf(w, "%smessage: %s\n", indent, result.Message)
+	}
+	triggers := make([]string, 0, len(trace.Triggers))
+	for _, trigger := range trace.Triggers {
+		s := strings.ToLower(trigger.Type.String())
+		if trigger.Source != nil {
+			s = fmt.Sprintf("%s %s", s, resourceString(trigger.Source))
+		}
+		triggers = append(triggers, s)
+	}
+	if len(triggers) > 0 {
+		fmt.Fprintf(w, "%striggers: %s\n", indent, strings.Join(triggers, ", "))
+	}
+	if len(trace.Calls) > 0 {
+		fmt.Fprintf(w, "%scalls:\n", indent)
+		for _, call := range trace.Calls {
+			s := fmt.Sprintf("%s %s", call.Verb, resourceString(call.Resource))
+			if call.Error != "" {
+				s = fmt.Sprintf("%s, error: %s", s, call.Error)
+			}
+			fmt.Fprintf(w, "%s%s%s\n", indent, indent, s)
+		}
+	}
+	if len(trace.Children) > 0 {
+		fmt.Fprintf(w, "%schildren: %s\n", indent, resourcesString(trace.Children))
+	}
+	if len(trace.Pruned) > 0 {
+		fmt.Fprintf(w, "%spruned: %s\n", indent, resourcesString(trace.Pruned))
+	}
+	if trace.Condition != nil {
+		s := fmt.Sprintf("%s=%s", trace.Condition.Type, trace.Condition.Status)
+		if trace.Condition.Reason != "" {
+			s = fmt.Sprintf("%s reason: %s", s, trace.Condition.Reason)
+		}
+		if trace.Condition.Message != "" {
+			s = fmt.Sprintf("%s message: %s", s, trace.Condition.Message)
+		}
+		fmt.Fprintf(w, "%scondition: %s\n", indent, s)
+	}
+}
+
+func resourceString(resource *runnerpb.Resource) string {
+	if resource == nil {
+		return ""
+	}
+	kind := resource.Kind
+	if resource.Group != "" {
+		kind = fmt.Sprintf("%s.%s", resource.Kind, resource.Group)
+	}
+	switch {
+	case resource.Name == "":
+		return kind
+	case resource.Namespace == "":
+		return fmt.Sprintf("%s/%s", kind, resource.Name)
+	default:
+		return fmt.Sprintf("%s/%s/%s", kind, resource.Namespace, resource.Name)
+	}
+}
+
+func resourcesString(resources []*runnerpb.Resource) string {
+	s := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		s = append(s, resourceString(resource))
+	}
+	return strings.Join(s, ", ")
+}
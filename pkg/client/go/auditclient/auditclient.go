@@ -0,0 +1,67 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditclient
+
+import (
+	"context"
+
+	"github.com/kform-dev/choreo/pkg/client/go/config"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type AuditClient interface {
+	List(ctx context.Context, in *auditpb.List_Request, opts ...grpc.CallOption) (*auditpb.List_Response, error)
+	Close() error
+}
+
+func NewAuditClient(config *config.Config) (AuditClient, error) {
+	client := &auditclient{
+		config: config,
+	}
+
+	conn, err := grpc.NewClient(config.Address,
+		grpc.WithTransportCredentials(
+			insecure.NewCredentials(),
+		),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(config.MaxMsgSize)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	client.client = auditpb.NewAuditClient(conn)
+	client.conn = conn
+	return client, nil
+}
+
+type auditclient struct {
+	config *config.Config
+	conn   *grpc.ClientConn
+	client auditpb.AuditClient
+}
+
+func (r *auditclient) Close() error {
+	if r.conn == nil {
+		return nil
+	}
+	return r.conn.Close()
+}
+
+func (r *auditclient) List(ctx context.Context, in *auditpb.List_Request, opts ...grpc.CallOption) (*auditpb.List_Response, error) {
+	return r.client.List(ctx, in, opts...)
+}
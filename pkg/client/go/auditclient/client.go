@@ -0,0 +1,137 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/kform-dev/choreo/pkg/client/go/config"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type Client interface {
+	List(ctx context.Context, opts ...ListOption) ([]*auditpb.Entry, error)
+	Close() error
+}
+
+func NewClient(config *config.Config) (Client, error) {
+	client := &client{
+		config: config,
+	}
+
+	conn, err := grpc.NewClient(config.Address,
+		grpc.WithTransportCredentials(
+			insecure.NewCredentials(),
+		),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(config.MaxMsgSize)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	client.client = auditpb.NewAuditClient(conn)
+	client.conn = conn
+	return client, nil
+}
+
+type client struct {
+	config *config.Config
+	conn   *grpc.ClientConn
+	client auditpb.AuditClient
+}
+
+func (r *client) Close() error {
+	if r.conn == nil {
+		return nil
+	}
+	return r.conn.Close()
+}
+
+func (r *client) List(ctx context.Context, opts ...ListOption) ([]*auditpb.Entry, error) {
+	o := ListOptions{}
+	o.ApplyOptions(opts)
+
+	var since *timestamppb.Timestamp
+	if !o.Since.IsZero() {
+		since = timestamppb.New(o.Since)
+	}
+
+	rsp, err := r.client.List(ctx, &auditpb.List_Request{
+		Options: &auditpb.List_Options{
+			ProxyName:      o.Proxy.Name,
+			ProxyNamespace: o.Proxy.Namespace,
+			Branch:         o.Branch,
+			Group:          o.Group,
+			Kind:           o.Kind,
+			Namespace:      o.Namespace,
+			Name:           o.Name,
+			Origin:         o.Origin,
+			Operation:      o.Operation,
+			Since:          since,
+			Limit:          o.Limit,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Entries, nil
+}
+
+type ListOption interface {
+	ApplyToList(*ListOptions)
+}
+
+var _ ListOption = &ListOptions{}
+
+type ListOptions struct {
+	Proxy     types.NamespacedName
+	Branch    string
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+	Origin    string
+	Operation auditpb.Operation
+	Since     time.Time
+	Limit     int64
+}
+
+func (o *ListOptions) ApplyToList(lo *ListOptions) {
+	lo.Proxy = o.Proxy
+	lo.Branch = o.Branch
+	lo.Group = o.Group
+	lo.Kind = o.Kind
+	lo.Namespace = o.Namespace
+	lo.Name = o.Name
+	lo.Origin = o.Origin
+	lo.Operation = o.Operation
+	lo.Since = o.Since
+	lo.Limit = o.Limit
+}
+
+// ApplyOptions applies the given list options on these options,
+// and then returns itself (for convenient chaining).
+func (o *ListOptions) ApplyOptions(opts []ListOption) *ListOptions {
+	for _, opt := range opts {
+		opt.ApplyToList(o)
+	}
+	return o
+}
@@ -33,7 +33,9 @@ import (
 	"github.com/kform-dev/choreo/pkg/client/go/discovery/cached/memory"
 	discoveryclient "github.com/kform-dev/choreo/pkg/client/go/discovery/client"
 	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
@@ -123,6 +125,11 @@ func (r *CachedDiscoveryClient) APIResources(ctx context.Context, proxy types.Na
 	return apiResources, nil
 }
 
+// Schema is not cached, the schemas are only retrieved on demand
+func (r *CachedDiscoveryClient) Schema(ctx context.Context, proxy types.NamespacedName, branch string, gvk schema.GroupVersionKind) (*discoverypb.APIResource, *apiextensionsv1.JSONSchemaProps, error) {
+	return r.delegate.Schema(ctx, proxy, branch, gvk)
+}
+
 func (r *CachedDiscoveryClient) Watch(ctx context.Context, req *discoverypb.Watch_Request) chan *discoverypb.Watch_Response {
 	return r.delegate.Watch(ctx, req)
 }
@@ -22,6 +22,8 @@ import (
 
 	"github.com/kform-dev/choreo/pkg/client/go/discovery"
 	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -70,6 +72,11 @@ func (r *memCacheClient) APIResources(ctx context.Context, proxy types.Namespace
 	return r.apiResources, nil
 }
 
+// Schema is not cached, the schemas are only retrieved on demand
+func (r *memCacheClient) Schema(ctx context.Context, proxy types.NamespacedName, branch string, gvk schema.GroupVersionKind) (*discoverypb.APIResource, *apiextensionsv1.JSONSchemaProps, error) {
+	return r.delegate.Schema(ctx, proxy, branch, gvk)
+}
+
 func (r *memCacheClient) Watch(ctx context.Context, req *discoverypb.Watch_Request) chan *discoverypb.Watch_Response {
 	return r.delegate.Watch(ctx, req)
 }
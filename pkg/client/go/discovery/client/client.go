@@ -34,6 +34,7 @@ import (
 type DiscoveryClientInterface interface {
 	Get(context.Context, *discoverypb.Get_Request) (*discoverypb.Get_Response, error)
 	Watch(context.Context, *discoverypb.Watch_Request) chan *discoverypb.Watch_Response
+	Schema(context.Context, *discoverypb.Schema_Request) (*discoverypb.Schema_Response, error)
 	Close() error
 }
 
@@ -73,6 +74,13 @@ func (r *discoveryclient) Get(ctx context.Context, in *discoverypb.Get_Request)
 	return r.client.Get(ctx, in)
 }
 
+func (r *discoveryclient) Schema(ctx context.Context, in *discoverypb.Schema_Request) (*discoverypb.Schema_Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.config.Timeout)
+	defer cancel()
+
+	return r.client.Schema(ctx, in)
+}
+
 func (r *discoveryclient) Watch(ctx context.Context, in *discoverypb.Watch_Request) chan *discoverypb.Watch_Response {
 	log := log.FromContext(ctx)
 	var stream discoverypb.Discovery_WatchClient
@@ -18,10 +18,13 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/kform-dev/choreo/pkg/client/go/config"
 	"github.com/kform-dev/choreo/pkg/client/go/discovery"
 	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -78,6 +81,25 @@ func (r *discoveryClient) APIResources(ctx context.Context, proxy types.Namespac
 	*/
 }
 
+func (r *discoveryClient) Schema(ctx context.Context, proxy types.NamespacedName, branch string, gvk schema.GroupVersionKind) (*discoverypb.APIResource, *apiextensionsv1.JSONSchemaProps, error) {
+	rsp, err := r.client.Schema(ctx, &discoverypb.Schema_Request{
+		ProxyName:      proxy.Name,
+		ProxyNamespace: proxy.Namespace,
+		Branch:         branch,
+		Group:          gvk.Group,
+		Version:        gvk.Version,
+		Kind:           gvk.Kind,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	openAPISchema := &apiextensionsv1.JSONSchemaProps{}
+	if err := json.Unmarshal(rsp.Schema, openAPISchema); err != nil {
+		return nil, nil, err
+	}
+	return rsp.ApiResource, openAPISchema, nil
+}
+
 func (r *discoveryClient) Watch(ctx context.Context, in *discoverypb.Watch_Request) chan *discoverypb.Watch_Response {
 	return r.client.Watch(ctx, in)
 }
@@ -20,6 +20,8 @@ import (
 	"context"
 
 	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -27,6 +29,8 @@ type DiscoveryInterface interface {
 	APIResources(ctx context.Context, proxy types.NamespacedName, branch string) ([]*discoverypb.APIResource, error)
 	Close() error
 	Watch(context.Context, *discoverypb.Watch_Request) chan *discoverypb.Watch_Response
+	// Schema returns the api resource and its openapi v3 schema; an empty version selects the served version
+	Schema(ctx context.Context, proxy types.NamespacedName, branch string, gvk schema.GroupVersionKind) (*discoverypb.APIResource, *apiextensionsv1.JSONSchemaProps, error)
 }
 
 // CachedDiscoveryInterface is a DiscoveryInterface with cache invalidation and freshness.
@@ -34,6 +34,7 @@ import (
 type DiscoveryClient interface {
 	Get(ctx context.Context, in *discoverypb.Get_Request, opts ...grpc.CallOption) (*discoverypb.Get_Response, error)
 	Watch(ctx context.Context, in *discoverypb.Watch_Request, opts ...grpc.CallOption) chan *discoverypb.Watch_Response
+	Schema(ctx context.Context, in *discoverypb.Schema_Request, opts ...grpc.CallOption) (*discoverypb.Schema_Response, error)
 	Close() error
 }
 
@@ -73,6 +74,10 @@ func (r *dicoveryclient) Get(ctx context.Context, in *discoverypb.Get_Request, o
 	return r.client.Get(ctx, in, opts...)
 }
 
+func (r *dicoveryclient) Schema(ctx context.Context, in *discoverypb.Schema_Request, opts ...grpc.CallOption) (*discoverypb.Schema_Response, error) {
+	return r.client.Schema(ctx, in, opts...)
+}
+
 func (r *dicoveryclient) Watch(ctx context.Context, in *discoverypb.Watch_Request, opts ...grpc.CallOption) chan *discoverypb.Watch_Response {
 	log := log.FromContext(ctx)
 	var stream discoverypb.Discovery_WatchClient
@@ -124,6 +124,9 @@ func (r *client) List(ctx context.Context, u runtime.Unstructured, opts ...ListO
 			ProxyName:        o.Proxy.Name,
 			ProxyNamespace:   o.Proxy.Namespace,
 			Ref:              o.Ref,
+			Namespace:        o.Namespace,
+			Limit:            o.Limit,
+			Continue:         o.Continue,
 		},
 	})
 	if err != nil {
@@ -140,6 +143,14 @@ func (r *client) List(ctx context.Context, u runtime.Unstructured, opts ...ListO
 }
 
 func (r *client) Apply(ctx context.Context, u runtime.Unstructured, opts ...ApplyOption) error {
+	return r.apply(ctx, u, r.client.Apply, opts)
+}
+
+func (r *client) ApplyStatus(ctx context.Context, u runtime.Unstructured, opts ...ApplyOption) error {
+	return r.apply(ctx, u, r.client.ApplyStatus, opts)
+}
+
+func (r *client) apply(ctx context.Context, u runtime.Unstructured, applyFn func(ctx context.Context, in *resourcepb.Apply_Request, opts ...grpc.CallOption) (*resourcepb.Apply_Response, error), opts []ApplyOption) error {
 	o := ApplyOptions{}
 	o.ApplyOptions(opts)
 
@@ -147,7 +158,7 @@ func (r *client) Apply(ctx context.Context, u runtime.Unstructured, opts ...Appl
 	if err != nil {
 		return err
 	}
-	rsp, err := r.client.Apply(ctx, &resourcepb.Apply_Request{
+	rsp, err := applyFn(ctx, &resourcepb.Apply_Request{
 		Object: b,
 		Options: &resourcepb.Apply_Options{
 			Branch:         o.Branch,
@@ -206,6 +217,14 @@ func (r *client) Create(ctx context.Context, u runtime.Unstructured, opts ...Cre
 }
 
 func (r *client) Update(ctx context.Context, u runtime.Unstructured, opts ...UpdateOption) error {
+	return r.update(ctx, u, r.client.Update, opts)
+}
+
+func (r *client) UpdateStatus(ctx context.Context, u runtime.Unstructured, opts ...UpdateOption) error {
+	return r.update(ctx, u, r.client.UpdateStatus, opts)
+}
+
+func (r *client) update(ctx context.Context, u runtime.Unstructured, updateFn func(ctx context.Context, in *resourcepb.Update_Request, opts ...grpc.CallOption) (*resourcepb.Update_Response, error), opts []UpdateOption) error {
 	o := UpdateOptions{}
 	o.ApplyOptions(opts)
 
@@ -213,7 +232,7 @@ func (r *client) Update(ctx context.Context, u runtime.Unstructured, opts ...Upd
 	if err != nil {
 		return err
 	}
-	rsp, err := r.client.Update(ctx, &resourcepb.Update_Request{
+	rsp, err := updateFn(ctx, &resourcepb.Update_Request{
 		Object: b,
 		Options: &resourcepb.Update_Options{
 			DryRun:         o.DryRun,
@@ -245,20 +264,31 @@ func (r *client) Delete(ctx context.Context, u runtime.Unstructured, opts ...Del
 	if err != nil {
 		return err
 	}
-	_, err = r.client.Delete(ctx, &resourcepb.Delete_Request{
+	rsp, err := r.client.Delete(ctx, &resourcepb.Delete_Request{
 		Object: b,
 		Options: &resourcepb.Delete_Options{
-			DryRun:         o.DryRun,
-			Branch:         o.Branch,
-			ProxyName:      o.Proxy.Name,
-			ProxyNamespace: o.Proxy.Namespace,
-			Trace:          o.Trace,
-			Origin:         o.Origin,
+			DryRun:            o.DryRun,
+			Branch:            o.Branch,
+			ProxyName:         o.Proxy.Name,
+			ProxyNamespace:    o.Proxy.Namespace,
+			Trace:             o.Trace,
+			Origin:            o.Origin,
+			PropagationPolicy: string(o.PropagationPolicy),
 		},
 	})
 	if err != nil {
 		return err
 	}
+	// no object is returned when the object was not found
+	if len(rsp.Object) == 0 {
+		return nil
+	}
+
+	data := map[string]any{}
+	if err := json.Unmarshal(rsp.Object, &data); err != nil {
+		return err
+	}
+	u.SetUnstructuredContent(data)
 	return nil
 }
 
@@ -276,9 +306,28 @@ func (r *client) Watch(ctx context.Context, u runtime.Unstructured, opts ...List
 		return nil
 	}
 
+	in := &resourcepb.Watch_Request{
+		Object: b,
+		Options: &resourcepb.Watch_Options{
+			Branch:          o.Branch,
+			ProxyName:       o.Proxy.Name,
+			ProxyNamespace:  o.Proxy.Namespace,
+			Ref:             o.Ref,
+			Watch:           o.Watch,
+			ExprSelector:    o.ExprSelector,
+			Trace:           o.Trace,
+			Origin:          o.Origin,
+			Namespace:       o.Namespace,
+			ResourceVersion: o.ResourceVersion,
+			AllowBookmarks:  o.AllowBookmarks,
+		},
+	}
+
 	rspCh := make(chan *resourcepb.Watch_Response)
 	go func() {
 		defer close(rspCh)
+		// resourceVersion of the last event received, used to resume the watch
+		resourceVersion := o.ResourceVersion
 		for {
 			select {
 			case <-ctx.Done():
@@ -286,19 +335,7 @@ func (r *client) Watch(ctx context.Context, u runtime.Unstructured, opts ...List
 				return
 			default:
 				if stream == nil {
-					if stream, err = r.client.Watch(ctx, &resourcepb.Watch_Request{
-						Object: b,
-						Options: &resourcepb.Watch_Options{
-							Branch:         o.Branch,
-							ProxyName:      o.Proxy.Name,
-							ProxyNamespace: o.Proxy.Namespace,
-							Ref:            o.Ref,
-							Watch:          o.Watch,
-							ExprSelector:   o.ExprSelector,
-							Trace:          o.Trace,
-							Origin:         o.Origin,
-						},
-					}); err != nil && !errors.Is(err, context.Canceled) {
+					if stream, err = r.client.Watch(ctx, resumeWatchRequest(in, resourceVersion)); err != nil && !errors.Is(err, context.Canceled) {
 						if statErr, ok := status.FromError(err); ok {
 							switch statErr.Code() {
 							case codes.Canceled:
@@ -320,6 +357,10 @@ func (r *client) Watch(ctx context.Context, u runtime.Unstructured, opts ...List
 							case codes.Canceled:
 								log.Debug("resource client watch event recv error", "error", err.Error())
 								// dont log when context got cancelled
+							case codes.OutOfRange:
+								// the resourceVersion is no longer available, list and watch again
+								log.Debug("resource client watch cannot resume", "resourceVersion", resourceVersion)
+								resourceVersion = ""
 							default:
 								log.Error("failed to receive a message from stream", "error", err.Error())
 							}
@@ -336,6 +377,9 @@ func (r *client) Watch(ctx context.Context, u runtime.Unstructured, opts ...List
 					}
 				}
 				log.Debug("resource client event received", "eventType", rsp.EventType.String())
+				if rsp.ResourceVersion != "" {
+					resourceVersion = rsp.ResourceVersion
+				}
 				if rsp.EventType == resourcepb.Watch_ERROR {
 					stream = nil
 					time.Sleep(time.Second * 1) //- resilience for server error
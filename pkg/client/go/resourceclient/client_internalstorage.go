@@ -55,7 +55,7 @@ func (r *internal) Get(ctx context.Context, key types.NamespacedName, obj runtim
 		return err
 	}
 
-	newobj, err := storage.Get(ctx, key.Name, &rest.GetOptions{
+	newobj, err := storage.Get(ctx, key, &rest.GetOptions{
 		Commit:            o.Commit,
 		ShowManagedFields: o.ShowManagedFields,
 		Trace:             o.Trace,
@@ -86,6 +86,9 @@ func (r *internal) List(ctx context.Context, obj runtime.Unstructured, opts ...L
 		ShowManagedFields: o.ShowManagedFields,
 		Trace:             o.Trace,
 		Origin:            o.Origin,
+		Namespace:         o.Namespace,
+		Limit:             o.Limit,
+		Continue:          o.Continue,
 		Selector:          selector,
 		Watch:             false,
 	})
@@ -96,6 +99,12 @@ func (r *internal) List(ctx context.Context, obj runtime.Unstructured, opts ...L
 	return nil
 }
 func (r *internal) Apply(ctx context.Context, obj runtime.Unstructured, opts ...ApplyOption) error {
+	return r.apply(ctx, obj, "", opts)
+}
+func (r *internal) ApplyStatus(ctx context.Context, obj runtime.Unstructured, opts ...ApplyOption) error {
+	return r.apply(ctx, obj, rest.SubresourceStatus, opts)
+}
+func (r *internal) apply(ctx context.Context, obj runtime.Unstructured, subresource string, opts []ApplyOption) error {
 	o := ApplyOptions{}
 	o.ApplyOptions(opts)
 
@@ -110,6 +119,7 @@ func (r *internal) Apply(ctx context.Context, obj runtime.Unstructured, opts ...
 		DryRun:       o.DryRun,
 		FieldManager: o.FieldManager,
 		Force:        o.Force,
+		Subresource:  subresource,
 	})
 	if err != nil {
 		return err
@@ -138,6 +148,12 @@ func (r *internal) Create(ctx context.Context, obj runtime.Unstructured, opts ..
 	return nil
 }
 func (r *internal) Update(ctx context.Context, obj runtime.Unstructured, opts ...UpdateOption) error {
+	return r.update(ctx, obj, "", opts)
+}
+func (r *internal) UpdateStatus(ctx context.Context, obj runtime.Unstructured, opts ...UpdateOption) error {
+	return r.update(ctx, obj, rest.SubresourceStatus, opts)
+}
+func (r *internal) update(ctx context.Context, obj runtime.Unstructured, subresource string, opts []UpdateOption) error {
 	o := UpdateOptions{}
 	o.ApplyOptions(opts)
 
@@ -147,9 +163,10 @@ func (r *internal) Update(ctx context.Context, obj runtime.Unstructured, opts ..
 	}
 
 	newobj, err := storage.Update(ctx, obj, &rest.UpdateOptions{
-		Trace:  o.Trace,
-		Origin: o.Origin,
-		DryRun: o.DryRun,
+		Trace:       o.Trace,
+		Origin:      o.Origin,
+		DryRun:      o.DryRun,
+		Subresource: subresource,
 	})
 	if err != nil {
 		return err
@@ -168,7 +185,7 @@ func (r *internal) Delete(ctx context.Context, obj runtime.Unstructured, opts ..
 		return err
 	}
 
-	if _, err := storage.Delete(ctx, u.GetName(), &rest.DeleteOptions{
+	if _, err := storage.Delete(ctx, types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}, &rest.DeleteOptions{
 		Trace:  o.Trace,
 		Origin: o.Origin,
 		DryRun: o.DryRun,
@@ -191,6 +208,9 @@ func (r *internal) Watch(ctx context.Context, obj runtime.Unstructured, opts ...
 		ShowManagedFields: o.ShowManagedFields,
 		Trace:             o.Trace,
 		Origin:            o.Origin,
+		Namespace:         o.Namespace,
+		ResourceVersion:   o.ResourceVersion,
+		AllowBookmarks:    o.AllowBookmarks,
 		Selector:          selector.Everything(),
 		Watch:             o.Watch,
 	}
@@ -245,8 +265,9 @@ func (r *internal) watch(ctx context.Context, wi watch.Interface, rspch chan *re
 			}
 
 			rspch <- &resourcepb.Watch_Response{
-				Object:    b,
-				EventType: watchEvent.Type,
+				Object:          b,
+				EventType:       watchEvent.Type,
+				ResourceVersion: watchEvent.ResourceVersion,
 			}
 		}
 	}
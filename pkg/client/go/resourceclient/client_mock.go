@@ -41,7 +41,7 @@ func (r *mock) Get(ctx context.Context, key types.NamespacedName, u runtime.Unst
 	o := GetOptions{}
 	o.ApplyOptions(opts)
 
-	obj, err := r.storage.Get(ctx, key.Name, &rest.GetOptions{
+	obj, err := r.storage.Get(ctx, key, &rest.GetOptions{
 		Commit:            o.Commit,
 		ShowManagedFields: o.ShowManagedFields,
 		Trace:             o.Trace,
@@ -67,6 +67,9 @@ func (r *mock) List(ctx context.Context, ul runtime.Unstructured, opts ...ListOp
 		ShowManagedFields: o.ShowManagedFields,
 		Trace:             o.Trace,
 		Origin:            o.Origin,
+		Namespace:         o.Namespace,
+		Limit:             o.Limit,
+		Continue:          o.Continue,
 		Selector:          selector,
 		Watch:             false,
 	})
@@ -77,6 +80,12 @@ func (r *mock) List(ctx context.Context, ul runtime.Unstructured, opts ...ListOp
 	return nil
 }
 func (r *mock) Apply(ctx context.Context, u runtime.Unstructured, opts ...ApplyOption) error {
+	return r.apply(ctx, u, "", opts)
+}
+func (r *mock) ApplyStatus(ctx context.Context, u runtime.Unstructured, opts ...ApplyOption) error {
+	return r.apply(ctx, u, rest.SubresourceStatus, opts)
+}
+func (r *mock) apply(ctx context.Context, u runtime.Unstructured, subresource string, opts []ApplyOption) error {
 	o := ApplyOptions{}
 	o.ApplyOptions(opts)
 
@@ -86,6 +95,7 @@ func (r *mock) Apply(ctx context.Context, u runtime.Unstructured, opts ...ApplyO
 		DryRun:       o.DryRun,
 		FieldManager: o.FieldManager,
 		Force:        o.Force,
+		Subresource:  subresource,
 	})
 	if err != nil {
 		return err
@@ -109,13 +119,20 @@ func (r *mock) Create(ctx context.Context, u runtime.Unstructured, opts ...Creat
 	return nil
 }
 func (r *mock) Update(ctx context.Context, u runtime.Unstructured, opts ...UpdateOption) error {
+	return r.update(ctx, u, "", opts)
+}
+func (r *mock) UpdateStatus(ctx context.Context, u runtime.Unstructured, opts ...UpdateOption) error {
+	return r.update(ctx, u, rest.SubresourceStatus, opts)
+}
+func (r *mock) update(ctx context.Context, u runtime.Unstructured, subresource string, opts []UpdateOption) error {
 	o := UpdateOptions{}
 	o.ApplyOptions(opts)
 
 	obj, err := r.storage.Update(ctx, u, &rest.UpdateOptions{
-		Trace:  o.Trace,
-		Origin: o.Origin,
-		DryRun: o.DryRun,
+		Trace:       o.Trace,
+		Origin:      o.Origin,
+		DryRun:      o.DryRun,
+		Subresource: subresource,
 	})
 	if err != nil {
 		return err
@@ -130,7 +147,7 @@ func (r *mock) Delete(ctx context.Context, u runtime.Unstructured, opts ...Delet
 	obj := unstructured.Unstructured{
 		Object: u.UnstructuredContent(),
 	}
-	_, err := r.storage.Delete(ctx, obj.GetName(), &rest.DeleteOptions{
+	_, err := r.storage.Delete(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, &rest.DeleteOptions{
 		Trace:  o.Trace,
 		Origin: o.Origin,
 		DryRun: o.DryRun,
@@ -21,6 +21,7 @@ import (
 
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -31,6 +32,10 @@ type Client interface {
 	Apply(ctx context.Context, u runtime.Unstructured, opts ...ApplyOption) error
 	Create(ctx context.Context, u runtime.Unstructured, opts ...CreateOption) error
 	Update(ctx context.Context, u runtime.Unstructured, opts ...UpdateOption) error
+	// ApplyStatus and UpdateStatus only change the status of the object, they require
+	// the status subresource to be enabled on the resource
+	ApplyStatus(ctx context.Context, u runtime.Unstructured, opts ...ApplyOption) error
+	UpdateStatus(ctx context.Context, u runtime.Unstructured, opts ...UpdateOption) error
 	Delete(ctx context.Context, u runtime.Unstructured, opts ...DeleteOption) error
 	Watch(ctx context.Context, u runtime.Unstructured, opts ...ListOption) chan *resourcepb.Watch_Response
 	Close() error
@@ -90,9 +95,20 @@ type ListOptions struct {
 	ShowManagedFields bool
 	Trace             string
 	Origin            string
+	// Namespace restricts the list/watch to a single namespace,
+	// an empty namespace selects all namespaces
+	Namespace string
 	// A selector based on expressions
 	ExprSelector *resourcepb.ExpressionSelector
 	Watch        bool
+	// Limit is the maximum number of items returned by a list, 0 returns all items
+	Limit int64
+	// Continue is the token returned by a previous list to retrieve the next page
+	Continue string
+	// ResourceVersion resumes a watch after the given resourceVersion
+	ResourceVersion string
+	// AllowBookmarks requests bookmark events on a watch
+	AllowBookmarks bool
 }
 
 func (o *ListOptions) ApplyToList(lo *ListOptions) {
@@ -107,8 +123,13 @@ func (o *ListOptions) ApplyToList(lo *ListOptions) {
 	lo.ShowManagedFields = o.ShowManagedFields
 	lo.Trace = o.Trace
 	lo.Origin = o.Origin
+	lo.Namespace = o.Namespace
 	lo.Watch = o.Watch
 	lo.Ref = o.Ref
+	lo.Limit = o.Limit
+	lo.Continue = o.Continue
+	lo.ResourceVersion = o.ResourceVersion
+	lo.AllowBookmarks = o.AllowBookmarks
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -198,6 +219,9 @@ type DeleteOptions struct {
 	DryRun []string
 	Branch string
 	Proxy  types.NamespacedName
+	// PropagationPolicy determines how the dependents of the object are deleted,
+	// empty defaults to background deletion by the server
+	PropagationPolicy metav1.DeletionPropagation
 }
 
 func (o *DeleteOptions) ApplyToDelete(lo *DeleteOptions) {
@@ -206,6 +230,7 @@ func (o *DeleteOptions) ApplyToDelete(lo *DeleteOptions) {
 	lo.DryRun = o.DryRun
 	lo.Branch = o.Branch
 	lo.Proxy = o.Proxy
+	lo.PropagationPolicy = o.PropagationPolicy
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -37,6 +37,8 @@ type ResourceClient interface {
 	Create(ctx context.Context, in *resourcepb.Create_Request, opts ...grpc.CallOption) (*resourcepb.Create_Response, error)
 	Update(ctx context.Context, in *resourcepb.Update_Request, opts ...grpc.CallOption) (*resourcepb.Update_Response, error)
 	Apply(ctx context.Context, in *resourcepb.Apply_Request, opts ...grpc.CallOption) (*resourcepb.Apply_Response, error)
+	UpdateStatus(ctx context.Context, in *resourcepb.Update_Request, opts ...grpc.CallOption) (*resourcepb.Update_Response, error)
+	ApplyStatus(ctx context.Context, in *resourcepb.Apply_Request, opts ...grpc.CallOption) (*resourcepb.Apply_Response, error)
 	Delete(ctx context.Context, in *resourcepb.Delete_Request, opts ...grpc.CallOption) (*resourcepb.Delete_Response, error)
 	Watch(ctx context.Context, in *resourcepb.Watch_Request, opts ...grpc.CallOption) chan *resourcepb.Watch_Response
 	Close() error
@@ -89,6 +91,12 @@ func (r *resourceclient) Update(ctx context.Context, in *resourcepb.Update_Reque
 func (r *resourceclient) Apply(ctx context.Context, in *resourcepb.Apply_Request, opts ...grpc.CallOption) (*resourcepb.Apply_Response, error) {
 	return r.client.Apply(ctx, in, opts...)
 }
+func (r *resourceclient) UpdateStatus(ctx context.Context, in *resourcepb.Update_Request, opts ...grpc.CallOption) (*resourcepb.Update_Response, error) {
+	return r.client.UpdateStatus(ctx, in, opts...)
+}
+func (r *resourceclient) ApplyStatus(ctx context.Context, in *resourcepb.Apply_Request, opts ...grpc.CallOption) (*resourcepb.Apply_Response, error) {
+	return r.client.ApplyStatus(ctx, in, opts...)
+}
 func (r *resourceclient) Delete(ctx context.Context, in *resourcepb.Delete_Request, opts ...grpc.CallOption) (*resourcepb.Delete_Response, error) {
 	return r.client.Delete(ctx, in, opts...)
 }
@@ -100,6 +108,8 @@ func (r *resourceclient) Watch(ctx context.Context, in *resourcepb.Watch_Request
 	rspCh := make(chan *resourcepb.Watch_Response)
 	go func() {
 		defer close(rspCh)
+		// resourceVersion of the last event received, used to resume the watch
+		resourceVersion := in.GetOptions().GetResourceVersion()
 		for {
 			select {
 			case <-ctx.Done():
@@ -107,7 +117,7 @@ func (r *resourceclient) Watch(ctx context.Context, in *resourcepb.Watch_Request
 				return
 			default:
 				if stream == nil {
-					if stream, err = r.client.Watch(ctx, in); err != nil && !errors.Is(err, context.Canceled) {
+					if stream, err = r.client.Watch(ctx, resumeWatchRequest(in, resourceVersion)); err != nil && !errors.Is(err, context.Canceled) {
 						if statErr, ok := status.FromError(err); ok {
 							switch statErr.Code() {
 							case codes.Canceled:
@@ -129,6 +139,10 @@ func (r *resourceclient) Watch(ctx context.Context, in *resourcepb.Watch_Request
 							case codes.Canceled:
 								log.Info("resource client watch event recv error", "error", err.Error())
 								// dont log when context got cancelled
+							case codes.OutOfRange:
+								// the resourceVersion is no longer available, list and watch again
+								log.Info("resource client watch cannot resume", "resourceVersion", resourceVersion)
+								resourceVersion = ""
 							default:
 								log.Error("failed to receive a message from stream", "error", err.Error())
 							}
@@ -145,6 +159,9 @@ func (r *resourceclient) Watch(ctx context.Context, in *resourcepb.Watch_Request
 					}
 				}
 				log.Info("resource client event received", "eventType", rsp.EventType)
+				if rsp.ResourceVersion != "" {
+					resourceVersion = rsp.ResourceVersion
+				}
 				rspCh <- rsp
 			}
 		}
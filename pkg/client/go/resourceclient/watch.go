@@ -0,0 +1,34 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceclient
+
+import (
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"google.golang.org/protobuf/proto"
+)
+
+// resumeWatchRequest returns the request used to (re)subscribe to a watch; once an
+// event is received the watch resumes after its resourceVersion, such that an
+// interrupted watch does not replay all objects
+func resumeWatchRequest(in *resourcepb.Watch_Request, resourceVersion string) *resourcepb.Watch_Request {
+	req := proto.Clone(in).(*resourcepb.Watch_Request)
+	if req.Options == nil {
+		req.Options = &resourcepb.Watch_Options{}
+	}
+	req.Options.ResourceVersion = resourceVersion
+	return req
+}
@@ -18,11 +18,13 @@ package runnerclient
 
 import (
 	"context"
+	"time"
 
 	"github.com/kform-dev/choreo/pkg/client/go/config"
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -31,6 +33,8 @@ type Client interface {
 	Stop(ctx context.Context, opts ...StopOption) error
 	Once(ctx context.Context, opts ...OnceOption) (runnerpb.Runner_OnceClient, error)
 	Load(ctx context.Context, opts ...LoadOption) error
+	Deps(ctx context.Context, opts ...DepsOption) (*runnerpb.Deps_Response, error)
+	Status(ctx context.Context, opts ...StatusOption) (*runnerpb.Status_Response, error)
 	Close() error
 }
 
@@ -74,6 +78,7 @@ func (r *client) Start(ctx context.Context, opts ...StartOption) error {
 		Options: &runnerpb.Start_Options{
 			ProxyName:      o.Proxy.Name,
 			ProxyNamespace: o.Proxy.Namespace,
+			Branch:         o.Branch,
 		},
 	}); err != nil {
 		return err
@@ -88,6 +93,7 @@ func (r *client) Stop(ctx context.Context, opts ...StopOption) error {
 		Options: &runnerpb.Stop_Options{
 			ProxyName:      o.Proxy.Name,
 			ProxyNamespace: o.Proxy.Namespace,
+			Branch:         o.Branch,
 		},
 	}); err != nil {
 		return err
@@ -98,10 +104,20 @@ func (r *client) Once(ctx context.Context, opts ...OnceOption) (runnerpb.Runner_
 	o := OnceOptions{}
 	o.ApplyOptions(opts)
 
+	var deadline *durationpb.Duration
+	if o.Deadline > 0 {
+		deadline = durationpb.New(o.Deadline)
+	}
+
 	return r.client.Once(ctx, &runnerpb.Once_Request{
 		Options: &runnerpb.Once_Options{
 			ProxyName:      o.Proxy.Name,
 			ProxyNamespace: o.Proxy.Namespace,
+			Branch:         o.Branch,
+			MaxIterations:  o.MaxIterations,
+			Deadline:       deadline,
+			Trace:          o.Trace,
+			Incremental:    o.Incremental,
 		},
 	})
 }
@@ -113,6 +129,7 @@ func (r *client) Load(ctx context.Context, opts ...LoadOption) error {
 		Options: &runnerpb.Load_Options{
 			ProxyName:      o.Proxy.Name,
 			ProxyNamespace: o.Proxy.Namespace,
+			Branch:         o.Branch,
 		},
 	}); err != nil {
 		return err
@@ -120,6 +137,32 @@ func (r *client) Load(ctx context.Context, opts ...LoadOption) error {
 	return nil
 }
 
+func (r *client) Deps(ctx context.Context, opts ...DepsOption) (*runnerpb.Deps_Response, error) {
+	o := DepsOptions{}
+	o.ApplyOptions(opts)
+
+	return r.client.Deps(ctx, &runnerpb.Deps_Request{
+		Options: &runnerpb.Deps_Options{
+			ProxyName:      o.Proxy.Name,
+			ProxyNamespace: o.Proxy.Namespace,
+			Branch:         o.Branch,
+		},
+	})
+}
+
+func (r *client) Status(ctx context.Context, opts ...StatusOption) (*runnerpb.Status_Response, error) {
+	o := StatusOptions{}
+	o.ApplyOptions(opts)
+
+	return r.client.Status(ctx, &runnerpb.Status_Request{
+		Options: &runnerpb.Status_Options{
+			ProxyName:      o.Proxy.Name,
+			ProxyNamespace: o.Proxy.Namespace,
+			Branch:         o.Branch,
+		},
+	})
+}
+
 type StartOption interface {
 	// ApplyToGet applies this configuration to the given get options.
 	ApplyToStart(*StartOptions)
@@ -129,10 +172,13 @@ var _ StartOption = &StartOptions{}
 
 type StartOptions struct {
 	Proxy types.NamespacedName
+	// Branch selects the runner of the branch, empty selects the checked out branch
+	Branch string
 }
 
 func (o *StartOptions) ApplyToStart(lo *StartOptions) {
 	lo.Proxy = o.Proxy
+	lo.Branch = o.Branch
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -152,10 +198,13 @@ var _ StopOption = &StopOptions{}
 
 type StopOptions struct {
 	Proxy types.NamespacedName
+	// Branch selects the runner of the branch, empty selects the checked out branch
+	Branch string
 }
 
 func (o *StopOptions) ApplyToStop(lo *StopOptions) {
 	lo.Proxy = o.Proxy
+	lo.Branch = o.Branch
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -175,10 +224,25 @@ var _ OnceOption = &OnceOptions{}
 
 type OnceOptions struct {
 	Proxy types.NamespacedName
+	// Branch selects the runner of the branch, empty selects the checked out branch
+	Branch string
+	// MaxIterations is the amount of times a reconciler can reconcile the same object, 0 uses the server default
+	MaxIterations int64
+	// Deadline is the time the reconcilers get to converge, 0 uses the server default
+	Deadline time.Duration
+	// Trace records a trace per reconcile
+	Trace bool
+	// Incremental only reloads the input that changed since the last snapshot
+	Incremental bool
 }
 
 func (o *OnceOptions) ApplyToOnce(lo *OnceOptions) {
 	lo.Proxy = o.Proxy
+	lo.Branch = o.Branch
+	lo.MaxIterations = o.MaxIterations
+	lo.Deadline = o.Deadline
+	lo.Trace = o.Trace
+	lo.Incremental = o.Incremental
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -198,10 +262,13 @@ var _ LoadOption = &LoadOptions{}
 
 type LoadOptions struct {
 	Proxy types.NamespacedName
+	// Branch selects the runner of the branch, empty selects the checked out branch
+	Branch string
 }
 
 func (o *LoadOptions) ApplyToLoad(lo *LoadOptions) {
 	lo.Proxy = o.Proxy
+	lo.Branch = o.Branch
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -212,3 +279,55 @@ func (o *LoadOptions) ApplyOptions(opts []LoadOption) *LoadOptions {
 	}
 	return o
 }
+
+type DepsOption interface {
+	ApplyToDeps(*DepsOptions)
+}
+
+var _ DepsOption = &DepsOptions{}
+
+type DepsOptions struct {
+	Proxy types.NamespacedName
+	// Branch selects the runner of the branch, empty selects the checked out branch
+	Branch string
+}
+
+func (o *DepsOptions) ApplyToDeps(lo *DepsOptions) {
+	lo.Proxy = o.Proxy
+	lo.Branch = o.Branch
+}
+
+// ApplyOptions applies the given get options on these options,
+// and then returns itself (for convenient chaining).
+func (o *DepsOptions) ApplyOptions(opts []DepsOption) *DepsOptions {
+	for _, opt := range opts {
+		opt.ApplyToDeps(o)
+	}
+	return o
+}
+
+type StatusOption interface {
+	ApplyToStatus(*StatusOptions)
+}
+
+var _ StatusOption = &StatusOptions{}
+
+type StatusOptions struct {
+	Proxy types.NamespacedName
+	// Branch selects the runner of the branch, empty selects the runners of all branches
+	Branch string
+}
+
+func (o *StatusOptions) ApplyToStatus(lo *StatusOptions) {
+	lo.Proxy = o.Proxy
+	lo.Branch = o.Branch
+}
+
+// ApplyOptions applies the given get options on these options,
+// and then returns itself (for convenient chaining).
+func (o *StatusOptions) ApplyOptions(opts []StatusOption) *StatusOptions {
+	for _, opt := range opts {
+		opt.ApplyToStatus(o)
+	}
+	return o
+}
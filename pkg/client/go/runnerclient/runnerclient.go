@@ -74,3 +74,9 @@ func (r *runnerclient) Once(ctx context.Context, in *runnerpb.Once_Request, opts
 func (r *runnerclient) Load(ctx context.Context, in *runnerpb.Load_Request, opts ...grpc.CallOption) (*runnerpb.Load_Response, error) {
 	return r.client.Load(ctx, in, opts...)
 }
+func (r *runnerclient) Deps(ctx context.Context, in *runnerpb.Deps_Request, opts ...grpc.CallOption) (*runnerpb.Deps_Response, error) {
+	return r.client.Deps(ctx, in, opts...)
+}
+func (r *runnerclient) Status(ctx context.Context, in *runnerpb.Status_Request, opts ...grpc.CallOption) (*runnerpb.Status_Response, error) {
+	return r.client.Status(ctx, in, opts...)
+}
@@ -42,6 +42,8 @@ type Client interface {
 	List(ctx context.Context, u runtime.Unstructured, opts ...ListOption) error
 	Diff(ctx context.Context, opts ...DiffOption) ([]byte, error)
 	Result(ctx context.Context, opts ...ResultOption) (*runnerpb.Once_RunResponse, error)
+	Tag(ctx context.Context, id, tag string, opts ...TagOption) error
+	Restore(ctx context.Context, id string, opts ...RestoreOption) error
 	Watch(ctx context.Context, u runtime.Unstructured, opts ...ListOption) chan *snapshotpb.Watch_Response
 	Close() error
 }
@@ -152,6 +154,8 @@ func (r *client) Diff(ctx context.Context, opts ...DiffOption) ([]byte, error) {
 			ShowManagedField: o.ShowManagedFields,
 			ShowChoreoAPIs:   o.ShowChoreoAPIs,
 			ShowFinalConfig:  o.ShowFinalConfig,
+			From:             o.From,
+			To:               o.To,
 		},
 	})
 	if err != nil {
@@ -178,6 +182,35 @@ func (r *client) Result(ctx context.Context, opts ...ResultOption) (*runnerpb.On
 	return rsp.RunResponse, nil
 }
 
+func (r *client) Tag(ctx context.Context, id, tag string, opts ...TagOption) error {
+	o := TagOptions{}
+	o.ApplyOptions(opts)
+
+	_, err := r.client.Tag(ctx, &snapshotpb.Tag_Request{
+		Id:  id,
+		Tag: tag,
+		Options: &snapshotpb.Tag_Options{
+			ProxyName:      o.Proxy.Name,
+			ProxyNamespace: o.Proxy.Namespace,
+		},
+	})
+	return err
+}
+
+func (r *client) Restore(ctx context.Context, id string, opts ...RestoreOption) error {
+	o := RestoreOptions{}
+	o.ApplyOptions(opts)
+
+	_, err := r.client.Restore(ctx, &snapshotpb.Restore_Request{
+		Id: id,
+		Options: &snapshotpb.Restore_Options{
+			ProxyName:      o.Proxy.Name,
+			ProxyNamespace: o.Proxy.Namespace,
+		},
+	})
+	return err
+}
+
 func (r *client) Watch(ctx context.Context, u runtime.Unstructured, opts ...ListOption) chan *snapshotpb.Watch_Response {
 	o := ListOptions{}
 	o.ApplyOptions(opts)
@@ -405,6 +438,10 @@ type DiffOptions struct {
 	ShowManagedFields bool
 	ShowChoreoAPIs    bool
 	ShowFinalConfig   bool
+	// From identifies the base snapshot of the diff
+	From string
+	// To identifies the snapshot compared against the base
+	To string
 }
 
 func (o *DiffOptions) ApplyToDiff(lo *DiffOptions) {
@@ -412,6 +449,8 @@ func (o *DiffOptions) ApplyToDiff(lo *DiffOptions) {
 	lo.ShowManagedFields = o.ShowManagedFields
 	lo.ShowChoreoAPIs = o.ShowChoreoAPIs
 	lo.ShowFinalConfig = o.ShowFinalConfig
+	lo.From = o.From
+	lo.To = o.To
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -446,6 +485,52 @@ func (o *ResultOptions) ApplyOptions(opts []ResultOption) *ResultOptions {
 	return o
 }
 
+type TagOption interface {
+	ApplyToTag(*TagOptions)
+}
+
+var _ TagOption = &TagOptions{}
+
+type TagOptions struct {
+	Proxy types.NamespacedName
+}
+
+func (o *TagOptions) ApplyToTag(lo *TagOptions) {
+	lo.Proxy = o.Proxy
+}
+
+// ApplyOptions applies the given tag options on these options,
+// and then returns itself (for convenient chaining).
+func (o *TagOptions) ApplyOptions(opts []TagOption) *TagOptions {
+	for _, opt := range opts {
+		opt.ApplyToTag(o)
+	}
+	return o
+}
+
+type RestoreOption interface {
+	ApplyToRestore(*RestoreOptions)
+}
+
+var _ RestoreOption = &RestoreOptions{}
+
+type RestoreOptions struct {
+	Proxy types.NamespacedName
+}
+
+func (o *RestoreOptions) ApplyToRestore(lo *RestoreOptions) {
+	lo.Proxy = o.Proxy
+}
+
+// ApplyOptions applies the given restore options on these options,
+// and then returns itself (for convenient chaining).
+func (o *RestoreOptions) ApplyOptions(opts []RestoreOption) *RestoreOptions {
+	for _, opt := range opts {
+		opt.ApplyToRestore(o)
+	}
+	return o
+}
+
 type ListOption interface {
 	ApplyToList(*ListOptions)
 }
@@ -37,6 +37,8 @@ type SnapshotClient interface {
 	Delete(ctx context.Context, in *snapshotpb.Delete_Request, opts ...grpc.CallOption) (*snapshotpb.Delete_Response, error)
 	Diff(ctx context.Context, in *snapshotpb.Diff_Request, opts ...grpc.CallOption) (*snapshotpb.Diff_Response, error)
 	Result(ctx context.Context, in *snapshotpb.Result_Request, opts ...grpc.CallOption) (*snapshotpb.Result_Response, error)
+	Tag(ctx context.Context, in *snapshotpb.Tag_Request, opts ...grpc.CallOption) (*snapshotpb.Tag_Response, error)
+	Restore(ctx context.Context, in *snapshotpb.Restore_Request, opts ...grpc.CallOption) (*snapshotpb.Restore_Response, error)
 	Watch(ctx context.Context, in *snapshotpb.Watch_Request, opts ...grpc.CallOption) chan *snapshotpb.Watch_Response
 	Close() error
 }
@@ -91,6 +93,14 @@ func (r *snapshotclient) Result(ctx context.Context, in *snapshotpb.Result_Reque
 	return r.client.Result(ctx, in, opts...)
 }
 
+func (r *snapshotclient) Tag(ctx context.Context, in *snapshotpb.Tag_Request, opts ...grpc.CallOption) (*snapshotpb.Tag_Response, error) {
+	return r.client.Tag(ctx, in, opts...)
+}
+
+func (r *snapshotclient) Restore(ctx context.Context, in *snapshotpb.Restore_Request, opts ...grpc.CallOption) (*snapshotpb.Restore_Response, error) {
+	return r.client.Restore(ctx, in, opts...)
+}
+
 func (r *snapshotclient) Watch(ctx context.Context, in *snapshotpb.Watch_Request, opts ...grpc.CallOption) chan *snapshotpb.Watch_Response {
 	log := log.FromContext(ctx)
 	var stream snapshotpb.Snapshot_WatchClient
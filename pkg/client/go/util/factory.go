@@ -20,6 +20,7 @@ import (
 	"errors"
 
 	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/client/go/auditclient"
 	"github.com/kform-dev/choreo/pkg/client/go/branchclient"
 	"github.com/kform-dev/choreo/pkg/client/go/choreoclient"
 	"github.com/kform-dev/choreo/pkg/client/go/config"
@@ -40,6 +41,7 @@ type Factory interface {
 	GetBranchClient() branchclient.Client
 	GetRunnerClient() runnerclient.Client
 	GetSnapshotClient() snapshotclient.Client
+	GetAuditClient() auditclient.Client
 	Close() error
 	GetBranch() string
 	GetProxy() types.NamespacedName
@@ -77,6 +79,11 @@ func NewFactory(clientGetter genericclioptions.ClientGetter) (Factory, error) {
 		return nil, err
 	}
 
+	auditClient, err := clientGetter.ToAuditClient()
+	if err != nil {
+		return nil, err
+	}
+
 	resourceClient, err := clientGetter.ToResourceClient()
 	if err != nil {
 		return nil, err
@@ -94,6 +101,7 @@ func NewFactory(clientGetter genericclioptions.ClientGetter) (Factory, error) {
 		branchClient:    branchClient,
 		runnerClient:    runnerClient,
 		snapshotClient:  snapshotClient,
+		auditClient:     auditClient,
 	}, nil
 }
 
@@ -107,6 +115,7 @@ type factory struct {
 	branchClient    branchclient.Client
 	runnerClient    runnerclient.Client
 	snapshotClient  snapshotclient.Client
+	auditClient     auditclient.Client
 }
 
 func (r *factory) Close() error {
@@ -161,6 +170,10 @@ func (r *factory) GetSnapshotClient() snapshotclient.Client {
 	return r.snapshotClient
 }
 
+func (r *factory) GetAuditClient() auditclient.Client {
+	return r.auditClient
+}
+
 func (r *factory) GetBranch() string {
 	return r.clientGetter.ToBranch()
 }
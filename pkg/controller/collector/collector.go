@@ -3,16 +3,26 @@ package collector
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/henderiw/logger/log"
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"google.golang.org/protobuf/proto"
 )
 
 type Collector interface {
 	Start(ctx context.Context, once bool)
+	// Hold prevents a run once from finishing, e.g. while reconcilers still need to be started
+	Hold()
+	// Release releases a hold
+	Release()
+	// Client returns a client that tracks the objects written by the reconcilers
+	// to detect objects that do not converge
+	Client(client resourceclient.Client) resourceclient.Client
 }
 
 type TaskID struct {
@@ -27,15 +37,53 @@ func (r TaskID) String() string {
 	return fmt.Sprintf("%s.%s.%s.%s", r.ReconcilerName, r.Kind, r.Group, r.Name)
 }
 
+type Option interface {
+	ApplyToCollector(*Options)
+}
+
+var _ Option = &Options{}
+
+type Options struct {
+	// MaxIterations is the amount of times a reconciler can reconcile the same object
+	// before the run once fails, 0 means unlimited
+	MaxIterations int64
+	// Deadline is the time the run once gets to converge, 0 means unlimited
+	Deadline time.Duration
+	// TraceFn is called with the results that carry a reconcile trace
+	TraceFn func(result *runnerpb.ReconcileResult)
+}
+
+func (o *Options) ApplyToCollector(lo *Options) {
+	lo.MaxIterations = o.MaxIterations
+	lo.Deadline = o.Deadline
+	lo.TraceFn = o.TraceFn
+}
+
+// ApplyOptions applies the given collector options on these options,
+// and then returns itself (for convenient chaining).
+func (o *Options) ApplyOptions(opts []Option) *Options {
+	for _, opt := range opts {
+		opt.ApplyToCollector(o)
+	}
+	return o
+}
+
 func New(
 	reconcilerResultCh chan *runnerpb.ReconcileResult,
 	collectorResultCh chan *runnerpb.Once_RunResult,
+	opts ...Option,
 ) Collector {
+	o := Options{}
+	o.ApplyOptions(opts)
 
 	return &collector{
 		reconcilerResultCh: reconcilerResultCh,
 		collectorResultCh:  collectorResultCh,
+		opts:               o,
+		tracker:            newTracker(),
 		work:               map[TaskID]time.Time{},
+		iterations:         map[TaskID]int64{},
+		requeues:           map[TaskID]int64{},
 		results:            []*runnerpb.ReconcileResult{},
 	}
 }
@@ -43,13 +91,48 @@ func New(
 type collector struct {
 	reconcilerResultCh chan *runnerpb.ReconcileResult
 	collectorResultCh  chan *runnerpb.Once_RunResult
+	opts               Options
+	tracker            *tracker
 	m                  sync.Mutex
 	work               map[TaskID]time.Time
+	iterations         map[TaskID]int64
+	requeues           map[TaskID]int64
 	results            []*runnerpb.ReconcileResult
 	finishing          bool
 	done               bool
 	idle               int
 	finish             time.Time
+	holds              int
+	// reported is set once the run result is sent, the collector only reports once
+	reported bool
+}
+
+func (r *collector) Hold() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.holds++
+}
+
+func (r *collector) Release() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.holds--
+}
+
+func (r *collector) isHeld() bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.holds > 0
+}
+
+func (r *collector) isReported() bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.reported
+}
+
+func (r *collector) Client(client resourceclient.Client) resourceclient.Client {
+	return &trackingClient{Client: client, tracker: r.tracker}
 }
 
 func (r *collector) Start(ctx context.Context, once bool) {
@@ -66,6 +149,13 @@ func (r *collector) Start(ctx context.Context, once bool) {
 		}
 	}()
 
+	var deadlineCh <-chan time.Time
+	if once && r.opts.Deadline > 0 {
+		deadlineTimer := time.NewTimer(r.opts.Deadline)
+		defer deadlineTimer.Stop()
+		deadlineCh = deadlineTimer.C
+	}
+
 	var start time.Time
 	running := true
 
@@ -82,36 +172,56 @@ func (r *collector) Start(ctx context.Context, once bool) {
 				start = time.Now()
 			}
 			r.handleResult(ctx, result)
+		case <-deadlineCh:
+			r.handleDeadline(ctx)
 		case <-waitTicker.C:
-			if once {
-				if r.done {
-					log.Debug("done", "elapsed time (sec)", r.finish.Sub(start).Seconds())
-					r.collectorResultCh <- &runnerpb.Once_RunResult{
-						Success:       true,
-						ExecutionTime: fmt.Sprintf("%v", r.finish.Sub(start).Seconds()),
-						Results:       r.results,
-					}
-				}
-				if r.finishing {
-					if !r.done {
-						log.Debug("finishing", "elapsed time", r.finish.Sub(start).Seconds())
-					}
-					r.done = true
-				}
-				if r.idle == 3 {
-					r.done = true
-				}
-				r.idle++
+			if !once || r.reported {
+				continue
+			}
+			if r.isHeld() || len(r.work) > 0 {
+				// restart the finish detection once released or once the
+				// pending work, including requeued work, is done
+				r.idle = 0
+				r.finishing = false
+				r.done = false
+				continue
+			}
+			if r.done {
+				log.Debug("done", "elapsed time (sec)", r.finish.Sub(start).Seconds())
+				r.report(ctx, &runnerpb.Once_RunResult{
+					Success:       true,
+					ExecutionTime: fmt.Sprintf("%v", r.finish.Sub(start).Seconds()),
+					Results:       r.results,
+				})
+				continue
+			}
+			if r.finishing {
+				log.Debug("finishing", "elapsed time", r.finish.Sub(start).Seconds())
+				r.done = true
+			}
+			if r.idle == 3 {
+				r.done = true
 			}
+			r.idle++
 		}
 	}
 }
 
 func (r *collector) handleResult(ctx context.Context, result *runnerpb.ReconcileResult) {
+	cloneResult := proto.Clone(result).(*runnerpb.ReconcileResult)
+	// the trace is handed over without holding the lock, since the receiver
+	// can block; the run result is only reported from this goroutine
+	if cloneResult.Trace != nil && r.opts.TraceFn != nil && !r.isReported() {
+		r.opts.TraceFn(cloneResult)
+	}
+
 	r.m.Lock()
 	defer r.m.Unlock()
 	log := log.FromContext(ctx)
-	cloneResult := proto.Clone(result).(*runnerpb.ReconcileResult)
+	if r.reported {
+		// the run result is already reported
+		return
+	}
 	r.results = append(r.results, cloneResult)
 	taskID := TaskID{
 		ReconcilerName: result.ReconcilerName,
@@ -126,19 +236,33 @@ func (r *collector) handleResult(ctx context.Context, result *runnerpb.Reconcile
 		delete(r.work, taskID)
 		log.Debug("execution failed", "taskID", taskID.String(), "error", result.Message)
 		// context of the error
-		r.collectorResultCh <- &runnerpb.Once_RunResult{
+		r.report(ctx, &runnerpb.Once_RunResult{
 			Success: false,
 			TaskId:  taskID.String(),
 			Message: result.Message,
 			Results: r.results,
-		}
+		})
 		return
 	case runnerpb.Operation_REQUEUE:
 		// this is a dummy time -> to ensure that the collector knows there is ongoing work
 		r.work[taskID] = result.EventTime.AsTime()
+		r.requeues[taskID]++
 		log.Debug("execution requeue", "taskID", taskID.String(), "error", result.Message)
 	case runnerpb.Operation_START:
 		r.work[taskID] = result.EventTime.AsTime()
+		r.iterations[taskID]++
+		if r.opts.MaxIterations > 0 && r.iterations[taskID] > r.opts.MaxIterations {
+			log.Debug("execution exceeded max iterations", "taskID", taskID.String(), "iterations", r.iterations[taskID])
+			r.report(ctx, r.failedResult(taskID.String(), []*runnerpb.Once_ConvergenceIssue{{
+				Type:        runnerpb.Once_MAX_ITERATIONS,
+				Reconcilers: []string{taskID.ReconcilerName},
+				Resource:    proto.Clone(result.Resource).(*runnerpb.Resource),
+				Iterations:  r.iterations[taskID],
+				Message: fmt.Sprintf("%s %s reconciled %d times, %d requeues, max iterations %d",
+					taskID.Kind, taskID.Name, r.iterations[taskID], r.requeues[taskID], r.opts.MaxIterations),
+			}}))
+			return
+		}
 	case runnerpb.Operation_STOP:
 		delete(r.work, taskID)
 		// this indicated the work queue is
@@ -147,6 +271,88 @@ func (r *collector) handleResult(ctx context.Context, result *runnerpb.Reconcile
 			r.finishing = true
 		}
 	}
+	if issues := r.tracker.getIssues(); len(issues) > 0 {
+		log.Debug("execution oscillates", "taskID", taskID.String())
+		r.report(ctx, r.failedResult("", issues))
+	}
+}
+
+// handleDeadline fails the run with the work that is still pending when the deadline expires
+func (r *collector) handleDeadline(ctx context.Context) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.reported {
+		return
+	}
+	taskIDs := make([]TaskID, 0, len(r.work))
+	for taskID := range r.work {
+		taskIDs = append(taskIDs, taskID)
+	}
+	sort.Slice(taskIDs, func(i, j int) bool {
+		return taskIDs[i].String() < taskIDs[j].String()
+	})
+	issues := make([]*runnerpb.Once_ConvergenceIssue, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		issues = append(issues, &runnerpb.Once_ConvergenceIssue{
+			Type:        runnerpb.Once_DEADLINE,
+			Reconcilers: []string{taskID.ReconcilerName},
+			Resource: &runnerpb.Resource{
+				Group:     taskID.Group,
+				Kind:      taskID.Kind,
+				Namespace: taskID.Namespace,
+				Name:      taskID.Name,
+			},
+			Iterations: r.iterations[taskID],
+			Message: fmt.Sprintf("%s %s still pending after deadline %s, %d requeues",
+				taskID.Kind, taskID.Name, r.opts.Deadline, r.requeues[taskID]),
+		})
+	}
+	if len(issues) == 0 {
+		issues = append(issues, &runnerpb.Once_ConvergenceIssue{
+			Type:    runnerpb.Once_DEADLINE,
+			Message: fmt.Sprintf("run not finished after deadline %s", r.opts.Deadline),
+		})
+	}
+	r.report(ctx, r.failedResult("", issues))
+}
+
+func (r *collector) failedResult(taskID string, issues []*runnerpb.Once_ConvergenceIssue) *runnerpb.Once_RunResult {
+	msgs := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		msgs = append(msgs, IssueString(issue))
+	}
+	return &runnerpb.Once_RunResult{
+		Success:           false,
+		TaskId:            taskID,
+		Message:           fmt.Sprintf("reconcilers did not converge: %s", strings.Join(msgs, "; ")),
+		Results:           r.results,
+		ConvergenceIssues: issues,
+	}
+}
+
+// report sends the run result, only the first result is reported
+func (r *collector) report(ctx context.Context, result *runnerpb.Once_RunResult) {
+	if r.reported {
+		return
+	}
+	r.reported = true
+	select {
+	case <-ctx.Done():
+	case r.collectorResultCh <- result:
+	}
+}
+
+// IssueString returns a human readable description of the convergence issue
+func IssueString(issue *runnerpb.Once_ConvergenceIssue) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s: %s", issue.Type.String(), issue.Message))
+	if len(issue.Reconcilers) > 0 {
+		sb.WriteString(fmt.Sprintf(", reconcilers: %s", strings.Join(issue.Reconcilers, ", ")))
+	}
+	if len(issue.Fields) > 0 {
+		sb.WriteString(fmt.Sprintf(", fields: %s", strings.Join(issue.Fields, ", ")))
+	}
+	return sb.String()
 }
 
 func drainTicker(ticker *time.Ticker) {
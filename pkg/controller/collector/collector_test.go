@@ -0,0 +1,102 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCollector(t *testing.T) {
+	resource := &runnerpb.Resource{Group: "example.com", Kind: "Test", Namespace: "default", Name: "a"}
+	// requeueLoop returns the results of a reconciler that requeues the resource n times
+	requeueLoop := func(n int) []runnerpb.Operation {
+		ops := []runnerpb.Operation{}
+		for i := 0; i < n; i++ {
+			ops = append(ops, runnerpb.Operation_START, runnerpb.Operation_REQUEUE)
+		}
+		return ops
+	}
+
+	cases := map[string]struct {
+		opts         *Options
+		operations   []runnerpb.Operation
+		expectIssues []*runnerpb.Once_ConvergenceIssue
+	}{
+		"MaxIterations": {
+			opts:       &Options{MaxIterations: 2},
+			operations: requeueLoop(10),
+			expectIssues: []*runnerpb.Once_ConvergenceIssue{{
+				Type:        runnerpb.Once_MAX_ITERATIONS,
+				Reconcilers: []string{"r1"},
+				Resource:    resource,
+				Iterations:  3,
+				Message:     "Test a reconciled 3 times, 2 requeues, max iterations 2",
+			}},
+		},
+		"Deadline": {
+			opts:       &Options{Deadline: 100 * time.Millisecond},
+			operations: append(requeueLoop(1), runnerpb.Operation_START),
+			expectIssues: []*runnerpb.Once_ConvergenceIssue{{
+				Type:        runnerpb.Once_DEADLINE,
+				Reconcilers: []string{"r1"},
+				Resource:    resource,
+				Iterations:  2,
+				Message:     "Test a still pending after deadline 100ms, 1 requeues",
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			reconcilerResultCh := make(chan *runnerpb.ReconcileResult, len(tc.operations))
+			collectorResultCh := make(chan *runnerpb.Once_RunResult, 1)
+			c := New(reconcilerResultCh, collectorResultCh, tc.opts)
+			go c.Start(ctx, true)
+
+			for _, op := range tc.operations {
+				reconcilerResultCh <- &runnerpb.ReconcileResult{
+					ReconcilerName: "r1",
+					EventTime:      timestamppb.Now(),
+					Operation:      op,
+					Resource:       resource,
+				}
+			}
+
+			select {
+			case result := <-collectorResultCh:
+				if result.Success {
+					t.Fatalf("expected the run to fail")
+				}
+				if diff := cmp.Diff(tc.expectIssues, result.ConvergenceIssues, protocmp.Transform()); diff != "" {
+					t.Errorf("unexpected issues (-want +got):\n%s", diff)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timeout waiting for the run result")
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// oscillationThreshold is the amount of times an object returns to an earlier
+	// state before it is reported as oscillating
+	oscillationThreshold = 3
+	// maxObjectStates is the amount of states that are kept per object
+	maxObjectStates = 8
+)
+
+type objectKey struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+type objectState struct {
+	hash    string
+	manager string
+	content map[string]any
+}
+
+type objectHistory struct {
+	states   []objectState
+	returns  int64
+	managers sets.Set[string]
+	fields   sets.Set[string]
+}
+
+// tracker records the content of the objects written by the reconcilers
+// to detect objects that keep returning to an earlier state
+type tracker struct {
+	m       sync.Mutex
+	objects map[objectKey]*objectHistory
+	issues  []*runnerpb.Once_ConvergenceIssue
+}
+
+func newTracker() *tracker {
+	return &tracker{
+		objects: map[objectKey]*objectHistory{},
+	}
+}
+
+// getIssues returns the oscillations detected since the last call
+func (r *tracker) getIssues() []*runnerpb.Once_ConvergenceIssue {
+	r.m.Lock()
+	defer r.m.Unlock()
+	issues := r.issues
+	r.issues = nil
+	return issues
+}
+
+func (r *tracker) record(u runtime.Unstructured, manager string) {
+	content := trackedContent(u.UnstructuredContent())
+	hash, err := hashContent(content)
+	if err != nil {
+		return
+	}
+	obj := &unstructured.Unstructured{Object: u.UnstructuredContent()}
+	key := objectKey{
+		Group:     obj.GroupVersionKind().Group,
+		Kind:      obj.GetKind(),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	h, ok := r.objects[key]
+	if !ok {
+		h = &objectHistory{managers: sets.New[string](), fields: sets.New[string]()}
+		r.objects[key] = h
+	}
+	if len(h.states) > 0 && h.states[len(h.states)-1].hash == hash {
+		// no change
+		return
+	}
+	for i := len(h.states) - 2; i >= 0; i-- {
+		if h.states[i].hash != hash {
+			continue
+		}
+		// the object returned to an earlier state, the states in between
+		// identify who changed which fields
+		h.returns++
+		if manager != "" {
+			h.managers.Insert(manager)
+		}
+		for _, state := range h.states[i+1:] {
+			if state.manager != "" {
+				h.managers.Insert(state.manager)
+			}
+			h.fields.Insert(diffFields(h.states[i].content, state.content, "")...)
+		}
+		if h.returns == oscillationThreshold {
+			r.issues = append(r.issues, &runnerpb.Once_ConvergenceIssue{
+				Type:        runnerpb.Once_OSCILLATION,
+				Reconcilers: sets.List(h.managers),
+				Resource: &runnerpb.Resource{
+					Group:     key.Group,
+					Kind:      key.Kind,
+					Namespace: key.Namespace,
+					Name:      key.Name,
+				},
+				Fields:     sets.List(h.fields),
+				Iterations: h.returns,
+				Message:    fmt.Sprintf("%s %s returned %d times to an earlier state", key.Kind, key.Name, h.returns),
+			})
+		}
+		break
+	}
+	h.states = append(h.states, objectState{hash: hash, manager: manager, content: content})
+	if len(h.states) > maxObjectStates {
+		h.states = h.states[len(h.states)-maxObjectStates:]
+	}
+}
+
+// trackedContent returns the content of the object without the fields that change
+// on every write, such that writes with the same intent result in the same content
+func trackedContent(obj map[string]any) map[string]any {
+	content := runtime.DeepCopyJSON(obj)
+	metadata := map[string]any{}
+	if m, ok := content["metadata"].(map[string]any); ok {
+		for _, k := range []string{"labels", "annotations", "ownerReferences", "finalizers"} {
+			if v, ok := m[k]; ok {
+				metadata[k] = v
+			}
+		}
+	}
+	content["metadata"] = metadata
+	if status, ok := content["status"].(map[string]any); ok {
+		delete(status, "observedGeneration")
+		if conditions, ok := status["conditions"].([]any); ok {
+			for _, condition := range conditions {
+				if condition, ok := condition.(map[string]any); ok {
+					delete(condition, "lastTransitionTime")
+					delete(condition, "observedGeneration")
+				}
+			}
+		}
+	}
+	return content
+}
+
+func hashContent(content map[string]any) (string, error) {
+	// json sorts the map keys, which makes the encoding deterministic
+	b, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// diffFields returns the paths of the fields that differ between a and b
+func diffFields(a, b any, path string) []string {
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if !aok || !bok {
+		if reflect.DeepEqual(a, b) {
+			return nil
+		}
+		return []string{path}
+	}
+	keys := sets.New[string]()
+	for k := range am {
+		keys.Insert(k)
+	}
+	for k := range bm {
+		keys.Insert(k)
+	}
+	fields := []string{}
+	for _, k := range sets.List(keys) {
+		fieldPath := k
+		if path != "" {
+			fieldPath = path + "." + k
+		}
+		fields = append(fields, diffFields(am[k], bm[k], fieldPath)...)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// trackingClient records the objects written through the client in the tracker
+type trackingClient struct {
+	resourceclient.Client
+	tracker *tracker
+}
+
+func (r *trackingClient) Apply(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.ApplyOption) error {
+	if err := r.Client.Apply(ctx, u, opts...); err != nil {
+		return err
+	}
+	o := resourceclient.ApplyOptions{}
+	o.ApplyOptions(opts)
+	r.tracker.record(u, o.FieldManager)
+	return nil
+}
+
+func (r *trackingClient) ApplyStatus(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.ApplyOption) error {
+	if err := r.Client.ApplyStatus(ctx, u, opts...); err != nil {
+		return err
+	}
+	o := resourceclient.ApplyOptions{}
+	o.ApplyOptions(opts)
+	r.tracker.record(u, o.FieldManager)
+	return nil
+}
+
+func (r *trackingClient) Create(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.CreateOption) error {
+	if err := r.Client.Create(ctx, u, opts...); err != nil {
+		return err
+	}
+	r.tracker.record(u, "")
+	return nil
+}
+
+func (r *trackingClient) Update(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.UpdateOption) error {
+	if err := r.Client.Update(ctx, u, opts...); err != nil {
+		return err
+	}
+	r.tracker.record(u, "")
+	return nil
+}
+
+func (r *trackingClient) UpdateStatus(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.UpdateOption) error {
+	if err := r.Client.UpdateStatus(ctx, u, opts...); err != nil {
+		return err
+	}
+	r.tracker.record(u, "")
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type write struct {
+	manager string
+	value   string
+}
+
+func testObject(value string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1alpha1",
+		"kind":       "Test",
+		"metadata": map[string]any{
+			"name":            "a",
+			"namespace":       "default",
+			"resourceVersion": value, // changes on every write and is ignored
+		},
+		"spec": map[string]any{
+			"value": value,
+			"fixed": "x",
+		},
+	}}
+}
+
+func TestTracker(t *testing.T) {
+	cases := map[string]struct {
+		writes          []write
+		expectIssue     bool
+		expectManagers  []string
+		expectFields    []string
+		expectIteration int64
+	}{
+		"NoChange": {
+			writes: []write{{"r1", "a"}, {"r1", "a"}, {"r1", "a"}, {"r1", "a"}},
+		},
+		"Converging": {
+			writes: []write{{"r1", "a"}, {"r2", "b"}, {"r1", "c"}, {"r2", "d"}},
+		},
+		"ReturnBelowThreshold": {
+			writes: []write{{"r1", "a"}, {"r2", "b"}, {"r1", "a"}, {"r2", "b"}},
+		},
+		"PingPong": {
+			writes:          []write{{"r1", "a"}, {"r2", "b"}, {"r1", "a"}, {"r2", "b"}, {"r1", "a"}, {"r2", "b"}},
+			expectIssue:     true,
+			expectManagers:  []string{"r1", "r2"},
+			expectFields:    []string{"spec.value"},
+			expectIteration: oscillationThreshold,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tracker := newTracker()
+			for _, w := range tc.writes {
+				tracker.record(testObject(w.value), w.manager)
+			}
+			issues := tracker.getIssues()
+			if !tc.expectIssue {
+				if len(issues) != 0 {
+					t.Errorf("unexpected issues: %v", issues)
+				}
+				return
+			}
+			if len(issues) != 1 {
+				t.Fatalf("expected 1 issue, got %d", len(issues))
+			}
+			issue := issues[0]
+			if issue.Type != runnerpb.Once_OSCILLATION {
+				t.Errorf("expected type %s, got %s", runnerpb.Once_OSCILLATION, issue.Type)
+			}
+			if diff := cmp.Diff(tc.expectManagers, issue.Reconcilers); diff != "" {
+				t.Errorf("unexpected reconcilers (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectFields, issue.Fields); diff != "" {
+				t.Errorf("unexpected fields (-want +got):\n%s", diff)
+			}
+			if issue.Iterations != tc.expectIteration {
+				t.Errorf("expected iterations %d, got %d", tc.expectIteration, issue.Iterations)
+			}
+			if issue.Resource.GetKind() != "Test" || issue.Resource.GetName() != "a" {
+				t.Errorf("unexpected resource %v", issue.Resource)
+			}
+			if issues := tracker.getIssues(); len(issues) != 0 {
+				t.Errorf("issues should only be reported once, got %v", issues)
+			}
+		})
+	}
+}
@@ -21,7 +21,9 @@ import (
 
 	"github.com/henderiw/logger/log"
 	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/controller/trace"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/kform-dev/choreo/pkg/server/selector"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -34,6 +36,8 @@ type For struct {
 	Queue    workqueue.TypedRateLimitingInterface[types.NamespacedName]
 	Selector selector.Selector
 	Client   resourceclient.Client
+	// Triggers records the events that queue a request when tracing is enabled
+	Triggers *trace.Triggers
 }
 
 func (r *For) EventHandler(ctx context.Context, _ resourcepb.Watch_EventType, obj runtime.Unstructured) bool {
@@ -55,6 +59,7 @@ func (r *For) EventHandler(ctx context.Context, _ resourcepb.Watch_EventType, ob
 		Namespace: usrc.GetNamespace(),
 	}
 	log.Debug("watch reconcile event", "reconciler name", r.Name, "src", obj.GetObjectKind().GroupVersionKind().String(), "resource name", usrc.GetName())
+	r.Triggers.Add(req, runnerpb.Trigger_FOR, usrc)
 	r.Queue.Add(req)
 	return true
 }
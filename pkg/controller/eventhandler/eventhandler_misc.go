@@ -21,7 +21,9 @@ import (
 
 	"github.com/henderiw/logger/log"
 	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/controller/trace"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/kform-dev/choreo/pkg/server/selector"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -38,6 +40,8 @@ type Custom struct {
 	ForGVK      schema.GroupVersionKind
 	ForSelector selector.Selector
 	BranchName  string
+	// Triggers records the events that queue a request when tracing is enabled
+	Triggers *trace.Triggers
 }
 
 // CustomEventHandler builds a new map[string]string as input for a list selector that filters
@@ -76,6 +80,7 @@ func (r *Custom) EventHandler(ctx context.Context, _ resourcepb.Watch_EventType,
 
 		if r.ForSelector != nil {
 			if r.ForSelector.Matches(usrc.Object) {
+				r.Triggers.Add(req, runnerpb.Trigger_WATCH, usrc)
 				r.Queue.Add(req)
 			}
 		}
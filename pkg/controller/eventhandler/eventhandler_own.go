@@ -21,7 +21,9 @@ import (
 
 	"github.com/henderiw/logger/log"
 	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/controller/trace"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -34,6 +36,8 @@ type Own struct {
 	Client resourceclient.Client
 	Queue  workqueue.TypedRateLimitingInterface[types.NamespacedName]
 	GVK    schema.GroupVersionKind
+	// Triggers records the events that queue a request when tracing is enabled
+	Triggers *trace.Triggers
 }
 
 func (r *Own) EventHandler(ctx context.Context, eventType resourcepb.Watch_EventType, obj runtime.Unstructured) bool {
@@ -54,6 +58,7 @@ func (r *Own) EventHandler(ctx context.Context, eventType resourcepb.Watch_Event
 			// we dont need a for selector since these resources were created by a for resource
 
 			log.Debug("own reconcile event", "src", obj.GetObjectKind().GroupVersionKind().String(), "name", usrc.GetName())
+			r.Triggers.Add(req, runnerpb.Trigger_OWN, usrc)
 			r.Queue.Add(req)
 		}
 	}
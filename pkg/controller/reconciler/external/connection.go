@@ -0,0 +1,125 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/henderiw/logger/log"
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/proto/reconcilerpb"
+	sdk "github.com/kform-dev/choreo/pkg/sdk/reconciler"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func newConnection(ctx context.Context, name string, cfg *choreov1alpha1.ExternalReconciler) *connection {
+	return &connection{
+		ctx:  ctx,
+		name: name,
+		cfg:  cfg,
+	}
+}
+
+// connection manages the connection to the external reconciler; the connection is
+// setup on first use and shared by all reconciles, a failed setup is retried on the
+// next use. When the subprocess exits, the subprocess and the connection are setup
+// again on the next use. The subprocess and the connection are stopped when the
+// context is cancelled.
+type connection struct {
+	ctx  context.Context
+	name string
+	cfg  *choreov1alpha1.ExternalReconciler
+	m    sync.Mutex
+	// started indicates the subprocess is running, it is not restarted by a retry
+	started bool
+	conn    *grpc.ClientConn
+	client  reconcilerpb.ReconcilerClient
+}
+
+func (r *connection) getClient() (reconcilerpb.ReconcilerClient, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.client != nil {
+		return r.client, nil
+	}
+	client, err := r.connect()
+	if err != nil {
+		return nil, err
+	}
+	r.client = client
+	return r.client, nil
+}
+
+func (r *connection) connect() (reconcilerpb.ReconcilerClient, error) {
+	if r.cfg == nil || r.cfg.Address == "" {
+		return nil, fmt.Errorf("external reconciler %s has no address", r.name)
+	}
+	if len(r.cfg.Command) != 0 && !r.started {
+		cmd := exec.CommandContext(r.ctx, r.cfg.Command[0], r.cfg.Command[1:]...)
+		cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", sdk.AddressEnv, r.cfg.Address))
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Start(); err != nil {
+			return nil, fmt.Errorf("external reconciler %s cannot start command, err: %s", r.name, err.Error())
+		}
+		r.started = true
+		go r.wait(cmd)
+	}
+	conn, err := grpc.NewClient(r.cfg.Address,
+		grpc.WithTransportCredentials(
+			insecure.NewCredentials(),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("external reconciler %s cannot connect, err: %s", r.name, err.Error())
+	}
+	go func() {
+		<-r.ctx.Done()
+		conn.Close()
+	}()
+	r.conn = conn
+	return reconcilerpb.NewReconcilerClient(conn), nil
+}
+
+// wait waits for the subprocess to exit and resets the connection, such that the
+// subprocess is restarted on the next use
+func (r *connection) wait(cmd *exec.Cmd) {
+	log := log.FromContext(r.ctx)
+	err := cmd.Wait()
+	if r.ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		log.Error("external reconciler command stopped", "reconciler", r.name, "err", err.Error())
+	} else {
+		log.Info("external reconciler command exited", "reconciler", r.name)
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.started = false
+	if r.conn != nil {
+		r.conn.Close()
+	}
+	r.conn = nil
+	r.client = nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+)
+
+func TestConnectionRestart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the command records each start and exits
+	starts := filepath.Join(t.TempDir(), "starts")
+	conn := newConnection(ctx, "test", &choreov1alpha1.ExternalReconciler{
+		Address: "unix://" + filepath.Join(t.TempDir(), "reconciler.sock"),
+		Command: []string{"sh", "-c", fmt.Sprintf("echo started >> %s", starts)},
+	})
+
+	for i := 1; i <= 2; i++ {
+		if _, err := conn.getClient(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// the exit of the command resets the connection
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			conn.m.Lock()
+			reset := !conn.started && conn.client == nil
+			conn.m.Unlock()
+			if reset {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("connection not reset after the command exited")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		b, err := os.ReadFile(starts)
+		if err != nil {
+			t.Fatalf("cannot read starts: %v", err)
+		}
+		if got := strings.Count(string(b), "started"); got != i {
+			t.Errorf("expected %d starts, got %d", i, got)
+		}
+	}
+}
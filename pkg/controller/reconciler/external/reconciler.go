@@ -0,0 +1,178 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/controller/reconcile"
+	"github.com/kform-dev/choreo/pkg/controller/reconciler/resources"
+	reconcileresult "github.com/kform-dev/choreo/pkg/controller/reconciler/result"
+	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
+	"github.com/kform-dev/choreo/pkg/proto/reconcilerpb"
+	"github.com/kform-dev/choreo/pkg/util/object"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// NewReconcilerFn returns the reconciler that calls the gRPC service of an external reconciler.
+// The subprocess and connection of the external reconciler live until the context is cancelled.
+func NewReconcilerFn(ctx context.Context, client resourceclient.Client, reconcileConfig *choreov1alpha1.Reconciler, branch string) reconcile.TypedReconcilerFn {
+	conn := newConnection(ctx, reconcileConfig.Name, reconcileConfig.Spec.External)
+	return func() (reconcile.TypedReconciler, error) {
+		return &reconciler{
+			name:          reconcileConfig.Name,
+			client:        client,
+			forgvk:        reconcileConfig.GetForGVK(),
+			owns:          reconcileConfig.GetOwnsGVKs(),
+			branch:        branch,
+			timeout:       reconcileConfig.GetTimeout(),
+			conn:          conn,
+			resultHandler: reconcileresult.New(client, reconcileConfig, branch),
+		}, nil
+	}
+}
+
+type reconciler struct {
+	name          string
+	client        resourceclient.Client
+	forgvk        schema.GroupVersionKind
+	owns          sets.Set[schema.GroupVersionKind]
+	branch        string
+	timeout       time.Duration
+	conn          *connection
+	resultHandler *reconcileresult.Handler
+	// dynamic data set on each reconcile
+	resources *resources.Resources
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	// get the resource
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(r.forgvk)
+	if err := r.client.Get(ctx, req.NamespacedName, u, &resourceclient.GetOptions{
+		ShowManagedFields: true,
+		Origin:            r.name,
+		Branch:            r.branch,
+	}); err != nil {
+		if !grpcerrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		// stop the reconcile loop since the object dissapeared
+		return reconcile.Result{}, nil
+	}
+
+	// reinitialize the resource on each reconcile
+	r.resources = resources.New(r.name, r.client, u, r.owns, r.branch)
+	if u.GetDeletionTimestamp() != nil {
+		if err := r.resources.Delete(ctx); err != nil {
+			return reconcile.Result{}, fmt.Errorf("external reconciler %s cannot delete child resource, err: %s", r.name, err.Error())
+		}
+		object.DeleteFinalizer(u, r.name)
+
+		// removes the fields that are not managed by this reconciler based on the managedFields info in the resource
+		// done before conditions are set
+		object.PruneUnmanagedFields(u, r.name)
+
+		if err := r.client.Apply(ctx, u, &resourceclient.ApplyOptions{
+			FieldManager: r.name,
+			Branch:       r.branch,
+		}); err != nil {
+			return reconcile.Result{}, fmt.Errorf("external reconciler %s cannot set finalizer, err: %s", r.name, err.Error())
+		}
+		return reconcile.Result{}, nil
+	}
+
+	rsp, err := r.call(ctx, u)
+	if err != nil {
+		return r.resultHandler.Fail(ctx, u, err)
+	}
+	result, err := r.getResult(ctx, rsp)
+	if err != nil {
+		return r.resultHandler.Fail(ctx, u, err)
+	}
+	return r.resultHandler.Handle(ctx, u, result, r.resources)
+}
+
+// call calls the external reconciler with the for resource and its existing children
+func (r *reconciler) call(ctx context.Context, u *unstructured.Unstructured) (*reconcilerpb.Reconcile_Response, error) {
+	client, err := r.conn.getClient()
+	if err != nil {
+		return nil, err
+	}
+	children, err := r.resources.GetExistingResources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("external reconciler %s cannot get child resources, err: %s", r.name, err.Error())
+	}
+	req := &reconcilerpb.Reconcile_Request{
+		Name:     r.name,
+		Children: make([][]byte, 0, len(children)),
+	}
+	if req.Object, err = json.Marshal(u.Object); err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		b, err := json.Marshal(child.Object)
+		if err != nil {
+			return nil, err
+		}
+		req.Children = append(req.Children, b)
+	}
+
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+	// wait for ready allows the subprocess to start listening
+	rsp, err := client.Reconcile(ctx, req, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, fmt.Errorf("external reconciler %s call failed, err: %s", r.name, err.Error())
+	}
+	return rsp, nil
+}
+
+// getResult adds the children of the response to the resources and returns the result
+func (r *reconciler) getResult(ctx context.Context, rsp *reconcilerpb.Reconcile_Response) (*reconcileresult.Result, error) {
+	for _, b := range rsp.Children {
+		obj := map[string]any{}
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return nil, fmt.Errorf("external reconciler %s returned an invalid child, err: %s", r.name, err.Error())
+		}
+		r.resources.AddNewResource(ctx, &unstructured.Unstructured{Object: obj})
+	}
+	obj := map[string]any{}
+	if len(rsp.Object) != 0 {
+		if err := json.Unmarshal(rsp.Object, &obj); err != nil {
+			return nil, fmt.Errorf("external reconciler %s returned an invalid object, err: %s", r.name, err.Error())
+		}
+	}
+	return &reconcileresult.Result{
+		Object:       &unstructured.Unstructured{Object: obj},
+		Requeue:      rsp.Requeue,
+		RequeueAfter: rsp.RequeueAfter,
+		Message:      rsp.Message,
+		Fatal:        rsp.Fatal,
+	}, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/controller/reconcile"
+	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
+	sdk "github.com/kform-dev/choreo/pkg/sdk/reconciler"
+	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/choreo/crdloader"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func testCRD(kind, plural string) *apiextensionsv1.CustomResourceDefinition {
+	object := apiextensionsv1.JSONSchemaProps{Type: "object", XPreserveUnknownFields: ptr.To(true)}
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: plural + ".example.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   plural,
+				Kind:     kind,
+				ListKind: kind + "List",
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+				Name:    "v1alpha1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"apiVersion": {Type: "string"},
+							"kind":       {Type: "string"},
+							"metadata":   {Type: "object"},
+							"spec":       object,
+							"status":     object,
+						},
+					},
+				},
+				Subresources: &apiextensionsv1.CustomResourceSubresources{
+					Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+				},
+			}},
+		},
+	}
+}
+
+func testObject(kind, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1alpha1",
+		"kind":       kind,
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "default",
+		},
+		"spec": map[string]any{"value": name},
+	}}
+}
+
+// newTestClient returns a client for the topologies and the nodes they own
+func newTestClient(t *testing.T, ctx context.Context) resourceclient.Client {
+	t.Helper()
+	apiStore := api.NewAPIStore()
+	for kind, plural := range map[string]string{"Topology": "topologies", "Node": "nodes"} {
+		rctx, err := crdloader.LoadCRD(ctx, nil, testCRD(kind, plural), nil, false)
+		if err != nil {
+			t.Fatalf("load crd failed: %v", err)
+		}
+		if err := apiStore.Apply(rctx.GV(), rctx); err != nil {
+			t.Fatalf("apply api failed: %v", err)
+		}
+	}
+	return resourceclient.NewAPIStorageClient(apiStore)
+}
+
+func getTestObject(ctx context.Context, client resourceclient.Client, kind, name string) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("example.com/v1alpha1")
+	u.SetKind(kind)
+	if err := client.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, u, &resourceclient.GetOptions{Branch: "main"}); err != nil {
+		return nil, err
+	}
+	return u.DeepCopy(), nil
+}
+
+func TestReconcile(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the external reconciler returns a node per topology and reports the names
+	// of the existing nodes in the status
+	address := "unix://" + filepath.Join(t.TempDir(), "reconciler.sock")
+	go func() {
+		_ = sdk.Serve(ctx, address, func(ctx context.Context, req *sdk.Request) (*sdk.Result, error) {
+			if req.Object.GetName() == "fail" {
+				return &sdk.Result{Message: "failed", RequeueAfter: 2 * time.Second}, nil
+			}
+			names := []string{}
+			for _, child := range req.Children {
+				names = append(names, child.GetName())
+			}
+			return &sdk.Result{
+				Children: []*unstructured.Unstructured{testObject("Node", req.Object.GetName()+"-node")},
+				Object: &unstructured.Unstructured{Object: map[string]any{
+					"status": map[string]any{"children": strings.Join(names, ",")},
+				}},
+				RequeueAfter: 5 * time.Second,
+			}, nil
+		})
+	}()
+
+	cases := map[string]struct {
+		name           string
+		address        string
+		expectedResult reconcile.Result
+		expectedErr    string
+		expectedChild  bool
+		expectedStatus map[string]any
+	}{
+		"Children": {
+			name:           "a",
+			address:        address,
+			expectedResult: reconcile.Result{Requeue: true, RequeueAfter: 5 * time.Second},
+			expectedChild:  true,
+			// the node applied by the first reconcile is provided to the second
+			expectedStatus: map[string]any{"children": "a-node"},
+		},
+		"Message": {
+			name:           "fail",
+			address:        address,
+			expectedResult: reconcile.Result{Requeue: true, RequeueAfter: 2 * time.Second, Message: "failed"},
+		},
+		"ConnectionFailure": {
+			name:        "b",
+			address:     "unix://" + filepath.Join(t.TempDir(), "unknown.sock"),
+			expectedErr: "external reconciler test call failed",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, ctx)
+			if err := client.Create(ctx, testObject("Topology", tc.name), &resourceclient.CreateOptions{Branch: "main"}); err != nil {
+				t.Fatalf("create failed: %v", err)
+			}
+			reconcileConfig := &choreov1alpha1.Reconciler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: choreov1alpha1.ReconcilerSpec{
+					For:      choreov1alpha1.ReconcilerResource{ResourceGVK: choreov1alpha1.ResourceGVK{Group: "example.com", Version: "v1alpha1", Kind: "Topology"}},
+					Owns:     []*choreov1alpha1.ReconcilerResource{{ResourceGVK: choreov1alpha1.ResourceGVK{Group: "example.com", Version: "v1alpha1", Kind: "Node"}}},
+					Timeout:  &metav1.Duration{Duration: 5 * time.Second},
+					External: &choreov1alpha1.ExternalReconciler{Address: tc.address},
+				},
+			}
+			if tc.expectedErr != "" {
+				reconcileConfig.Spec.Timeout = &metav1.Duration{Duration: 100 * time.Millisecond}
+			}
+			r, err := NewReconcilerFn(ctx, client, reconcileConfig, "main")()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: tc.name}}
+			for i := 0; i < 2; i++ {
+				result, err := r.Reconcile(ctx, req)
+				if tc.expectedErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+						t.Fatalf("expected error containing %q, got %v", tc.expectedErr, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if diff := cmp.Diff(tc.expectedResult, result); diff != "" {
+					t.Errorf("-want, +got:\n%s", diff)
+				}
+			}
+
+			child, err := getTestObject(ctx, client, "Node", tc.name+"-node")
+			switch {
+			case tc.expectedChild && err != nil:
+				t.Errorf("expected the child to be applied, got %v", err)
+			case tc.expectedChild && child.GetOwnerReferences()[0].Name != tc.name:
+				t.Errorf("expected the child to be owned by %s, got %v", tc.name, child.GetOwnerReferences())
+			case !tc.expectedChild && !grpcerrors.IsNotFound(err):
+				t.Errorf("expected no child, got %v", err)
+			}
+
+			u, err := getTestObject(ctx, client, "Topology", tc.name)
+			if err != nil {
+				t.Fatalf("get failed: %v", err)
+			}
+			status, _, _ := unstructured.NestedMap(u.Object, "status")
+			if diff := cmp.Diff(tc.expectedStatus, status); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
 	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
 	"github.com/kform-dev/choreo/pkg/controller/informers"
+	"github.com/kform-dev/choreo/pkg/controller/reconciler/graph"
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	//reconcilerstore "github.com/kform-dev/choreo/pkg/controller/reconciler/store"
 )
@@ -39,7 +40,15 @@ func NewReconcilerFactory(
 	libraries []*choreov1alpha1.Library,
 	resultCh chan *runnerpb.ReconcileResult,
 	branchName string,
+	opts ...FactoryOption,
 ) (ReconcilerFactory, error) {
+	o := FactoryOptions{}
+	o.ApplyOptions(opts)
+
+	var waves [][]string
+	if o.Waves {
+		waves = graph.New(reconcilersConfigs).Waves()
+	}
 
 	reconcilers, err := newReconcilers(
 		ctx,
@@ -49,6 +58,9 @@ func NewReconcilerFactory(
 		libraries,
 		resultCh,
 		branchName,
+		waves,
+		o.Holder,
+		o.Trace,
 	)
 
 	return &reconcilerFactory{
@@ -57,6 +69,36 @@ func NewReconcilerFactory(
 	}, err
 }
 
+type FactoryOption interface {
+	ApplyToFactory(*FactoryOptions)
+}
+
+var _ FactoryOption = &FactoryOptions{}
+
+type FactoryOptions struct {
+	// Waves starts the reconcilers in the topological waves of their dependency graph
+	Waves bool
+	// Holder is held while reconciler waves are pending
+	Holder Holder
+	// Trace records a trace per reconcile in the reconcile results
+	Trace bool
+}
+
+func (o *FactoryOptions) ApplyToFactory(lo *FactoryOptions) {
+	lo.Waves = o.Waves
+	lo.Holder = o.Holder
+	lo.Trace = o.Trace
+}
+
+// ApplyOptions applies the given factory options on these options,
+// and then returns itself (for convenient chaining).
+func (o *FactoryOptions) ApplyOptions(opts []FactoryOption) *FactoryOptions {
+	for _, opt := range opts {
+		opt.ApplyToFactory(o)
+	}
+	return o
+}
+
 type reconcilerFactory struct {
 	client      resourceclient.Client
 	reconcilers *reConcilers
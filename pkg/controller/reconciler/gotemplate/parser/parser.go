@@ -45,6 +45,11 @@ type Parser struct {
 	tmpl *template.Template
 }
 
+// HasTemplate returns true if a template with the name exists
+func (r *Parser) HasTemplate(template string) bool {
+	return r.tmpl.Lookup(template) != nil
+}
+
 func (r *Parser) Render(ctx context.Context, template string, u *unstructured.Unstructured, w io.Writer) error {
 	if w != nil {
 		return r.tmpl.ExecuteTemplate(w, template, u.Object)
@@ -26,22 +26,23 @@ import (
 	"github.com/kform-dev/choreo/pkg/controller/reconcile"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/gotemplate/parser"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/resources"
+	reconcileresult "github.com/kform-dev/choreo/pkg/controller/reconciler/result"
 	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
 	"github.com/kform-dev/choreo/pkg/util/object"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
-	"sigs.k8s.io/yaml"
 )
 
 func NewReconcilerFn(client resourceclient.Client, reconcileConfig *choreov1alpha1.Reconciler, branch string) reconcile.TypedReconcilerFn {
 	return func() (reconcile.TypedReconciler, error) {
 		r := &reconciler{
-			name:   reconcileConfig.Name,
-			client: client,
-			forgvk: reconcileConfig.GetForGVK(),
-			owns:   reconcileConfig.GetOwnsGVKs(),
-			branch: branch,
+			name:          reconcileConfig.Name,
+			client:        client,
+			forgvk:        reconcileConfig.GetForGVK(),
+			owns:          reconcileConfig.GetOwnsGVKs(),
+			branch:        branch,
+			resultHandler: reconcileresult.New(client, reconcileConfig, branch),
 		}
 
 		p, err := parser.New(reconcileConfig.Spec.Code)
@@ -55,12 +56,13 @@ func NewReconcilerFn(client resourceclient.Client, reconcileConfig *choreov1alph
 }
 
 type reconciler struct {
-	name   string
-	parser *parser.Parser
-	client resourceclient.Client
-	forgvk schema.GroupVersionKind
-	owns   sets.Set[schema.GroupVersionKind]
-	branch string
+	name          string
+	parser        *parser.Parser
+	client        resourceclient.Client
+	forgvk        schema.GroupVersionKind
+	owns          sets.Set[schema.GroupVersionKind]
+	branch        string
+	resultHandler *reconcileresult.Handler
 	// dynamic data set on each reconcile
 	ctx       context.Context // bad practice but allows for reuse of ctx
 	resources *resources.Resources
@@ -106,35 +108,42 @@ func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
-	object.SetFinalizer(u, r.name)
-
 	var buf bytes.Buffer
 	if err := r.parser.Render(ctx, "main.tpl", u, &buf); err != nil {
-		return reconcile.Result{}, fmt.Errorf("gotemplate reconciler %s parser failed err: %s", r.name, err.Error())
+		return r.resultHandler.Fail(ctx, u, fmt.Errorf("gotemplate reconciler %s parser failed err: %s", r.name, err.Error()))
 	}
 
-	data := map[string]any{}
-	if err := yaml.Unmarshal(buf.Bytes(), &data); err != nil {
-		return reconcile.Result{}, fmt.Errorf("gotemplate reconciler %s generate invalid yaml failed err: %s", r.name, err.Error())
+	objs, err := resources.DecodeDocuments(buf.Bytes())
+	if err != nil {
+		return r.resultHandler.Fail(ctx, u, fmt.Errorf("gotemplate reconciler %s generate invalid yaml failed err: %s", r.name, err.Error()))
 	}
 
-	// apply the own resource generated by the template
-	newu := &unstructured.Unstructured{}
-	newu.Object = data
-	r.resources.AddNewResource(ctx, newu)
-	if err := r.resources.Apply(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("apply failed for gotemplate reconciler %s, err: %s", r.name, err.Error())
+	// add the own resources generated by the template, the children
+	// that are no longer rendered are pruned by the apply
+	for _, newu := range objs {
+		r.resources.AddNewResource(ctx, newu)
 	}
 
-	// removes the fields that are not managed by this reconciler based on the managedFields info in the resource
-	// done before conditions are set
-	object.PruneUnmanagedFields(u, r.name)
-	// apply the for resource
-	if err := r.client.Apply(ctx, u, &resourceclient.ApplyOptions{
-		FieldManager: r.name,
-		Branch:       r.branch,
-	}); err != nil {
-		return reconcile.Result{}, fmt.Errorf("go template reconciler %s cannot set finalizer, err: %s", r.name, err.Error())
+	result, err := r.getResult(ctx, u)
+	if err != nil {
+		return r.resultHandler.Fail(ctx, u, err)
+	}
+	return r.resultHandler.Handle(ctx, u, result, r.resources)
+}
+
+// getResult renders the optional result template, which reports the spec, status,
+// failure message and requeue information of the reconcile
+func (r *reconciler) getResult(ctx context.Context, u *unstructured.Unstructured) (*reconcileresult.Result, error) {
+	if !r.parser.HasTemplate("result.tpl") {
+		return &reconcileresult.Result{}, nil
+	}
+	var buf bytes.Buffer
+	if err := r.parser.Render(ctx, "result.tpl", u, &buf); err != nil {
+		return nil, fmt.Errorf("gotemplate reconciler %s result parser failed err: %s", r.name, err.Error())
+	}
+	result, err := reconcileresult.Decode(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("gotemplate reconciler %s generate invalid result failed err: %s", r.name, err.Error())
 	}
-	return reconcile.Result{}, nil
+	return result, nil
 }
@@ -0,0 +1,209 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Graph is the dependency graph of the reconcilers. A reconciler depends on the reconcilers
+// that own the resources it reconciles (for) or watches, since their output is its input.
+type Graph struct {
+	nodes map[string]*Node
+	// cycles are the strongly connected components of more than 1 reconciler
+	cycles [][]string
+}
+
+type Node struct {
+	Name    string
+	For     schema.GroupKind
+	Owns    []schema.GroupKind
+	Watches []schema.GroupKind
+	// DependsOn are the names of the reconcilers this reconciler depends on
+	DependsOn []string
+	// Wave is the topological order of the reconciler, reconcilers in the same cycle
+	// share the same wave
+	Wave int
+}
+
+// New builds the dependency graph of the reconcilers
+func New(reconcilers []*choreov1alpha1.Reconciler) *Graph {
+	r := &Graph{nodes: map[string]*Node{}}
+
+	owners := map[schema.GroupKind]sets.Set[string]{}
+	for _, reconciler := range reconcilers {
+		node := &Node{
+			Name: reconciler.GetName(),
+			For:  reconciler.Spec.For.ResourceGVK.GetGVK().GroupKind(),
+		}
+		for _, resource := range reconciler.Spec.Owns {
+			if resource == nil {
+				continue
+			}
+			gk := resource.ResourceGVK.GetGVK().GroupKind()
+			node.Owns = append(node.Owns, gk)
+			if _, ok := owners[gk]; !ok {
+				owners[gk] = sets.New[string]()
+			}
+			owners[gk].Insert(node.Name)
+		}
+		for _, resource := range reconciler.Spec.Watches {
+			if resource == nil {
+				continue
+			}
+			node.Watches = append(node.Watches, resource.ResourceGVK.GetGVK().GroupKind())
+		}
+		r.nodes[node.Name] = node
+	}
+
+	for _, node := range r.nodes {
+		dependsOn := sets.New[string]()
+		for _, gk := range append([]schema.GroupKind{node.For}, node.Watches...) {
+			dependsOn.Insert(owners[gk].UnsortedList()...)
+		}
+		// a reconciler that watches its own children does not depend on itself
+		dependsOn.Delete(node.Name)
+		node.DependsOn = sets.List(dependsOn)
+	}
+	r.sort()
+	return r
+}
+
+// Nodes returns the reconcilers ordered by wave and name
+func (r *Graph) Nodes() []*Node {
+	nodes := make([]*Node, 0, len(r.nodes))
+	for _, node := range r.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Wave != nodes[j].Wave {
+			return nodes[i].Wave < nodes[j].Wave
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+	return nodes
+}
+
+// Waves returns the names of the reconcilers per wave, the reconcilers of a wave only
+// depend on the reconcilers of the previous waves or on the reconcilers in the same cycle
+func (r *Graph) Waves() [][]string {
+	waves := [][]string{}
+	for _, node := range r.Nodes() {
+		for len(waves) <= node.Wave {
+			waves = append(waves, []string{})
+		}
+		waves[node.Wave] = append(waves[node.Wave], node.Name)
+	}
+	return waves
+}
+
+// Cycles returns the reconcilers that depend on each other
+func (r *Graph) Cycles() [][]string {
+	return r.cycles
+}
+
+// CycleError returns an error describing the cycles, nil when the graph has no cycles
+func (r *Graph) CycleError() error {
+	if len(r.cycles) == 0 {
+		return nil
+	}
+	cycles := make([]string, 0, len(r.cycles))
+	for _, cycle := range r.cycles {
+		cycles = append(cycles, strings.Join(cycle, ", "))
+	}
+	return fmt.Errorf("reconciler dependency cycles: [%s]", strings.Join(cycles, "], ["))
+}
+
+// sort determines the cycles and the waves; the strongly connected components of the
+// graph are found with tarjan's algorithm, which returns them in reverse topological order
+func (r *Graph) sort() {
+	names := sets.List(sets.KeySet(r.nodes))
+
+	index := 0
+	indexes := map[string]int{}
+	lowlinks := map[string]int{}
+	onStack := sets.New[string]()
+	stack := []string{}
+	components := [][]string{}
+
+	var strongconnect func(name string)
+	strongconnect = func(name string) {
+		indexes[name] = index
+		lowlinks[name] = index
+		index++
+		stack = append(stack, name)
+		onStack.Insert(name)
+
+		for _, dep := range r.nodes[name].DependsOn {
+			if _, ok := indexes[dep]; !ok {
+				strongconnect(dep)
+				lowlinks[name] = min(lowlinks[name], lowlinks[dep])
+			} else if onStack.Has(dep) {
+				lowlinks[name] = min(lowlinks[name], indexes[dep])
+			}
+		}
+
+		if lowlinks[name] == indexes[name] {
+			component := []string{}
+			for {
+				n := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack.Delete(n)
+				component = append(component, n)
+				if n == name {
+					break
+				}
+			}
+			sort.Strings(component)
+			components = append(components, component)
+		}
+	}
+	for _, name := range names {
+		if _, ok := indexes[name]; !ok {
+			strongconnect(name)
+		}
+	}
+
+	// since the edges point to the dependencies, the dependencies of a component
+	// are found before the component itself
+	for _, component := range components {
+		members := sets.New(component...)
+		wave := 0
+		for _, name := range component {
+			for _, dep := range r.nodes[name].DependsOn {
+				if !members.Has(dep) {
+					wave = max(wave, r.nodes[dep].Wave+1)
+				}
+			}
+		}
+		for _, name := range component {
+			r.nodes[name].Wave = wave
+		}
+		if len(component) > 1 {
+			r.cycles = append(r.cycles, component)
+		}
+	}
+	sort.Slice(r.cycles, func(i, j int) bool {
+		return r.cycles[i][0] < r.cycles[j][0]
+	})
+}
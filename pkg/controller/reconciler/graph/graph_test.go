@@ -0,0 +1,81 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/util/testhelper"
+)
+
+func TestGraph(t *testing.T) {
+	cases := map[string]struct {
+		reconcilers    []*choreov1alpha1.Reconciler
+		expectedWaves  [][]string
+		expectedCycles [][]string
+	}{
+		"Independent": {
+			reconcilers: []*choreov1alpha1.Reconciler{
+				testhelper.NewReconciler("b", "B", nil, nil),
+				testhelper.NewReconciler("a", "A", nil, nil),
+			},
+			expectedWaves: [][]string{{"a", "b"}},
+		},
+		"Chain": {
+			reconcilers: []*choreov1alpha1.Reconciler{
+				testhelper.NewReconciler("interface", "Interface", []string{"IPClaim"}, nil),
+				testhelper.NewReconciler("ipclaim", "IPClaim", []string{"IPEntry"}, nil),
+				testhelper.NewReconciler("config", "Device", nil, []string{"IPEntry", "Interface"}),
+				testhelper.NewReconciler("node", "Node", []string{"Interface"}, []string{"Node"}),
+			},
+			expectedWaves: [][]string{{"node"}, {"interface"}, {"ipclaim"}, {"config"}},
+		},
+		"OwnChildrenWatched": {
+			reconcilers: []*choreov1alpha1.Reconciler{
+				testhelper.NewReconciler("a", "A", []string{"B"}, []string{"B"}),
+			},
+			expectedWaves: [][]string{{"a"}},
+		},
+		"Cycle": {
+			reconcilers: []*choreov1alpha1.Reconciler{
+				testhelper.NewReconciler("a", "A", []string{"B"}, nil),
+				testhelper.NewReconciler("b", "B", []string{"C"}, nil),
+				testhelper.NewReconciler("c", "C", []string{"A"}, nil),
+				testhelper.NewReconciler("d", "D", nil, []string{"C"}),
+			},
+			expectedWaves:  [][]string{{"a", "b", "c"}, {"d"}},
+			expectedCycles: [][]string{{"a", "b", "c"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := New(tc.reconcilers)
+			if waves := g.Waves(); !reflect.DeepEqual(waves, tc.expectedWaves) {
+				t.Errorf("expected waves %v, got %v", tc.expectedWaves, waves)
+			}
+			if cycles := g.Cycles(); !reflect.DeepEqual(cycles, tc.expectedCycles) {
+				t.Errorf("expected cycles %v, got %v", tc.expectedCycles, cycles)
+			}
+			if (g.CycleError() != nil) != (len(tc.expectedCycles) > 0) {
+				t.Errorf("unexpected cycle error: %v", g.CycleError())
+			}
+		})
+	}
+}
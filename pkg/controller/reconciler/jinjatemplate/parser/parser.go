@@ -23,6 +23,8 @@ import (
 	"os"
 
 	"github.com/flosch/pongo2/v6"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -53,17 +55,39 @@ func New(templates map[string]string) (*Parser, error) {
 	}
 
 	return &Parser{
-		tmpl: mainTemplate,
+		set:       ts,
+		templates: templates,
+		tmpl:      mainTemplate,
 	}, nil
 }
 
 type Parser struct {
-	tmpl *pongo2.Template
+	set       *pongo2.TemplateSet
+	templates map[string]string
+	tmpl      *pongo2.Template
+}
+
+// HasTemplate returns true if a template with the name exists
+func (r *Parser) HasTemplate(template string) bool {
+	_, ok := r.templates[template]
+	return ok
 }
 
+// Render renders the template with the object; a template that does not exist returns NotFound
 func (r *Parser) Render(ctx context.Context, template string, u *unstructured.Unstructured, w io.Writer) error {
+	if !r.HasTemplate(template) {
+		return status.Errorf(codes.NotFound, "template %s not found", template)
+	}
+	tmpl := r.tmpl
+	if template != "main.jinja2" {
+		var err error
+		tmpl, err = r.set.FromCache(template)
+		if err != nil {
+			return err
+		}
+	}
 	if w != nil {
-		return r.tmpl.ExecuteWriter(u.Object, w)
+		return tmpl.ExecuteWriter(u.Object, w)
 	}
-	return r.tmpl.ExecuteWriter(u.Object, os.Stdout)
+	return tmpl.ExecuteWriter(u.Object, os.Stdout)
 }
@@ -25,6 +25,8 @@ import (
 
 	"github.com/henderiw/store"
 	"github.com/kform-dev/choreo/pkg/server/choreo/loader"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 )
@@ -83,7 +85,7 @@ func TestRender(t *testing.T) {
 			}
 
 			var buf bytes.Buffer
-			if err := p.Render(ctx, "main.tpl", u, &buf); err != nil {
+			if err := p.Render(ctx, "main.jinja2", u, &buf); err != nil {
 				t.Errorf("failed rendering data: %v", err)
 				return
 			}
@@ -98,3 +100,18 @@ func TestRender(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	p, err := New(map[string]string{"main.jinja2": "name: {{ metadata.name }}"})
+	if err != nil {
+		t.Fatalf("creating parser failed: %v", err)
+	}
+	u := &unstructured.Unstructured{Object: map[string]any{"metadata": map[string]any{"name": "a"}}}
+	var buf bytes.Buffer
+	if err := p.Render(context.Background(), "unknown.jinja2", u, &buf); status.Code(err) != codes.NotFound {
+		t.Errorf("want %s, got %v", codes.NotFound, err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("want nothing rendered, got %q", buf.String())
+	}
+}
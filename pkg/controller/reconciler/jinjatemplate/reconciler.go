@@ -26,12 +26,12 @@ import (
 	"github.com/kform-dev/choreo/pkg/controller/reconcile"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/jinjatemplate/parser"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/resources"
+	reconcileresult "github.com/kform-dev/choreo/pkg/controller/reconciler/result"
 	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
 	"github.com/kform-dev/choreo/pkg/util/object"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
-	"sigs.k8s.io/yaml"
 )
 
 func NewReconcilerFn(client resourceclient.Client, reconcileConfig *choreov1alpha1.Reconciler, branch string) reconcile.TypedReconcilerFn {
@@ -39,10 +39,10 @@ func NewReconcilerFn(client resourceclient.Client, reconcileConfig *choreov1alph
 		r := &reconciler{
 			name:          reconcileConfig.Name,
 			client:        client,
-			conditionType: reconcileConfig.Spec.ConditionType,
 			forgvk:        reconcileConfig.GetForGVK(),
 			owns:          reconcileConfig.GetOwnsGVKs(),
 			branch:        branch,
+			resultHandler: reconcileresult.New(client, reconcileConfig, branch),
 		}
 		p, err := parser.New(reconcileConfig.Spec.Code)
 		if err != nil {
@@ -58,10 +58,9 @@ type reconciler struct {
 	parser        *parser.Parser
 	client        resourceclient.Client
 	forgvk        schema.GroupVersionKind
-	conditionType *string
-	//specUpdate          *bool
-	owns   sets.Set[schema.GroupVersionKind]
-	branch string
+	owns          sets.Set[schema.GroupVersionKind]
+	branch        string
+	resultHandler *reconcileresult.Handler
 	// dynamic data set on each reconcile
 	ctx       context.Context // bad practice but allows for reuse of ctx
 	resources *resources.Resources
@@ -109,39 +108,40 @@ func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	var buf bytes.Buffer
 	if err := r.parser.Render(ctx, "main.jinja2", u, &buf); err != nil {
-		return reconcile.Result{}, fmt.Errorf("jinja2 template %s parser failed err: %s", r.name, err.Error())
+		return r.resultHandler.Fail(ctx, u, fmt.Errorf("jinja2 template %s parser failed err: %s", r.name, err.Error()))
 	}
 
-	//fmt.Println("config", buf.String())
-
-	data := map[string]any{}
-	if err := yaml.Unmarshal(buf.Bytes(), &data); err != nil {
-		return reconcile.Result{}, fmt.Errorf("jinja2 template %s generate invalid yaml failed err: %s", r.name, err.Error())
+	objs, err := resources.DecodeDocuments(buf.Bytes())
+	if err != nil {
+		return r.resultHandler.Fail(ctx, u, fmt.Errorf("jinja2 template %s generate invalid yaml failed err: %s", r.name, err.Error()))
 	}
 
-	// apply the own resource generated by the template
-	newu := &unstructured.Unstructured{}
-	newu.Object = data
-	r.resources.AddNewResource(ctx, newu)
-	if err := r.resources.Apply(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("apply failed for jinja2 template %s, err: %s", r.name, err.Error())
+	// add the own resources generated by the template, the children
+	// that are no longer rendered are pruned by the apply
+	for _, newu := range objs {
+		r.resources.AddNewResource(ctx, newu)
 	}
 
-	//fmt.Println("config", newu)
+	result, err := r.getResult(ctx, u)
+	if err != nil {
+		return r.resultHandler.Fail(ctx, u, err)
+	}
+	return r.resultHandler.Handle(ctx, u, result, r.resources)
+}
 
-	// removes the fields that are not managed by this reconciler based on the managedFields info in the resource
-	// done before conditions are set
-	object.PruneUnmanagedFields(u, r.name)
-	object.SetFinalizer(u, r.name)
-	if r.conditionType != nil {
-		object.SetCondition(u.Object, *r.conditionType, "")
+// getResult renders the optional result template, which reports the spec, status,
+// failure message and requeue information of the reconcile
+func (r *reconciler) getResult(ctx context.Context, u *unstructured.Unstructured) (*reconcileresult.Result, error) {
+	if !r.parser.HasTemplate("result.jinja2") {
+		return &reconcileresult.Result{}, nil
 	}
-	// apply the for resource
-	if err := r.client.Apply(ctx, u, &resourceclient.ApplyOptions{
-		FieldManager: r.name,
-		Branch:       r.branch,
-	}); err != nil {
-		return reconcile.Result{}, fmt.Errorf("jinja2 template %s cannot set finalizer, err: %s", r.name, err.Error())
+	var buf bytes.Buffer
+	if err := r.parser.Render(ctx, "result.jinja2", u, &buf); err != nil {
+		return nil, fmt.Errorf("jinja2 template %s result parser failed err: %s", r.name, err.Error())
+	}
+	result, err := reconcileresult.Decode(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("jinja2 template %s generate invalid result failed err: %s", r.name, err.Error())
 	}
-	return reconcile.Result{}, nil
+	return result, nil
 }
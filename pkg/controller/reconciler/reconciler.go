@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/henderiw/logger/log"
@@ -28,20 +29,26 @@ import (
 	"github.com/kform-dev/choreo/pkg/controller/eventhandler"
 	"github.com/kform-dev/choreo/pkg/controller/informers"
 	"github.com/kform-dev/choreo/pkg/controller/reconcile"
+	"github.com/kform-dev/choreo/pkg/controller/reconciler/external"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/gotemplate"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/jinjatemplate"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/starlark"
+	"github.com/kform-dev/choreo/pkg/controller/trace"
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/kform-dev/choreo/pkg/server/selector"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 )
 
 type Reconciler interface {
 	start(ctx context.Context)
+	// idle returns true when the reconciler has no queued or inflight work
+	idle() bool
 }
 
 func newReconciler(
@@ -53,6 +60,7 @@ func newReconciler(
 	libraries []*choreov1alpha1.Library,
 	resultCh chan *runnerpb.ReconcileResult,
 	branchName string,
+	tracing bool,
 ) Reconciler {
 	r := &reconciler{
 		name:                    name,
@@ -61,12 +69,19 @@ func newReconciler(
 		resultCh:                resultCh,
 		branchName:              branchName,
 	}
+	reconcileClient := client
+	if tracing {
+		r.triggers = trace.NewTriggers()
+		r.conditionType = ptr.Deref(reconcilerConfig.Spec.ConditionType, "")
+		// the client calls of the reconciles are recorded in the trace
+		reconcileClient = trace.NewClient(client)
+	}
 
 	r.createWorkQueue(ctx)
 	r.addEventHandlerToInformerFactory(reconcilerConfig.DeepCopy(), informerFactory)
 
 	var err error
-	r.typedReconcilerFn, err = getTypeReconcilerFn(reconcilerConfig, libraries, client, branchName)
+	r.typedReconcilerFn, err = getTypeReconcilerFn(ctx, reconcilerConfig, libraries, reconcileClient, branchName)
 	if err != nil {
 		panic(err)
 	}
@@ -84,6 +99,11 @@ type reconciler struct {
 	client                  resourceclient.Client
 	resultCh                chan *runnerpb.ReconcileResult
 	branchName              string
+	// inflight is the amount of requests being reconciled
+	inflight atomic.Int64
+	// triggers and conditionType are only set when tracing is enabled
+	triggers      *trace.Triggers
+	conditionType string
 
 	// Reconciler is a function that can be called at any time with the Name / Namespace of an object and
 	// ensures that the state of the system matches the state specified in the object.
@@ -130,6 +150,7 @@ func (r *reconciler) registerForResource(resource choreov1alpha1.ReconcilerResou
 		Queue:    r.queue,
 		Client:   r.client,
 		Selector: selector,
+		Triggers: r.triggers,
 	}
 
 	informerFactory.AddEventHandler(resource.ResourceGVK.GetGVK(), r.name, eh.EventHandler)
@@ -137,10 +158,11 @@ func (r *reconciler) registerForResource(resource choreov1alpha1.ReconcilerResou
 
 func (r *reconciler) registerOwnResource(resource choreov1alpha1.ReconcilerResource, informerFactory informers.InformerFactory) {
 	eh := eventhandler.Own{
-		Name:   r.name,
-		Client: r.client,
-		Queue:  r.queue,
-		GVK:    r.forgvk, // for gvk
+		Name:     r.name,
+		Client:   r.client,
+		Queue:    r.queue,
+		GVK:      r.forgvk, // for gvk
+		Triggers: r.triggers,
 	}
 	informerFactory.AddEventHandler(resource.ResourceGVK.GetGVK(), r.name, eh.EventHandler)
 }
@@ -158,6 +180,7 @@ func (r *reconciler) registerWatchResource(resource choreov1alpha1.ReconcilerRes
 		ForGVK:      r.forgvk,
 		ForSelector: forSelector,
 		BranchName:  r.branchName,
+		Triggers:    r.triggers,
 	}
 
 	informerFactory.AddEventHandler(resource.ResourceGVK.GetGVK(), r.name, eh.EventHandler)
@@ -184,6 +207,10 @@ func (r *reconciler) start(ctx context.Context) {
 	log.Debug("All workers finished")
 }
 
+func (r *reconciler) idle() bool {
+	return r.queue.Len() == 0 && r.inflight.Load() == 0
+}
+
 func (r *reconciler) processNextWorkItem(ctx context.Context) bool {
 	req, shutdown := r.queue.Get()
 	if shutdown {
@@ -199,6 +226,8 @@ func (r *reconciler) processNextWorkItem(ctx context.Context) bool {
 	// period.
 	defer r.queue.Done(req)
 
+	r.inflight.Add(1)
+	defer r.inflight.Add(-1)
 	r.reconcileHandler(ctx, req)
 	return true
 }
@@ -253,8 +282,22 @@ func (r *reconciler) reconcileHandler(ctx context.Context, req types.NamespacedN
 	}
 
 	r.resultCh <- result
-	res, err := r.Reconcile(ctx, req)
+
+	var triggers []*runnerpb.Trigger
+	var recorder *trace.Recorder
+	traceCtx := ctx
+	if r.triggers != nil {
+		triggers = r.triggers.Pop(req)
+		recorder = trace.NewRecorder()
+		traceCtx = trace.IntoContext(ctx, recorder)
+	}
+	start := time.Now()
+	res, err := r.Reconcile(traceCtx, req)
 	result.EventTime = timestamppb.New(time.Now())
+	if r.triggers != nil {
+		result.Trace = recorder.Trace(r.forgvk, req, triggers, time.Since(start))
+		result.Trace.Condition = r.getCondition(ctx, req)
+	}
 	switch {
 	case err != nil:
 		//if errors.Is(err, reconcile.TerminalError(nil)) {
@@ -281,6 +324,7 @@ func (r *reconciler) reconcileHandler(ctx context.Context, req types.NamespacedN
 		result.Operation = runnerpb.Operation_REQUEUE
 		r.resultCh <- result
 
+		r.triggers.Add(req, runnerpb.Trigger_REQUEUE, nil)
 		r.queue.Forget(req)
 		r.queue.AddAfter(req, res.RequeueAfter)
 		//ctrlmetrics.ReconcileTotal.WithLabelValues(c.Name, labelRequeueAfter).Inc()
@@ -289,6 +333,7 @@ func (r *reconciler) reconcileHandler(ctx context.Context, req types.NamespacedN
 		result.Operation = runnerpb.Operation_REQUEUE
 		result.Message = res.Message
 		r.resultCh <- result
+		r.triggers.Add(req, runnerpb.Trigger_REQUEUE, nil)
 		r.queue.AddRateLimited(req)
 		//ctrlmetrics.ReconcileTotal.WithLabelValues(c.Name, labelRequeue).Inc()
 	default:
@@ -303,7 +348,24 @@ func (r *reconciler) reconcileHandler(ctx context.Context, req types.NamespacedN
 	}
 }
 
-func getTypeReconcilerFn(reconcilerConfig *choreov1alpha1.Reconciler, libraries []*choreov1alpha1.Library, client resourceclient.Client, branch string) (reconcile.TypedReconcilerFn, error) {
+// getCondition returns the condition of the reconciler on the for resource, nil when the
+// reconciler has no condition or the resource is gone
+func (r *reconciler) getCondition(ctx context.Context, req types.NamespacedName) *runnerpb.Condition {
+	if r.conditionType == "" {
+		return nil
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(r.forgvk)
+	if err := r.client.Get(ctx, req, u, &resourceclient.GetOptions{
+		Origin: r.name,
+		Branch: r.branchName,
+	}); err != nil {
+		return nil
+	}
+	return trace.ConditionOf(u.Object, r.conditionType)
+}
+
+func getTypeReconcilerFn(ctx context.Context, reconcilerConfig *choreov1alpha1.Reconciler, libraries []*choreov1alpha1.Library, client resourceclient.Client, branch string) (reconcile.TypedReconcilerFn, error) {
 	if reconcilerConfig.Spec.Type == nil {
 		return nil, fmt.Errorf("reconcilerTypenot specified for %s", reconcilerConfig.GetName())
 	}
@@ -314,6 +376,8 @@ func getTypeReconcilerFn(reconcilerConfig *choreov1alpha1.Reconciler, libraries
 		return gotemplate.NewReconcilerFn(client, reconcilerConfig, branch), nil
 	case choreov1alpha1.SoftwardTechnologyType_JinjaTemplate:
 		return jinjatemplate.NewReconcilerFn(client, reconcilerConfig, branch), nil
+	case choreov1alpha1.SoftwardTechnologyType_External:
+		return external.NewReconcilerFn(ctx, client, reconcilerConfig, branch), nil
 	default:
 		return nil, fmt.Errorf("reconcilerType %s is unsupported", (*reconcilerConfig.Spec.Type).String())
 	}
@@ -18,6 +18,7 @@ package reconciler
 
 import (
 	"context"
+	"time"
 
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
@@ -28,8 +29,25 @@ import (
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 )
 
+const (
+	// the reconcilers of a wave are considered done when they are idle for
+	// waveIdleCount consecutive waveIdlePeriods
+	waveIdlePeriod = 100 * time.Millisecond
+	waveIdleCount  = 3
+)
+
+// Holder holds a run once from finishing while reconciler waves are pending
+type Holder interface {
+	Hold()
+	Release()
+}
+
 type reConcilers struct {
 	reconcilers store.Storer[Reconciler]
+	// waves are the names of the reconcilers in the order they are started,
+	// all reconcilers are started at once when no waves are provided
+	waves  [][]string
+	holder Holder
 
 	cancel func()
 }
@@ -42,6 +60,9 @@ func newReconcilers(
 	libraries []*choreov1alpha1.Library,
 	resultCh chan *runnerpb.ReconcileResult,
 	branchName string,
+	waves [][]string,
+	holder Holder,
+	tracing bool,
 ) (*reConcilers, error) {
 	reconcilers := memory.NewStore[Reconciler](nil)
 	var errm error
@@ -56,6 +77,7 @@ func newReconcilers(
 			libraries,
 			resultCh,
 			branchName,
+			tracing,
 		)); err != nil {
 			log.Error("unexpected error, duplicate application name")
 		}
@@ -63,6 +85,8 @@ func newReconcilers(
 
 	return &reConcilers{
 		reconcilers: reconcilers,
+		waves:       waves,
+		holder:      holder,
 	}, errm
 }
 
@@ -71,11 +95,15 @@ func (r *reConcilers) start(ctx context.Context) {
 	ctx, cancel := context.WithCancel(ctx)
 	r.cancel = cancel
 
-	r.reconcilers.List(func(k store.Key, r Reconciler) {
-		go func() {
-			go r.start(ctx)
-		}()
-	})
+	if len(r.waves) > 0 {
+		go r.startWaves(ctx)
+	} else {
+		r.reconcilers.List(func(k store.Key, r Reconciler) {
+			go func() {
+				go r.start(ctx)
+			}()
+		})
+	}
 
 	<-ctx.Done()
 	log.Debug("reconcilers stopped...")
@@ -87,3 +115,56 @@ func (r *reConcilers) stop() {
 		r.cancel()
 	}
 }
+
+// startWaves starts the reconcilers wave by wave, the next wave is started when the
+// reconcilers that were started are idle. The reconcilers of a wave queue their events
+// until they are started.
+func (r *reConcilers) startWaves(ctx context.Context) {
+	log := log.FromContext(ctx)
+	if r.holder != nil {
+		r.holder.Hold()
+		defer r.holder.Release()
+	}
+
+	started := []Reconciler{}
+	for i, wave := range r.waves {
+		for _, name := range wave {
+			reconciler, err := r.reconcilers.Get(store.ToKey(name))
+			if err != nil {
+				log.Error("cannot start reconciler wave, reconciler not found", "reconciler", name)
+				continue
+			}
+			go reconciler.start(ctx)
+			started = append(started, reconciler)
+		}
+		log.Debug("reconciler wave started", "wave", i, "reconcilers", wave)
+		if i < len(r.waves)-1 && !waitIdle(ctx, started) {
+			return
+		}
+	}
+}
+
+// waitIdle waits till the reconcilers are idle, it returns false when the context is cancelled
+func waitIdle(ctx context.Context, reconcilers []Reconciler) bool {
+	ticker := time.NewTicker(waveIdlePeriod)
+	defer ticker.Stop()
+
+	idle := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			idle++
+			for _, reconciler := range reconcilers {
+				if !reconciler.idle() {
+					idle = 0
+					break
+				}
+			}
+			if idle >= waveIdleCount {
+				return true
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// DecodeDocuments decodes the rendered output of a template into a list of resources.
+// The output can contain multiple documents separated by ---, and every document can either
+// be a single resource, a list of resources or a kubernetes List with the resources as items.
+// Empty documents are ignored.
+func DecodeDocuments(b []byte) ([]*unstructured.Unstructured, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(b)))
+	objs := []*unstructured.Unstructured{}
+	refs := map[corev1.ObjectReference]struct{}{}
+	for i := 0; ; i++ {
+		doc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, err
+		}
+		if len(strings.TrimSpace(string(doc))) == 0 {
+			continue
+		}
+		var data any
+		if err := yaml.Unmarshal(doc, &data); err != nil {
+			return nil, fmt.Errorf("document %d: invalid yaml: %s", i, err.Error())
+		}
+		items, err := getDocumentItems(data)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %s", i, err.Error())
+		}
+		for _, item := range items {
+			u := &unstructured.Unstructured{Object: item}
+			if u.GetAPIVersion() == "" || u.GetKind() == "" || u.GetName() == "" {
+				return nil, fmt.Errorf("document %d: resource requires an apiVersion, kind and name", i)
+			}
+			ref := GetObjectReference(u)
+			if _, ok := refs[ref]; ok {
+				return nil, fmt.Errorf("document %d: duplicate resource %s", i, ref.String())
+			}
+			refs[ref] = struct{}{}
+			objs = append(objs, u)
+		}
+	}
+}
+
+func getDocumentItems(data any) ([]map[string]any, error) {
+	switch data := data.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		items := make([]map[string]any, 0, len(data))
+		for _, item := range data {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("expected a resource in the list, got %T", item)
+			}
+			items = append(items, obj)
+		}
+		return items, nil
+	case map[string]any:
+		u := &unstructured.Unstructured{Object: data}
+		if u.IsList() {
+			return getDocumentItems(data["items"])
+		}
+		return []map[string]any{data}, nil
+	default:
+		return nil, fmt.Errorf("expected a resource or a list of resources, got %T", data)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+)
+
+func TestDecodeDocuments(t *testing.T) {
+	cases := map[string]struct {
+		input       string
+		expected    []string
+		expectedErr bool
+	}{
+		"Empty": {
+			input:    "",
+			expected: []string{},
+		},
+		"Single": {
+			input:    "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n",
+			expected: []string{"a"},
+		},
+		"MultiDocument": {
+			input:    "---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\n\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n",
+			expected: []string{"a", "b"},
+		},
+		"ListForm": {
+			input:    "- apiVersion: v1\n  kind: ConfigMap\n  metadata:\n    name: a\n- apiVersion: v1\n  kind: ConfigMap\n  metadata:\n    name: b\n",
+			expected: []string{"a", "b"},
+		},
+		"KubernetesList": {
+			input:    "apiVersion: v1\nkind: List\nitems:\n- apiVersion: v1\n  kind: ConfigMap\n  metadata:\n    name: a\n",
+			expected: []string{"a"},
+		},
+		"Duplicate": {
+			input:       "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n",
+			expectedErr: true,
+		},
+		"MissingName": {
+			input:       "apiVersion: v1\nkind: ConfigMap\n",
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			objs, err := DecodeDocuments([]byte(tc.input))
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error, got %d resources", len(objs))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(objs) != len(tc.expected) {
+				t.Fatalf("expected %d resources, got %d", len(tc.expected), len(objs))
+			}
+			for i, u := range objs {
+				if u.GetName() != tc.expected[i] {
+					t.Errorf("expected resource %d to be %s, got %s", i, tc.expected[i], u.GetName())
+				}
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/henderiw/logger/log"
@@ -134,6 +135,25 @@ func (r *Resources) getExistingResources(ctx context.Context) error {
 	return errm
 }
 
+// GetExistingResources returns the existing resources that are owned by this cr
+func (r *Resources) GetExistingResources(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if err := r.getExistingResources(ctx); err != nil {
+		return nil, err
+	}
+	refs := r.getExistingRefs()
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].String() < refs[j].String()
+	})
+	l := make([]*unstructured.Unstructured, 0, len(refs))
+	for _, ref := range refs {
+		l = append(l, r.existingResources[ref])
+	}
+	return l, nil
+}
+
 // APIDelete is used to delete the existing resources that are owned by this cr
 // the implementation retrieves the existing resources and deletes them
 func (r *Resources) Delete(ctx context.Context) error {
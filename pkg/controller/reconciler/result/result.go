@@ -0,0 +1,230 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/henderiw/logger/log"
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/controller/reconcile"
+	"github.com/kform-dev/choreo/pkg/controller/reconciler/resources"
+	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
+	"github.com/kform-dev/choreo/pkg/util/object"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// Result is the outcome of the reconciler code. The contract is shared by all
+// reconciler types (starlark, gotemplate, jinjatemplate).
+type Result struct {
+	// Object holds the spec and status reported by the reconciler code
+	Object *unstructured.Unstructured
+	// Requeue indicates the for resource should be reconciled again
+	Requeue bool
+	// RequeueAfter is the requeue delay in seconds
+	RequeueAfter int64
+	// Message indicates a failure of the reconcile when not empty
+	Message string
+	// Fatal indicates the failure is not recoverable by a requeue
+	Fatal bool
+}
+
+// resultDocument is the output of the result template of the template reconcilers
+type resultDocument struct {
+	Spec         any    `json:"spec,omitempty"`
+	Status       any    `json:"status,omitempty"`
+	Requeue      bool   `json:"requeue,omitempty"`
+	RequeueAfter int64  `json:"requeueAfter,omitempty"`
+	Message      string `json:"message,omitempty"`
+	Fatal        bool   `json:"fatal,omitempty"`
+}
+
+// Decode decodes the rendered output of a result template, e.g.
+//
+//	spec: {}          # applied when the reconciler has specUpdate set
+//	status: {}        # the status of the for resource
+//	message: ""       # a non empty message indicates a failure
+//	requeue: false
+//	requeueAfter: 0   # in seconds
+//	fatal: false
+func Decode(b []byte) (*Result, error) {
+	doc := &resultDocument{}
+	if err := yaml.UnmarshalStrict(b, doc); err != nil {
+		return nil, err
+	}
+	obj := map[string]any{}
+	if doc.Spec != nil {
+		obj["spec"] = doc.Spec
+	}
+	if doc.Status != nil {
+		obj["status"] = doc.Status
+	}
+	return &Result{
+		Object:       &unstructured.Unstructured{Object: obj},
+		Requeue:      doc.Requeue,
+		RequeueAfter: doc.RequeueAfter,
+		Message:      strings.TrimSpace(doc.Message),
+		Fatal:        doc.Fatal,
+	}, nil
+}
+
+func New(client resourceclient.Client, reconcileConfig *choreov1alpha1.Reconciler, branch string) *Handler {
+	return &Handler{
+		name:          reconcileConfig.Name,
+		client:        client,
+		branch:        branch,
+		conditionType: reconcileConfig.Spec.ConditionType,
+		specUpdate:    reconcileConfig.Spec.SpecUpdate,
+	}
+}
+
+// Handler handles the result of the reconciler code: it applies the child resources and
+// updates the for resource with the condition, status and optionally the spec
+type Handler struct {
+	name          string
+	client        resourceclient.Client
+	branch        string
+	conditionType *string
+	specUpdate    *bool
+}
+
+func (r *Handler) Handle(ctx context.Context, u *unstructured.Unstructured, result *Result, resources *resources.Resources) (reconcile.Result, error) {
+	log := log.FromContext(ctx)
+	if result.Object == nil {
+		result.Object = &unstructured.Unstructured{Object: map[string]any{}}
+	}
+	if result.Fatal {
+		if uerr := r.UpdateForResource(ctx, u, result.Object, result.Message); uerr != nil {
+			return reconcile.Result{}, fmt.Errorf("reconciler %s cannot update resource: err: %v, orig fatal error: %s", r.name, uerr, result.Message)
+		}
+		return reconcile.Result{}, errors.New(result.Message)
+	}
+	var requeue time.Duration
+	if result.RequeueAfter != 0 {
+		requeue = time.Duration(result.RequeueAfter) * time.Second
+	}
+	if result.Message != "" {
+		log.Debug("reconcile failed", "msg", result.Message)
+		if uerr := r.UpdateForResource(ctx, u, result.Object, result.Message); uerr != nil {
+			return reconcile.Result{Requeue: true, RequeueAfter: requeue, Message: result.Message},
+				fmt.Errorf("reconciler %s cannot update resource: err: %v, orig error: %s", r.name, uerr, result.Message)
+		}
+		return reconcile.Result{Requeue: true, RequeueAfter: requeue, Message: result.Message}, nil
+	}
+	// this is the happy path, we apply the child resources to the api
+	if err := resources.Apply(ctx); err != nil {
+		// when we get not initialized we continue and requeue
+		// other errors are returned as fatal
+		if strings.Contains(err.Error(), "not initialized") {
+			log.Info("apply resources failed requeue", "reconciler", r.name, "err", err)
+
+			return reconcile.Result{
+				Requeue:      true,
+				RequeueAfter: requeue,
+				Message:      fmt.Errorf("reconciler %s apply resources failed, err: %s", r.name, err.Error()).Error(),
+			}, nil
+		}
+		return r.Fail(ctx, u, fmt.Errorf("apply resources failed reconciler %s err: %v", r.name, err))
+	}
+	if err := r.UpdateForResource(ctx, u, result.Object, ""); err != nil {
+		return reconcile.Result{}, err
+	}
+	if result.Requeue || requeue > 0 {
+		return reconcile.Result{Requeue: true, RequeueAfter: requeue}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// Fail reports the error on the condition of the for resource and returns the error
+func (r *Handler) Fail(ctx context.Context, u *unstructured.Unstructured, err error) (reconcile.Result, error) {
+	if uerr := r.UpdateForResource(ctx, u, nil, err.Error()); uerr != nil {
+		return reconcile.Result{}, fmt.Errorf("reconciler %s cannot update resource: err: %v, orig error: %s", r.name, uerr, err.Error())
+	}
+	return reconcile.Result{}, err
+}
+
+// UpdateForResource updates the for resource with the status and spec of newu
+// and sets the condition of the reconciler; an empty msg indicates success
+func (r *Handler) UpdateForResource(ctx context.Context, u, newu *unstructured.Unstructured, msg string) error {
+	if newu != nil {
+		if newStatus, ok := newu.Object["status"]; ok {
+			u.Object["status"] = newStatus
+		}
+		if r.specUpdate != nil && *r.specUpdate {
+			if newSpec, ok := newu.Object["spec"]; ok {
+				u.Object["spec"] = newSpec
+			}
+		}
+	}
+	// removes the fields that are not managed by this reconciler based on the managedFields info in the resource
+	// done before conditions are set
+	object.PruneUnmanagedFields(u, r.name)
+	object.SetFinalizer(u, r.name)
+	if r.conditionType != nil {
+		object.SetCondition(u.Object, *r.conditionType, msg)
+	}
+
+	// the status is captured before the apply since the apply returns the stored object
+	statusObj := newStatusObject(u)
+
+	// update the for resource with latest changes; when the status subresource is enabled
+	// the status is ignored by the apply and applied through the status subresource
+	if err := r.client.Apply(ctx, u, &resourceclient.ApplyOptions{
+		FieldManager: r.name,
+		Branch:       r.branch,
+	}); err != nil {
+		return fmt.Errorf("cannot apply resource, err: %s", err.Error())
+	}
+	if statusObj == nil {
+		return nil
+	}
+	if err := r.client.ApplyStatus(ctx, statusObj, &resourceclient.ApplyOptions{
+		FieldManager: r.name,
+		Branch:       r.branch,
+	}); err != nil {
+		// without status subresource the status was already applied with the resource
+		if grpcerrors.IsUnimplemented(err) {
+			return nil
+		}
+		return fmt.Errorf("cannot apply resource status, err: %s", err.Error())
+	}
+	return nil
+}
+
+// newStatusObject returns an object with the identity and the status of u,
+// nil when u has no status
+func newStatusObject(u *unstructured.Unstructured) *unstructured.Unstructured {
+	status, ok := u.Object["status"]
+	if !ok {
+		return nil
+	}
+	statusObj := &unstructured.Unstructured{Object: map[string]any{
+		"status": runtime.DeepCopyJSONValue(status),
+	}}
+	statusObj.SetAPIVersion(u.GetAPIVersion())
+	statusObj.SetKind(u.GetKind())
+	statusObj.SetNamespace(u.GetNamespace())
+	statusObj.SetName(u.GetName())
+	return statusObj
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package result
+
+import (
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	cases := map[string]struct {
+		input        string
+		expectedErr  bool
+		expectedMsg  string
+		requeueAfter int64
+		hasStatus    bool
+	}{
+		"Empty": {
+			input: "",
+		},
+		"Status": {
+			input:     "status:\n  ready: true\n",
+			hasStatus: true,
+		},
+		"Failure": {
+			input:        "message: no peers\nrequeueAfter: 5\n",
+			expectedMsg:  "no peers",
+			requeueAfter: 5,
+		},
+		"UnknownField": {
+			input:       "msg: typo\n",
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := Decode([]byte(tc.input))
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Message != tc.expectedMsg {
+				t.Errorf("expected message %q, got %q", tc.expectedMsg, result.Message)
+			}
+			if result.RequeueAfter != tc.requeueAfter {
+				t.Errorf("expected requeueAfter %d, got %d", tc.requeueAfter, result.RequeueAfter)
+			}
+			if _, ok := result.Object.Object["status"]; ok != tc.hasStatus {
+				t.Errorf("expected status %t, got %t", tc.hasStatus, ok)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"unsafe"
 
 	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/controller/reconciler/starlark/stdlib"
 	"go.starlark.net/starlark"
 	"go.starlark.net/syntax"
 )
@@ -94,6 +95,10 @@ func (c *cache) doLoad(cc *cycleChecker, module string, builtins starlark.String
 	}
 	data, ok := c.modules[module]
 	if !ok {
+		// libraries take precedence over the standard library modules
+		if globals, ok := stdlib.Load(module); ok {
+			return globals, nil
+		}
 		return nil, fmt.Errorf("module %s not found in library", module)
 	}
 	return starlark.ExecFileOptions(&syntax.FileOptions{}, thread, module, data, builtins)
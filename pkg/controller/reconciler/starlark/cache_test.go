@@ -52,6 +52,57 @@ func TestCache(t *testing.T) {
 			reconciler:  `load("a.star", "a"); print(a)`,
 			expextedErr: true,
 		},
+		"StdLib": {
+			cache: &cache{
+				cache: make(map[string]*entry),
+				modules: map[string]string{
+					"a.star": `load("json", "json"); load("re", "re"); a = json.encode({"a": re.find("[0-9]+", "abc123")})`,
+				},
+			},
+			reconciler: `
+load("a.star", "a")
+load("json", "json")
+load("yaml", "yaml")
+load("re", "re")
+load("time", "time")
+load("hash", "hash")
+load("strings", "strings")
+
+def check(got, want):
+    if got != want:
+        fail("got %r, want %r" % (got, want))
+
+check(a, '{"a":"123"}')
+check(json.decode(a), {"a": "123"})
+check(yaml.encode({"a": [1, "b"]}), "a:\n- 1\n- b\n")
+check(yaml.decode(yaml.encode({"a": [1, "b"]})), {"a": [1, "b"]})
+check(re.match("^eth[0-9]+$", "eth12"), True)
+check(re.find_submatch("([a-z]+)-([0-9]+)", "eth-12"), ["eth-12", "eth", "12"])
+check(re.replace("([0-9]+)", "eth12", "<$1>"), "eth<12>")
+check((time.from_timestamp(0) + time.parse_duration("90m")).unix, 5400)
+check(time.parse_duration("1h") - time.parse_duration("15m"), time.parse_duration("45m"))
+check(hash.sha256("abc"), "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad")
+check(hash.fnv32a(""), 2166136261)
+check(strings.format("%s-%03d %v", "eth", 7, True), "eth-007 true")
+check(strings.pad_left("7", 3, "0"), "007")
+check(strings.pad_right("ab", 4), "ab  ")
+check(strings.join(",", ["a", 1]), "a,1")
+check(strings.upper("ab"), "AB")
+check(strings.title("hello world"), "Hello World")
+check(strings.snake_case("ipAddress-v4"), "ip_address_v4")
+check(strings.kebab_case("IPAddress"), "ipaddress")
+check(strings.camel_case("ip_address"), "ipAddress")
+`,
+			expextedErr: false,
+		},
+		"UnknownModule": {
+			cache: &cache{
+				cache:   make(map[string]*entry),
+				modules: map[string]string{},
+			},
+			reconciler:  `load("unknown", "x"); print(x)`,
+			expextedErr: true,
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
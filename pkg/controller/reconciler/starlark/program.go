@@ -0,0 +1,109 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package starlark
+
+import (
+	"fmt"
+	"sync"
+
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// reconcilerLocalKey is the thread local key holding the reconciler of the reconcile
+// that is being executed; the builtins use it to access the per reconcile state
+const reconcilerLocalKey = "reconciler"
+
+// builtins are shared by all reconcilers; the builtins that need the per reconcile
+// state retrieve the reconciler from the thread executing the starlark code
+var builtins = starlark.StringDict{
+	"client_get":           reconcilerBuiltin("client_get", (*reconciler).get),
+	"client_list":          reconcilerBuiltin("client_list", (*reconciler).list),
+	"client_update":        reconcilerBuiltin("client_update", (*reconciler).update),
+	"client_update_status": reconcilerBuiltin("client_update_status", (*reconciler).updateStatus),
+	"client_create":        reconcilerBuiltin("client_create", (*reconciler).create),
+	"client_apply":         reconcilerBuiltin("client_apply", (*reconciler).apply),
+	"client_delete":        reconcilerBuiltin("client_delete", (*reconciler).delete),
+	"reconcile_result":     reconcilerBuiltin("reconcile_result", (*reconciler).reconcileResult),
+	"get_resource":         starlark.NewBuiltin("get_resource", getResource),
+	"del_finalizer":        starlark.NewBuiltin("del_finalizer", delFinalizer),
+	"add_finalizer":        starlark.NewBuiltin("add_finalizer", addFinalizer),
+	"get_prefixlength":     starlark.NewBuiltin("get_prefixlength", getPrefixLength),
+	"get_subnetname":       starlark.NewBuiltin("get_subnetname", getSubnetName),
+	"get_address":          starlark.NewBuiltin("get_address", getAddress),
+	"is_ipv4":              starlark.NewBuiltin("is_ipv4", isIPv4),
+	"is_ipv6":              starlark.NewBuiltin("is_ipv6", isIPv6),
+	"is_conditionready":    starlark.NewBuiltin("is_condition_ready", isConditionReady),
+}
+
+type reconcilerBuiltinFn func(r *reconciler, thread *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error)
+
+func reconcilerBuiltin(name string, fn reconcilerBuiltinFn) *starlark.Builtin {
+	return starlark.NewBuiltin(name, func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		r, ok := thread.Local(reconcilerLocalKey).(*reconciler)
+		if !ok {
+			return nil, fmt.Errorf("%s can only be called during a reconcile", name)
+		}
+		return fn(r, thread, b, args, kwargs)
+	})
+}
+
+// program is the compiled reconciler code together with the libraries it loads.
+// It is built once and shared by all workers of the reconciler; the globals are
+// frozen after execution which makes them safe for concurrent use.
+type program struct {
+	once      sync.Once
+	name      string
+	code      string
+	libraries []*choreov1alpha1.Library
+
+	globals starlark.StringDict
+	err     error
+}
+
+func newProgram(reconcileConfig *choreov1alpha1.Reconciler, libraries []*choreov1alpha1.Library) *program {
+	return &program{
+		name:      reconcileConfig.GetName(),
+		code:      reconcileConfig.Spec.Code["reconciler.star"],
+		libraries: libraries,
+	}
+}
+
+// getGlobals returns the globals of the reconciler code, the code is executed on first use
+func (r *program) getGlobals() (starlark.StringDict, error) {
+	r.once.Do(func() {
+		// cache deals with library loading
+		cache := newCache(r.libraries)
+		cc := new(cycleChecker)
+
+		thread := &starlark.Thread{
+			Name: "main",
+			Load: func(thread *starlark.Thread, module string) (starlark.StringDict, error) {
+				return cache.get(cc, module, builtins)
+			},
+		}
+
+		globals, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, "reconciler.star", r.code, builtins)
+		if err != nil {
+			r.err = fmt.Errorf("reconciler %s err: %v", r.name, err)
+			return
+		}
+		r.globals = globals
+	})
+	return r.globals, r.err
+}
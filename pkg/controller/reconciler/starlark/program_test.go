@@ -0,0 +1,89 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package starlark
+
+import (
+	"testing"
+
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"go.starlark.net/starlark"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProgram(t *testing.T) {
+	cases := map[string]struct {
+		code        string
+		libraries   map[string]string
+		expectedErr bool
+	}{
+		"Library": {
+			code:      "load(\"lib.star\", \"add\")\ndef reconcile(self):\n  return add(1, 2)\n",
+			libraries: map[string]string{"lib.star": "def add(a, b):\n  return a + b\n"},
+		},
+		"MissingLibrary": {
+			code:        "load(\"lib.star\", \"add\")\ndef reconcile(self):\n  return add(1, 2)\n",
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			libraries := []*choreov1alpha1.Library{}
+			for name, code := range tc.libraries {
+				libraries = append(libraries, &choreov1alpha1.Library{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: choreov1alpha1.LibrarySpec{
+						Type: choreov1alpha1.SoftwardTechnologyType_Starlark,
+						Code: code,
+					},
+				})
+			}
+			p := newProgram(&choreov1alpha1.Reconciler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: choreov1alpha1.ReconcilerSpec{
+					Code: map[string]string{"reconciler.star": tc.code},
+				},
+			}, libraries)
+
+			globals, err := p.getGlobals()
+			if err != nil {
+				if !tc.expectedErr {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.expectedErr {
+				t.Fatalf("expected an error got nil")
+			}
+			// the globals are reused across reconciles
+			again, _ := p.getGlobals()
+			if again["reconcile"] != globals["reconcile"] {
+				t.Errorf("expected the compiled program to be reused")
+			}
+			// concurrent reconciles each use their own thread
+			for i := 0; i < 2; i++ {
+				thread := (&reconciler{name: "test"}).newThread()
+				v, err := starlark.Call(thread, globals["reconcile"], starlark.Tuple{starlark.None}, nil)
+				if err != nil {
+					t.Fatalf("unexpected call error: %v", err)
+				}
+				if v.String() != "3" {
+					t.Errorf("expected 3, got %s", v.String())
+				}
+			}
+		})
+	}
+}
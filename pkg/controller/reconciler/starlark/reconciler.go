@@ -18,23 +18,22 @@ package starlark
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
-	"strings"
 	"time"
 
 	"github.com/henderiw/iputil"
-	"github.com/henderiw/logger/log"
 	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
 	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
 	"github.com/kform-dev/choreo/pkg/controller/reconcile"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/resources"
+	reconcileresult "github.com/kform-dev/choreo/pkg/controller/reconciler/result"
 	"github.com/kform-dev/choreo/pkg/controller/reconciler/starlark/util"
 	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
 	"github.com/kform-dev/choreo/pkg/util/object"
 	"go.starlark.net/starlark"
-	"go.starlark.net/syntax"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -43,57 +42,25 @@ import (
 )
 
 func NewReconcilerFn(client resourceclient.Client, reconcileConfig *choreov1alpha1.Reconciler, libraries []*choreov1alpha1.Library, branch string) reconcile.TypedReconcilerFn {
+	// the program is shared by all reconciles, a reconcile only holds its own state
+	program := newProgram(reconcileConfig, libraries)
 	return func() (reconcile.TypedReconciler, error) {
-		r := &reconciler{
-			name:          reconcileConfig.Name,
-			client:        client,
-			conditionType: reconcileConfig.Spec.ConditionType,
-			specUpdate:    reconcileConfig.Spec.SpecUpdate,
-			forgvk:        reconcileConfig.GetForGVK(),
-			owns:          reconcileConfig.GetOwnsGVKs(),
-			branch:        branch,
-		}
-
-		// Setup built-in functions
-		builtins := starlark.StringDict{
-			"client_get":           starlark.NewBuiltin("client_get", r.get),
-			"client_list":          starlark.NewBuiltin("client_list", r.list),
-			"client_update":        starlark.NewBuiltin("client_update", r.update),
-			"client_update_status": starlark.NewBuiltin("client_update_status", r.updateStatus),
-			"client_create":        starlark.NewBuiltin("client_create", r.create),
-			"client_apply":         starlark.NewBuiltin("client_apply", r.apply),
-			"client_delete":        starlark.NewBuiltin("client_delete", r.delete),
-			"reconcile_result":     starlark.NewBuiltin("reconcile_result", r.reconcileResult),
-			"get_resource":         starlark.NewBuiltin("get_resource", getResource),
-			"del_finalizer":        starlark.NewBuiltin("del_finalizer", delFinalizer),
-			"add_finalizer":        starlark.NewBuiltin("add_finalizer", addFinalizer),
-			"get_prefixlength":     starlark.NewBuiltin("get_prefixlength", getPrefixLength),
-			"get_subnetname":       starlark.NewBuiltin("get_subnetname", getSubnetName),
-			"get_address":          starlark.NewBuiltin("get_address", getAddress),
-			"is_ipv4":              starlark.NewBuiltin("is_ipv4", isIPv4),
-			"is_ipv6":              starlark.NewBuiltin("is_ipv6", isIPv6),
-			"is_conditionready":    starlark.NewBuiltin("is_condition_ready", isConditionReady),
-		}
-
-		// cache deals with library loading
-		cache := newCache(libraries)
-		cc := new(cycleChecker)
-
-		thread := &starlark.Thread{
-			Name: "main",
-			Load: func(thread *starlark.Thread, module string) (starlark.StringDict, error) {
-				return cache.get(cc, module, builtins)
-			},
-		}
-
-		startlarkReconciler := reconcileConfig.Spec.Code["reconciler.star"]
-		starlarkReconciler, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, "reconciler.star", startlarkReconciler, builtins)
+		globals, err := program.getGlobals()
 		if err != nil {
-			return nil, fmt.Errorf("reconciler %s err: %v", reconcileConfig.GetName(), err)
+			return nil, err
 		}
-
-		r.startlarkReconciler = starlarkReconciler
-		return r, nil
+		return &reconciler{
+			name:                reconcileConfig.Name,
+			client:              client,
+			specUpdate:          reconcileConfig.Spec.SpecUpdate,
+			forgvk:              reconcileConfig.GetForGVK(),
+			owns:                reconcileConfig.GetOwnsGVKs(),
+			branch:              branch,
+			maxSteps:            reconcileConfig.GetMaxSteps(),
+			timeout:             reconcileConfig.GetTimeout(),
+			startlarkReconciler: globals,
+			resultHandler:       reconcileresult.New(client, reconcileConfig, branch),
+		}, nil
 	}
 }
 
@@ -101,11 +68,13 @@ type reconciler struct {
 	name                string
 	startlarkReconciler starlark.StringDict
 	client              resourceclient.Client
-	conditionType       *string
 	specUpdate          *bool
 	forgvk              schema.GroupVersionKind
 	owns                sets.Set[schema.GroupVersionKind]
 	branch              string
+	maxSteps            uint64
+	timeout             time.Duration
+	resultHandler       *reconcileresult.Handler
 	// dynamic data set on each reconcile
 	ctx       context.Context // bad practice but allows for reuse of ctx
 	resources *resources.Resources
@@ -140,12 +109,9 @@ func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			if err != nil {
 				return reconcile.Result{}, err
 			}
-			reconciler := r.startlarkReconciler["reconcile"]
-			thread := &starlark.Thread{Name: "main"}
-			v, err := starlark.Call(thread, reconciler, starlark.Tuple{starlark.Value(obj)}, nil)
+			v, err := r.execute(ctx, obj)
 			if err != nil {
-				// this is a starlark execution runtime failure
-				return reconcile.Result{}, fmt.Errorf("starlark execution runtime failure: %s", err.Error())
+				return reconcile.Result{}, err
 			}
 			reconcileResult, err := r.handleResult(ctx, u, v)
 			if err != nil {
@@ -176,116 +142,93 @@ func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if err != nil {
 		return reconcile.Result{}, err
 	}
-	reconciler := r.startlarkReconciler["reconcile"]
-	thread := &starlark.Thread{Name: "main"}
-	v, err := starlark.Call(thread, reconciler, starlark.Tuple{starlark.Value(obj)}, nil)
+	v, err := r.execute(ctx, obj)
 	if err != nil {
-		// this is a starlark execution runtime failure
-		return reconcile.Result{}, fmt.Errorf("starlark execution runtime failure: %s", err.Error())
+		return r.resultHandler.Fail(ctx, u, err)
 	}
 
 	return r.handleResult(ctx, u, v)
 }
 
-func (r *reconciler) handleResult(ctx context.Context, oldu *unstructured.Unstructured, v starlark.Value) (reconcile.Result, error) {
-	log := log.FromContext(ctx)
-	newu, result, err := convertReconcileResult(v)
-	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("starlark reconciler %s cannot convert result: %s", r.name, err.Error())
-	}
-	if result.Fatal {
-		if uerr := r.updateForResourceStatus(ctx, newu, oldu, result.Message); uerr != nil {
-			return reconcile.Result{}, fmt.Errorf("starlark reconciler cannot update resource: err: %v, orig fatal error: %s", uerr, result.Message)
-		}
-		return reconcile.Result{}, fmt.Errorf(result.Message)
-	}
-	var requeue time.Duration
-	if result.RequeueAfter != 0 {
-		requeue = time.Duration(result.RequeueAfter) * time.Second
-	}
-	if result.Message != "" {
-		log.Debug("reconcile failed", "msg", result.Message)
-		if uerr := r.updateForResourceStatus(ctx, newu, oldu, result.Message); uerr != nil {
-			return reconcile.Result{Requeue: true, RequeueAfter: requeue, Message: result.Message},
-				fmt.Errorf("starlark reconciler cannot update resource: err: %v, orig error: %s", uerr, result.Message)
-		}
-		return reconcile.Result{Requeue: true, RequeueAfter: requeue, Message: result.Message}, nil
-	}
-	// this is the happy path, we apply the child resources to the api
-	if err := r.resources.Apply(ctx); err != nil {
-		// when we get not initialized we continue and requeue
-		// other errors are returned as fatal
-		if strings.Contains(err.Error(), "not initialized") {
-			log.Info("apply resources failed requeue", "reconciler", r.name, "err", err)
-
-			return reconcile.Result{
-				Requeue:      true,
-				RequeueAfter: requeue,
-				Message:      fmt.Errorf("starlark reconciler %s apply resources failed, err: %s", r.name, err.Error()).Error(),
-			}, nil
-		}
-		return reconcile.Result{}, fmt.Errorf("apply resources failed reconciler %s err: %v", r.name, err)
-	}
-	if err := r.updateForResourceStatus(ctx, newu, oldu, ""); err != nil {
-		return reconcile.Result{}, err
+// newThread returns the thread to execute the reconciler code; the reconciler is stored
+// in the thread so the builtins operate on the state of this reconcile
+func (r *reconciler) newThread() *starlark.Thread {
+	thread := &starlark.Thread{Name: "main"}
+	thread.SetLocal(reconcilerLocalKey, r)
+	if r.maxSteps > 0 {
+		thread.SetMaxExecutionSteps(r.maxSteps)
 	}
-	return reconcile.Result{}, nil
+	return thread
 }
 
-func (r *reconciler) updateForResourceStatus(ctx context.Context, newu, u *unstructured.Unstructured, msg string) error {
-	// removes the fields that are not managed by this reconciler based on the managedFields info in the resource
-	// done before conditions are set
-	if newStatus, ok := newu.Object["status"]; ok {
-		u.Object["status"] = newStatus
-	}
-	if r.specUpdate != nil && *r.specUpdate {
-		if newSpec, ok := newu.Object["spec"]; ok {
-			u.Object["spec"] = newSpec
+// execute calls the reconcile function of the starlark code with the execution limits of the reconciler.
+// The execution is cancelled when the reconcile context is cancelled or when the timeout expires.
+func (r *reconciler) execute(ctx context.Context, obj starlark.Value) (starlark.Value, error) {
+	var cancel context.CancelFunc
+	if r.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	// the builtins use the context of the execution such that client calls are cancelled as well
+	r.ctx = ctx
+
+	thread := r.newThread()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			thread.Cancel(ctx.Err().Error())
+		case <-done:
 		}
-	}
-	object.PruneUnmanagedFields(u, r.name)
-	object.SetFinalizer(u, r.name)
-	if r.conditionType != nil {
-		object.SetCondition(u.Object, *r.conditionType, msg)
-	}
+	}()
 
-	// update the for resource with latest changes
-	if err := r.client.Apply(ctx, u, &resourceclient.ApplyOptions{
-		FieldManager: r.name,
-		Branch:       r.branch,
-	}); err != nil {
-		return fmt.Errorf("cannot apply resource, err: %s", err.Error())
+	v, err := starlark.Call(thread, r.startlarkReconciler["reconcile"], starlark.Tuple{obj}, nil)
+	if err != nil {
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			return nil, fmt.Errorf("starlark reconciler %s cancelled, execution exceeded the timeout of %s", r.name, r.timeout)
+		case ctx.Err() != nil:
+			return nil, fmt.Errorf("starlark reconciler %s cancelled, err: %s", r.name, ctx.Err().Error())
+		case r.maxSteps > 0 && thread.ExecutionSteps() >= r.maxSteps:
+			return nil, fmt.Errorf("starlark reconciler %s cancelled, execution exceeded the maximum of %d steps", r.name, r.maxSteps)
+		}
+		// this is a starlark execution runtime failure
+		return nil, fmt.Errorf("starlark execution runtime failure: %s", err.Error())
 	}
-	return nil
+	return v, nil
 }
 
-type ReconcileResult struct {
-	Requeue      bool
-	RequeueAfter int64
-	Message      string
-	Fatal        bool
+func (r *reconciler) handleResult(ctx context.Context, oldu *unstructured.Unstructured, v starlark.Value) (reconcile.Result, error) {
+	res, err := convertReconcileResult(v)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("starlark reconciler %s cannot convert result: %s", r.name, err.Error())
+	}
+	return r.resultHandler.Handle(ctx, oldu, res, r.resources)
 }
 
-func convertReconcileResult(v starlark.Value) (*unstructured.Unstructured, *ReconcileResult, error) {
+func convertReconcileResult(v starlark.Value) (*reconcileresult.Result, error) {
 	result, err := util.StarlarkValueToMap(v)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	objVal, ok := result["obj"].(map[string]any)
 	if !ok {
 		//fmt.Printf("obj: %v", result["obj"])
-		return nil, nil, fmt.Errorf("reconcileResult obj is not map[string]any, got: %v", reflect.TypeOf(result["obj"]).Name())
+		return nil, fmt.Errorf("reconcileResult obj is not map[string]any, got: %v", reflect.TypeOf(result["obj"]).Name())
 	}
 	u := &unstructured.Unstructured{
 		Object: objVal,
 	}
 
-	reconcileResult := &ReconcileResult{}
+	reconcileResult := &reconcileresult.Result{Object: u}
 	if v, ok := result["requeue"]; ok {
 		vv, ok := v.(bool)
 		if !ok {
-			return nil, nil, fmt.Errorf("reconcileResult requeue is not a bool, got %T", v)
+			return nil, fmt.Errorf("reconcileResult requeue is not a bool, got %T", v)
 		}
 		reconcileResult.Requeue = vv
 
@@ -293,14 +236,14 @@ func convertReconcileResult(v starlark.Value) (*unstructured.Unstructured, *Reco
 	if v, ok := result["requeueAfter"]; ok {
 		vv, ok := v.(int64)
 		if !ok {
-			return nil, nil, fmt.Errorf("reconcileResult requeueAfter is not a int64, got %T", v)
+			return nil, fmt.Errorf("reconcileResult requeueAfter is not a int64, got %T", v)
 		}
 		reconcileResult.RequeueAfter = vv
 	}
 	if v, ok := result["fatal"]; ok {
 		vv, ok := v.(bool)
 		if !ok {
-			return nil, nil, fmt.Errorf("reconcileResult fatal is not a bool, got %T", v)
+			return nil, fmt.Errorf("reconcileResult fatal is not a bool, got %T", v)
 		}
 		reconcileResult.Fatal = vv
 
@@ -308,11 +251,11 @@ func convertReconcileResult(v starlark.Value) (*unstructured.Unstructured, *Reco
 	if v, ok := result["error"]; ok {
 		vv, ok := v.(string)
 		if !ok {
-			return nil, nil, fmt.Errorf("reconcileResult error is not a string, got %T", v)
+			return nil, fmt.Errorf("reconcileResult error is not a string, got %T", v)
 		}
 		reconcileResult.Message = vv
 	}
-	return u, reconcileResult, nil
+	return reconcileResult, nil
 }
 
 func reconcileResult(obj starlark.Value, requeue bool, requeueAfter int64, err error, fatal bool) *starlark.Dict {
@@ -548,14 +491,17 @@ func (r *reconciler) updateStatus(thread *starlark.Thread, fn *starlark.Builtin,
 		return result(nil, err, true), nil
 	}
 
-	if err := r.client.Apply(r.ctx,
-		u,
-		&resourceclient.ApplyOptions{
-			FieldManager: r.name,
-			Origin:       r.name,
-			Branch:       r.branch,
-		},
-	); err != nil {
+	opts := &resourceclient.ApplyOptions{
+		FieldManager: r.name,
+		Origin:       r.name,
+		Branch:       r.branch,
+	}
+	err = r.client.ApplyStatus(r.ctx, u, opts)
+	if grpcerrors.IsUnimplemented(err) {
+		// without status subresource the status is updated together with the resource
+		err = r.client.Apply(r.ctx, u, opts)
+	}
+	if err != nil {
 		if grpcerrors.InvalidArgument(err) {
 			// this meaans the content on the resource we try to update is badly formatted
 			return result(nil, err, true), nil
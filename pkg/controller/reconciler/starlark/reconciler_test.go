@@ -0,0 +1,77 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package starlark
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+func TestExecute(t *testing.T) {
+	loop := "def reconcile(self):\n  for i in range(1000000000):\n    pass\n  return 1\n"
+	cases := map[string]struct {
+		code        string
+		maxSteps    uint64
+		timeout     time.Duration
+		expectedErr string
+	}{
+		"Success": {
+			code:     "def reconcile(self):\n  return 1\n",
+			maxSteps: 1000,
+			timeout:  time.Second,
+		},
+		"MaxSteps": {
+			code:        loop,
+			maxSteps:    1000,
+			expectedErr: "maximum of 1000 steps",
+		},
+		"Timeout": {
+			code:        loop,
+			timeout:     10 * time.Millisecond,
+			expectedErr: "timeout of 10ms",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			globals, err := starlark.ExecFileOptions(&syntax.FileOptions{}, &starlark.Thread{}, "reconciler.star", tc.code, builtins)
+			if err != nil {
+				t.Fatalf("unexpected exec error: %v", err)
+			}
+			r := &reconciler{
+				name:                "test",
+				maxSteps:            tc.maxSteps,
+				timeout:             tc.timeout,
+				startlarkReconciler: globals,
+			}
+			_, err = r.execute(context.Background(), starlark.None)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("expected error containing %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
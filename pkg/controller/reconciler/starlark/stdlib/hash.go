@@ -0,0 +1,80 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stdlib
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"hash/fnv"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// HashModule provides stable hashing of strings. The cryptographic hashes
+// return a hex encoded string, the fnv hashes return an int.
+//
+//	sha256(s) -> string
+//	sha1(s) -> string
+//	md5(s) -> string
+//	fnv32a(s) -> int
+//	fnv64a(s) -> int
+var HashModule = &starlarkstruct.Module{
+	Name: "hash",
+	Members: starlark.StringDict{
+		"sha256": hexHashBuiltin("hash.sha256", sha256.New),
+		"sha1":   hexHashBuiltin("hash.sha1", sha1.New),
+		"md5":    hexHashBuiltin("hash.md5", md5.New),
+		"fnv32a": starlark.NewBuiltin("hash.fnv32a", fnv32a),
+		"fnv64a": starlark.NewBuiltin("hash.fnv64a", fnv64a),
+	},
+}
+
+func hexHashBuiltin(name string, newHash func() hash.Hash) *starlark.Builtin {
+	return starlark.NewBuiltin(name, func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var s string
+		if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &s); err != nil {
+			return nil, err
+		}
+		h := newHash()
+		h.Write([]byte(s))
+		return starlark.String(hex.EncodeToString(h.Sum(nil))), nil
+	})
+}
+
+func fnv32a(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var s string
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &s); err != nil {
+		return nil, err
+	}
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return starlark.MakeUint(uint(h.Sum32())), nil
+}
+
+func fnv64a(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var s string
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &s); err != nil {
+		return nil, err
+	}
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return starlark.MakeUint64(h.Sum64()), nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stdlib
+
+import (
+	"fmt"
+	"regexp"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// REModule provides regular expressions using the RE2 syntax of the go regexp package.
+//
+//	match(pattern, s) -> bool
+//	find(pattern, s) -> string or None
+//	find_all(pattern, s, n=-1) -> list of strings
+//	find_submatch(pattern, s) -> list of strings or None
+//	replace(pattern, s, repl) -> string, repl supports $1 style expansion
+//	split(pattern, s, n=-1) -> list of strings
+var REModule = &starlarkstruct.Module{
+	Name: "re",
+	Members: starlark.StringDict{
+		"match":         starlark.NewBuiltin("re.match", reMatch),
+		"find":          starlark.NewBuiltin("re.find", reFind),
+		"find_all":      starlark.NewBuiltin("re.find_all", reFindAll),
+		"find_submatch": starlark.NewBuiltin("re.find_submatch", reFindSubmatch),
+		"replace":       starlark.NewBuiltin("re.replace", reReplace),
+		"split":         starlark.NewBuiltin("re.split", reSplit),
+	},
+}
+
+func compile(b *starlark.Builtin, pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid pattern %q: %v", b.Name(), pattern, err)
+	}
+	return re, nil
+}
+
+func toList(items []string) *starlark.List {
+	elems := make([]starlark.Value, 0, len(items))
+	for _, item := range items {
+		elems = append(elems, starlark.String(item))
+	}
+	return starlark.NewList(elems)
+}
+
+func reMatch(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var pattern, s string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "pattern", &pattern, "s", &s); err != nil {
+		return nil, err
+	}
+	re, err := compile(b, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.Bool(re.MatchString(s)), nil
+}
+
+func reFind(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var pattern, s string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "pattern", &pattern, "s", &s); err != nil {
+		return nil, err
+	}
+	re, err := compile(b, pattern)
+	if err != nil {
+		return nil, err
+	}
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return starlark.None, nil
+	}
+	return starlark.String(s[loc[0]:loc[1]]), nil
+}
+
+func reFindAll(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var pattern, s string
+	n := -1
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "pattern", &pattern, "s", &s, "n?", &n); err != nil {
+		return nil, err
+	}
+	re, err := compile(b, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return toList(re.FindAllString(s, n)), nil
+}
+
+func reFindSubmatch(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var pattern, s string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "pattern", &pattern, "s", &s); err != nil {
+		return nil, err
+	}
+	re, err := compile(b, pattern)
+	if err != nil {
+		return nil, err
+	}
+	matches := re.FindStringSubmatch(s)
+	if matches == nil {
+		return starlark.None, nil
+	}
+	return toList(matches), nil
+}
+
+func reReplace(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var pattern, s, repl string
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "pattern", &pattern, "s", &s, "repl", &repl); err != nil {
+		return nil, err
+	}
+	re, err := compile(b, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.String(re.ReplaceAllString(s, repl)), nil
+}
+
+func reSplit(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var pattern, s string
+	n := -1
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "pattern", &pattern, "s", &s, "n?", &n); err != nil {
+		return nil, err
+	}
+	re, err := compile(b, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return toList(re.Split(s, n)), nil
+}
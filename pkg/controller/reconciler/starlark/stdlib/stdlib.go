@@ -0,0 +1,44 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stdlib
+
+import (
+	"go.starlark.net/lib/json"
+	"go.starlark.net/lib/time"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// Modules contains the standard library modules that can be loaded by
+// reconcilers and libraries, e.g. load("json", "json")
+var Modules = map[string]*starlarkstruct.Module{
+	"json":    json.Module,
+	"yaml":    YAMLModule,
+	"re":      REModule,
+	"time":    time.Module,
+	"hash":    HashModule,
+	"strings": StringsModule,
+}
+
+// Load returns the globals of the standard library module with the given name
+func Load(module string) (starlark.StringDict, bool) {
+	m, ok := Modules[module]
+	if !ok {
+		return nil, false
+	}
+	return starlark.StringDict{m.Name: m}, true
+}
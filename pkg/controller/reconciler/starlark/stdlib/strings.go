@@ -0,0 +1,206 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stdlib
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// StringsModule provides string formatting, padding, joining and case helpers.
+// format uses the verbs of the go fmt package, e.g. format("%s-%03d", "eth", 7).
+//
+//	format(format, *args) -> string
+//	pad_left(s, width, char=" ") -> string
+//	pad_right(s, width, char=" ") -> string
+//	join(sep, items) -> string, items are converted with str()
+//	upper(s) -> string
+//	lower(s) -> string
+//	title(s) -> string
+//	snake_case(s) -> string
+//	kebab_case(s) -> string
+//	camel_case(s) -> string
+var StringsModule = &starlarkstruct.Module{
+	Name: "strings",
+	Members: starlark.StringDict{
+		"format":     starlark.NewBuiltin("strings.format", stringsFormat),
+		"pad_left":   starlark.NewBuiltin("strings.pad_left", stringsPadLeft),
+		"pad_right":  starlark.NewBuiltin("strings.pad_right", stringsPadRight),
+		"join":       starlark.NewBuiltin("strings.join", stringsJoin),
+		"upper":      stringBuiltin("strings.upper", strings.ToUpper),
+		"lower":      stringBuiltin("strings.lower", strings.ToLower),
+		"title":      stringBuiltin("strings.title", toTitle),
+		"snake_case": stringBuiltin("strings.snake_case", func(s string) string { return strings.Join(splitWords(s), "_") }),
+		"kebab_case": stringBuiltin("strings.kebab_case", func(s string) string { return strings.Join(splitWords(s), "-") }),
+		"camel_case": stringBuiltin("strings.camel_case", toCamel),
+	},
+}
+
+func stringBuiltin(name string, fn func(string) string) *starlark.Builtin {
+	return starlark.NewBuiltin(name, func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var s string
+		if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &s); err != nil {
+			return nil, err
+		}
+		return starlark.String(fn(s)), nil
+	})
+}
+
+// toGo converts the starlark scalars to their go equivalent so the fmt verbs
+// behave as expected, other values are formatted with their starlark string.
+func toGo(v starlark.Value) any {
+	switch v := v.(type) {
+	case starlark.String:
+		return string(v)
+	case starlark.Bool:
+		return bool(v)
+	case starlark.Float:
+		return float64(v)
+	case starlark.Int:
+		if i, ok := v.Int64(); ok {
+			return i
+		}
+		return v.BigInt()
+	case starlark.NoneType:
+		return nil
+	default:
+		return v.String()
+	}
+}
+
+func stringsFormat(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if len(kwargs) > 0 {
+		return nil, fmt.Errorf("%s: unexpected keyword arguments", b.Name())
+	}
+	if len(args) < 1 {
+		return nil, fmt.Errorf("%s: missing argument for format", b.Name())
+	}
+	format, ok := starlark.AsString(args[0])
+	if !ok {
+		return nil, fmt.Errorf("%s: for parameter format: got %s, want string", b.Name(), args[0].Type())
+	}
+	values := make([]any, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		values = append(values, toGo(arg))
+	}
+	return starlark.String(fmt.Sprintf(format, values...)), nil
+}
+
+func pad(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple, left bool) (starlark.Value, error) {
+	var s string
+	var width int
+	char := " "
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "s", &s, "width", &width, "char?", &char); err != nil {
+		return nil, err
+	}
+	if len([]rune(char)) != 1 {
+		return nil, fmt.Errorf("%s: char must be a single character, got %q", b.Name(), char)
+	}
+	n := width - len([]rune(s))
+	if n <= 0 {
+		return starlark.String(s), nil
+	}
+	if left {
+		return starlark.String(strings.Repeat(char, n) + s), nil
+	}
+	return starlark.String(s + strings.Repeat(char, n)), nil
+}
+
+func stringsPadLeft(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	return pad(b, args, kwargs, true)
+}
+
+func stringsPadRight(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	return pad(b, args, kwargs, false)
+}
+
+func stringsJoin(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var sep string
+	var items starlark.Iterable
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "sep", &sep, "items", &items); err != nil {
+		return nil, err
+	}
+	var elems []string
+	iter := items.Iterate()
+	defer iter.Done()
+	var x starlark.Value
+	for iter.Next(&x) {
+		if s, ok := starlark.AsString(x); ok {
+			elems = append(elems, s)
+			continue
+		}
+		elems = append(elems, x.String())
+	}
+	return starlark.String(strings.Join(elems, sep)), nil
+}
+
+// splitWords splits s in lower case words on non alphanumeric characters and
+// on lower to upper case transitions, e.g. "ipAddress-v4" -> [ip address v4]
+func splitWords(s string) []string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	var prev rune
+	for _, r := range s {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			flush()
+			word = append(word, r)
+		default:
+			word = append(word, r)
+		}
+		prev = r
+	}
+	flush()
+	return words
+}
+
+func capitalize(s string) string {
+	r := []rune(s)
+	if len(r) == 0 {
+		return s
+	}
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func toTitle(s string) string {
+	fields := strings.Fields(s)
+	for i, f := range fields {
+		fields[i] = capitalize(strings.ToLower(f))
+	}
+	return strings.Join(fields, " ")
+}
+
+func toCamel(s string) string {
+	words := splitWords(s)
+	for i := 1; i < len(words); i++ {
+		words[i] = capitalize(words[i])
+	}
+	return strings.Join(words, "")
+}
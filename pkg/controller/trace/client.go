@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	VerbGet          = "get"
+	VerbList         = "list"
+	VerbApply        = "apply"
+	VerbApplyStatus  = "applyStatus"
+	VerbCreate       = "create"
+	VerbUpdate       = "update"
+	VerbUpdateStatus = "updateStatus"
+	VerbDelete       = "delete"
+)
+
+// NewClient returns a client that records its calls in the recorder of the context of the call
+func NewClient(c resourceclient.Client) resourceclient.Client {
+	return &client{Client: c}
+}
+
+type client struct {
+	resourceclient.Client
+}
+
+func (r *client) Get(ctx context.Context, key types.NamespacedName, u runtime.Unstructured, opts ...resourceclient.GetOption) error {
+	err := r.Client.Get(ctx, key, u, opts...)
+	FromContext(ctx).record(VerbGet, u.GetObjectKind().GroupVersionKind(), key, err)
+	return err
+}
+
+func (r *client) List(ctx context.Context, ul runtime.Unstructured, opts ...resourceclient.ListOption) error {
+	gvk := ul.GetObjectKind().GroupVersionKind()
+	err := r.Client.List(ctx, ul, opts...)
+	FromContext(ctx).record(VerbList, gvk, types.NamespacedName{}, err)
+	return err
+}
+
+func (r *client) Apply(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.ApplyOption) error {
+	err := r.Client.Apply(ctx, u, opts...)
+	FromContext(ctx).record(VerbApply, u.GetObjectKind().GroupVersionKind(), keyOf(u), err)
+	return err
+}
+
+func (r *client) ApplyStatus(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.ApplyOption) error {
+	err := r.Client.ApplyStatus(ctx, u, opts...)
+	FromContext(ctx).record(VerbApplyStatus, u.GetObjectKind().GroupVersionKind(), keyOf(u), err)
+	return err
+}
+
+func (r *client) Create(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.CreateOption) error {
+	err := r.Client.Create(ctx, u, opts...)
+	FromContext(ctx).record(VerbCreate, u.GetObjectKind().GroupVersionKind(), keyOf(u), err)
+	return err
+}
+
+func (r *client) Update(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.UpdateOption) error {
+	err := r.Client.Update(ctx, u, opts...)
+	FromContext(ctx).record(VerbUpdate, u.GetObjectKind().GroupVersionKind(), keyOf(u), err)
+	return err
+}
+
+func (r *client) UpdateStatus(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.UpdateOption) error {
+	err := r.Client.UpdateStatus(ctx, u, opts...)
+	FromContext(ctx).record(VerbUpdateStatus, u.GetObjectKind().GroupVersionKind(), keyOf(u), err)
+	return err
+}
+
+func (r *client) Delete(ctx context.Context, u runtime.Unstructured, opts ...resourceclient.DeleteOption) error {
+	err := r.Client.Delete(ctx, u, opts...)
+	FromContext(ctx).record(VerbDelete, u.GetObjectKind().GroupVersionKind(), keyOf(u), err)
+	return err
+}
+
+func keyOf(u runtime.Unstructured) types.NamespacedName {
+	obj := &unstructured.Unstructured{Object: u.UnstructuredContent()}
+	return types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"github.com/kform-dev/choreo/pkg/util/object"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// maxTriggers is the amount of triggers that are kept per queued request
+const maxTriggers = 16
+
+// Triggers records the events that queued a request until the request is reconciled.
+// A nil Triggers records nothing, which disables tracing.
+type Triggers struct {
+	m        sync.Mutex
+	triggers map[types.NamespacedName][]*runnerpb.Trigger
+}
+
+func NewTriggers() *Triggers {
+	return &Triggers{
+		triggers: map[types.NamespacedName][]*runnerpb.Trigger{},
+	}
+}
+
+// Add records a trigger of the request; src is the object of the event, nil for a requeue
+func (r *Triggers) Add(req types.NamespacedName, triggerType runnerpb.Trigger_Type, src *unstructured.Unstructured) {
+	if r == nil {
+		return
+	}
+	trigger := &runnerpb.Trigger{Type: triggerType}
+	if src != nil {
+		trigger.Source = ResourceOf(src.GroupVersionKind(), types.NamespacedName{Namespace: src.GetNamespace(), Name: src.GetName()})
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	triggers := append(r.triggers[req], trigger)
+	if len(triggers) > maxTriggers {
+		triggers = triggers[len(triggers)-maxTriggers:]
+	}
+	r.triggers[req] = triggers
+}
+
+// Pop returns and removes the triggers of the request
+func (r *Triggers) Pop(req types.NamespacedName) []*runnerpb.Trigger {
+	if r == nil {
+		return nil
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	triggers := r.triggers[req]
+	delete(r.triggers, req)
+	return triggers
+}
+
+type recorderKey struct{}
+
+// Recorder records the client calls of a single reconcile
+type Recorder struct {
+	m     sync.Mutex
+	calls []*runnerpb.ClientCall
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		calls: []*runnerpb.ClientCall{},
+	}
+}
+
+// IntoContext returns a context that records the client calls of the traced client in the recorder
+func IntoContext(ctx context.Context, recorder *Recorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, recorder)
+}
+
+// FromContext returns the recorder of the context, nil when the reconcile is not traced
+func FromContext(ctx context.Context) *Recorder {
+	recorder, _ := ctx.Value(recorderKey{}).(*Recorder)
+	return recorder
+}
+
+func (r *Recorder) record(verb string, gvk schema.GroupVersionKind, key types.NamespacedName, err error) {
+	if r == nil {
+		return
+	}
+	call := &runnerpb.ClientCall{
+		Verb:       verb,
+		ApiVersion: gvk.GroupVersion().Identifier(),
+		Resource:   ResourceOf(gvk, key),
+	}
+	if err != nil {
+		call.Error = err.Error()
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+// Trace returns the trace of the reconcile of the for resource; the applied resources
+// other than the for resource are reported as children, the deleted resources as pruned
+func (r *Recorder) Trace(forGVK schema.GroupVersionKind, req types.NamespacedName, triggers []*runnerpb.Trigger, duration time.Duration) *runnerpb.ReconcileTrace {
+	trace := &runnerpb.ReconcileTrace{
+		Triggers: triggers,
+		Duration: durationpb.New(duration),
+		Calls:    []*runnerpb.ClientCall{},
+		Children: []*runnerpb.Resource{},
+		Pruned:   []*runnerpb.Resource{},
+	}
+	if r == nil {
+		return trace
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	forResource := ResourceOf(forGVK, req)
+	children := sets.New[string]()
+	pruned := sets.New[string]()
+	for _, call := range r.calls {
+		trace.Calls = append(trace.Calls, call)
+		if call.Error != "" || sameResource(call.Resource, forResource) {
+			continue
+		}
+		id := strings.Join([]string{call.ApiVersion, call.Resource.Kind, call.Resource.Namespace, call.Resource.Name}, "/")
+		switch call.Verb {
+		case VerbApply, VerbCreate, VerbUpdate:
+			if !children.Has(id) {
+				children.Insert(id)
+				trace.Children = append(trace.Children, call.Resource)
+			}
+		case VerbDelete:
+			if !pruned.Has(id) {
+				pruned.Insert(id)
+				trace.Pruned = append(trace.Pruned, call.Resource)
+			}
+		}
+	}
+	return trace
+}
+
+// ConditionOf returns the condition of the given type of the object
+func ConditionOf(obj map[string]any, conditionType string) *runnerpb.Condition {
+	condition := object.GetCondition(obj, conditionType)
+	getString := func(key string) string {
+		v, _ := condition[key].(string)
+		return v
+	}
+	return &runnerpb.Condition{
+		Type:    conditionType,
+		Status:  getString("status"),
+		Reason:  getString("reason"),
+		Message: getString("message"),
+	}
+}
+
+func ResourceOf(gvk schema.GroupVersionKind, key types.NamespacedName) *runnerpb.Resource {
+	return &runnerpb.Resource{
+		Group:     gvk.Group,
+		Kind:      gvk.Kind,
+		Namespace: key.Namespace,
+		Name:      key.Name,
+	}
+}
+
+func sameResource(a, b *runnerpb.Resource) bool {
+	return a.Group == b.Group && a.Kind == b.Kind && a.Namespace == b.Namespace && a.Name == b.Name
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type call struct {
+	verb string
+	gvk  schema.GroupVersionKind
+	name string
+	err  error
+}
+
+func TestRecorderTrace(t *testing.T) {
+	forGVK := schema.GroupVersionKind{Group: "example.com", Version: "v1alpha1", Kind: "Parent"}
+	childGVK := schema.GroupVersionKind{Group: "example.com", Version: "v1alpha1", Kind: "Child"}
+	req := types.NamespacedName{Namespace: "default", Name: "a"}
+
+	cases := map[string]struct {
+		calls          []call
+		expectChildren []*runnerpb.Resource
+		expectPruned   []*runnerpb.Resource
+	}{
+		"NoCalls": {
+			expectChildren: []*runnerpb.Resource{},
+			expectPruned:   []*runnerpb.Resource{},
+		},
+		"ForResourceIsNoChild": {
+			calls: []call{
+				{verb: VerbGet, gvk: forGVK, name: "a"},
+				{verb: VerbApply, gvk: forGVK, name: "a"},
+				{verb: VerbApplyStatus, gvk: forGVK, name: "a"},
+			},
+			expectChildren: []*runnerpb.Resource{},
+			expectPruned:   []*runnerpb.Resource{},
+		},
+		"ChildrenAndPruned": {
+			calls: []call{
+				{verb: VerbGet, gvk: forGVK, name: "a"},
+				{verb: VerbList, gvk: childGVK},
+				{verb: VerbApply, gvk: childGVK, name: "c1"},
+				{verb: VerbApply, gvk: childGVK, name: "c1"},
+				{verb: VerbApply, gvk: childGVK, name: "c2", err: errors.New("failed")},
+				{verb: VerbDelete, gvk: childGVK, name: "c3"},
+				{verb: VerbApply, gvk: forGVK, name: "a"},
+			},
+			expectChildren: []*runnerpb.Resource{
+				{Group: "example.com", Kind: "Child", Namespace: "default", Name: "c1"},
+			},
+			expectPruned: []*runnerpb.Resource{
+				{Group: "example.com", Kind: "Child", Namespace: "default", Name: "c3"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			recorder := NewRecorder()
+			ctx := IntoContext(context.Background(), recorder)
+			for _, c := range tc.calls {
+				FromContext(ctx).record(c.verb, c.gvk, types.NamespacedName{Namespace: req.Namespace, Name: c.name}, c.err)
+			}
+			trace := recorder.Trace(forGVK, req, nil, time.Second)
+			if len(trace.Calls) != len(tc.calls) {
+				t.Errorf("expected %d calls, got %d", len(tc.calls), len(trace.Calls))
+			}
+			if diff := cmp.Diff(tc.expectChildren, trace.Children, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected children (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectPruned, trace.Pruned, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected pruned (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTriggers(t *testing.T) {
+	req := types.NamespacedName{Namespace: "default", Name: "a"}
+
+	var disabled *Triggers
+	disabled.Add(req, runnerpb.Trigger_FOR, nil)
+	if triggers := disabled.Pop(req); triggers != nil {
+		t.Errorf("expected no triggers when tracing is disabled, got %v", triggers)
+	}
+
+	triggers := NewTriggers()
+	for i := 0; i < maxTriggers+2; i++ {
+		triggers.Add(req, runnerpb.Trigger_REQUEUE, nil)
+	}
+	if got := len(triggers.Pop(req)); got != maxTriggers {
+		t.Errorf("expected %d triggers, got %d", maxTriggers, got)
+	}
+	if got := triggers.Pop(req); len(got) != 0 {
+		t.Errorf("expected the triggers to be removed, got %v", got)
+	}
+}
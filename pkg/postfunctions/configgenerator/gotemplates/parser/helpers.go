@@ -1,9 +1,9 @@
 package parser
 
 import (
-	"html/template"
+	"github.com/kform-dev/choreo/pkg/util/templatehelpers"
 )
 
 // TemplateHelperFunctions specifies a set of functions that are supplied as
 // helpers to the templates that are used within this file.
-var templateHelperFunctions = template.FuncMap{}
+var templateHelperFunctions = templatehelpers.FuncMap()
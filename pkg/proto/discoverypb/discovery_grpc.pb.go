@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion7
 type DiscoveryClient interface {
 	Get(ctx context.Context, in *Get_Request, opts ...grpc.CallOption) (*Get_Response, error)
 	Watch(ctx context.Context, in *Watch_Request, opts ...grpc.CallOption) (Discovery_WatchClient, error)
+	Schema(ctx context.Context, in *Schema_Request, opts ...grpc.CallOption) (*Schema_Response, error)
 }
 
 type discoveryClient struct {
@@ -75,12 +76,22 @@ func (x *discoveryWatchClient) Recv() (*Watch_Response, error) {
 	return m, nil
 }
 
+func (c *discoveryClient) Schema(ctx context.Context, in *Schema_Request, opts ...grpc.CallOption) (*Schema_Response, error) {
+	out := new(Schema_Response)
+	err := c.cc.Invoke(ctx, "/discoverypb.Discovery/Schema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscoveryServer is the server API for Discovery service.
 // All implementations must embed UnimplementedDiscoveryServer
 // for forward compatibility
 type DiscoveryServer interface {
 	Get(context.Context, *Get_Request) (*Get_Response, error)
 	Watch(*Watch_Request, Discovery_WatchServer) error
+	Schema(context.Context, *Schema_Request) (*Schema_Response, error)
 	mustEmbedUnimplementedDiscoveryServer()
 }
 
@@ -94,6 +105,9 @@ func (UnimplementedDiscoveryServer) Get(context.Context, *Get_Request) (*Get_Res
 func (UnimplementedDiscoveryServer) Watch(*Watch_Request, Discovery_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
+func (UnimplementedDiscoveryServer) Schema(context.Context, *Schema_Request) (*Schema_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schema not implemented")
+}
 func (UnimplementedDiscoveryServer) mustEmbedUnimplementedDiscoveryServer() {}
 
 // UnsafeDiscoveryServer may be embedded to opt out of forward compatibility for this service.
@@ -146,6 +160,24 @@ func (x *discoveryWatchServer) Send(m *Watch_Response) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Discovery_Schema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schema_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServer).Schema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/discoverypb.Discovery/Schema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServer).Schema(ctx, req.(*Schema_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Discovery_ServiceDesc is the grpc.ServiceDesc for Discovery service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +189,10 @@ var Discovery_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _Discovery_Get_Handler,
 		},
+		{
+			MethodName: "Schema",
+			Handler:    _Discovery_Schema_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -30,3 +30,13 @@ func InvalidArgument(err error) bool {
 	}
 	return true
 }
+
+// IsUnimplemented returns true if the specified error has the Unimplemented code,
+// e.g. a status update of a resource without status subresource.
+func IsUnimplemented(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	return st.Code() == codes.Unimplemented
+}
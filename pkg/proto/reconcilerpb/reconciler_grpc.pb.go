@@ -0,0 +1,105 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.29.0
+// source: reconciler.proto
+
+package reconcilerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ReconcilerClient is the client API for Reconciler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReconcilerClient interface {
+	Reconcile(ctx context.Context, in *Reconcile_Request, opts ...grpc.CallOption) (*Reconcile_Response, error)
+}
+
+type reconcilerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReconcilerClient(cc grpc.ClientConnInterface) ReconcilerClient {
+	return &reconcilerClient{cc}
+}
+
+func (c *reconcilerClient) Reconcile(ctx context.Context, in *Reconcile_Request, opts ...grpc.CallOption) (*Reconcile_Response, error) {
+	out := new(Reconcile_Response)
+	err := c.cc.Invoke(ctx, "/reconcilerpb.Reconciler/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReconcilerServer is the server API for Reconciler service.
+// All implementations must embed UnimplementedReconcilerServer
+// for forward compatibility
+type ReconcilerServer interface {
+	Reconcile(context.Context, *Reconcile_Request) (*Reconcile_Response, error)
+	mustEmbedUnimplementedReconcilerServer()
+}
+
+// UnimplementedReconcilerServer must be embedded to have forward compatible implementations.
+type UnimplementedReconcilerServer struct {
+}
+
+func (UnimplementedReconcilerServer) Reconcile(context.Context, *Reconcile_Request) (*Reconcile_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedReconcilerServer) mustEmbedUnimplementedReconcilerServer() {}
+
+// UnsafeReconcilerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReconcilerServer will
+// result in compilation errors.
+type UnsafeReconcilerServer interface {
+	mustEmbedUnimplementedReconcilerServer()
+}
+
+func RegisterReconcilerServer(s grpc.ServiceRegistrar, srv ReconcilerServer) {
+	s.RegisterService(&Reconciler_ServiceDesc, srv)
+}
+
+func _Reconciler_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Reconcile_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconcilerServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reconcilerpb.Reconciler/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconcilerServer).Reconcile(ctx, req.(*Reconcile_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Reconciler_ServiceDesc is the grpc.ServiceDesc for Reconciler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Reconciler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reconcilerpb.Reconciler",
+	HandlerType: (*ReconcilerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reconcile",
+			Handler:    _Reconciler_Reconcile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reconciler.proto",
+}
@@ -27,6 +27,10 @@ type ResourceClient interface {
 	Create(ctx context.Context, in *Create_Request, opts ...grpc.CallOption) (*Create_Response, error)
 	Update(ctx context.Context, in *Update_Request, opts ...grpc.CallOption) (*Update_Response, error)
 	Apply(ctx context.Context, in *Apply_Request, opts ...grpc.CallOption) (*Apply_Response, error)
+	// UpdateStatus and ApplyStatus only change the status of the object, the status
+	// subresource needs to be enabled on the resource
+	UpdateStatus(ctx context.Context, in *Update_Request, opts ...grpc.CallOption) (*Update_Response, error)
+	ApplyStatus(ctx context.Context, in *Apply_Request, opts ...grpc.CallOption) (*Apply_Response, error)
 	Delete(ctx context.Context, in *Delete_Request, opts ...grpc.CallOption) (*Delete_Response, error)
 	Watch(ctx context.Context, in *Watch_Request, opts ...grpc.CallOption) (Resource_WatchClient, error)
 }
@@ -84,6 +88,24 @@ func (c *resourceClient) Apply(ctx context.Context, in *Apply_Request, opts ...g
 	return out, nil
 }
 
+func (c *resourceClient) UpdateStatus(ctx context.Context, in *Update_Request, opts ...grpc.CallOption) (*Update_Response, error) {
+	out := new(Update_Response)
+	err := c.cc.Invoke(ctx, "/resourcepb.Resource/UpdateStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceClient) ApplyStatus(ctx context.Context, in *Apply_Request, opts ...grpc.CallOption) (*Apply_Response, error) {
+	out := new(Apply_Response)
+	err := c.cc.Invoke(ctx, "/resourcepb.Resource/ApplyStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceClient) Delete(ctx context.Context, in *Delete_Request, opts ...grpc.CallOption) (*Delete_Response, error) {
 	out := new(Delete_Response)
 	err := c.cc.Invoke(ctx, "/resourcepb.Resource/Delete", in, out, opts...)
@@ -134,6 +156,10 @@ type ResourceServer interface {
 	Create(context.Context, *Create_Request) (*Create_Response, error)
 	Update(context.Context, *Update_Request) (*Update_Response, error)
 	Apply(context.Context, *Apply_Request) (*Apply_Response, error)
+	// UpdateStatus and ApplyStatus only change the status of the object, the status
+	// subresource needs to be enabled on the resource
+	UpdateStatus(context.Context, *Update_Request) (*Update_Response, error)
+	ApplyStatus(context.Context, *Apply_Request) (*Apply_Response, error)
 	Delete(context.Context, *Delete_Request) (*Delete_Response, error)
 	Watch(*Watch_Request, Resource_WatchServer) error
 	mustEmbedUnimplementedResourceServer()
@@ -158,6 +184,12 @@ func (UnimplementedResourceServer) Update(context.Context, *Update_Request) (*Up
 func (UnimplementedResourceServer) Apply(context.Context, *Apply_Request) (*Apply_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
 }
+func (UnimplementedResourceServer) UpdateStatus(context.Context, *Update_Request) (*Update_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
+}
+func (UnimplementedResourceServer) ApplyStatus(context.Context, *Apply_Request) (*Apply_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyStatus not implemented")
+}
 func (UnimplementedResourceServer) Delete(context.Context, *Delete_Request) (*Delete_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -267,6 +299,42 @@ func _Resource_Apply_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Resource_UpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Update_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServer).UpdateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resourcepb.Resource/UpdateStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServer).UpdateStatus(ctx, req.(*Update_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Resource_ApplyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Apply_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServer).ApplyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/resourcepb.Resource/ApplyStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServer).ApplyStatus(ctx, req.(*Apply_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Resource_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Delete_Request)
 	if err := dec(in); err != nil {
@@ -333,6 +401,14 @@ var Resource_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Apply",
 			Handler:    _Resource_Apply_Handler,
 		},
+		{
+			MethodName: "UpdateStatus",
+			Handler:    _Resource_UpdateStatus_Handler,
+		},
+		{
+			MethodName: "ApplyStatus",
+			Handler:    _Resource_ApplyStatus_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _Resource_Delete_Handler,
@@ -24,6 +24,7 @@ package runnerpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -99,6 +100,7 @@ const (
 	Once_RUN_RESPONSE    Once_MessageType = 3
 	Once_SDC_RESPONSE    Once_MessageType = 4
 	Once_COMPLETED       Once_MessageType = 5
+	Once_TRACE           Once_MessageType = 6
 )
 
 // Enum value maps for Once_MessageType.
@@ -109,6 +111,7 @@ var (
 		3: "RUN_RESPONSE",
 		4: "SDC_RESPONSE",
 		5: "COMPLETED",
+		6: "TRACE",
 	}
 	Once_MessageType_value = map[string]int32{
 		"PROGRESS_UPDATE": 0,
@@ -116,6 +119,7 @@ var (
 		"RUN_RESPONSE":    3,
 		"SDC_RESPONSE":    4,
 		"COMPLETED":       5,
+		"TRACE":           6,
 	}
 )
 
@@ -146,8 +150,116 @@ func (Once_MessageType) EnumDescriptor() ([]byte, []int) {
 	return file_runner_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type Once_ConvergenceIssueType int32
+
+const (
+	// CONVERGENCE_ISSUE_TYPE_UNSPECIFIED indicates the type of the issue is not set
+	Once_CONVERGENCE_ISSUE_TYPE_UNSPECIFIED Once_ConvergenceIssueType = 0
+	// OSCILLATION indicates an object keeps returning to an earlier state
+	Once_OSCILLATION Once_ConvergenceIssueType = 1
+	// MAX_ITERATIONS indicates a reconciler reconciled an object more than the max iterations
+	Once_MAX_ITERATIONS Once_ConvergenceIssueType = 2
+	// DEADLINE indicates the reconcilers did not converge before the deadline
+	Once_DEADLINE Once_ConvergenceIssueType = 3
+)
+
+// Enum value maps for Once_ConvergenceIssueType.
+var (
+	Once_ConvergenceIssueType_name = map[int32]string{
+		0: "CONVERGENCE_ISSUE_TYPE_UNSPECIFIED",
+		1: "OSCILLATION",
+		2: "MAX_ITERATIONS",
+		3: "DEADLINE",
+	}
+	Once_ConvergenceIssueType_value = map[string]int32{
+		"CONVERGENCE_ISSUE_TYPE_UNSPECIFIED": 0,
+		"OSCILLATION":                        1,
+		"MAX_ITERATIONS":                     2,
+		"DEADLINE":                           3,
+	}
+)
+
+func (x Once_ConvergenceIssueType) Enum() *Once_ConvergenceIssueType {
+	p := new(Once_ConvergenceIssueType)
+	*p = x
+	return p
+}
+
+func (x Once_ConvergenceIssueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Once_ConvergenceIssueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_runner_proto_enumTypes[2].Descriptor()
+}
+
+func (Once_ConvergenceIssueType) Type() protoreflect.EnumType {
+	return &file_runner_proto_enumTypes[2]
+}
+
+func (x Once_ConvergenceIssueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Once_ConvergenceIssueType.Descriptor instead.
+func (Once_ConvergenceIssueType) EnumDescriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{2, 1}
+}
+
+type Trigger_Type int32
+
+const (
+	Trigger_FOR     Trigger_Type = 0
+	Trigger_OWN     Trigger_Type = 1
+	Trigger_WATCH   Trigger_Type = 2
+	Trigger_REQUEUE Trigger_Type = 3
+)
+
+// Enum value maps for Trigger_Type.
+var (
+	Trigger_Type_name = map[int32]string{
+		0: "FOR",
+		1: "OWN",
+		2: "WATCH",
+		3: "REQUEUE",
+	}
+	Trigger_Type_value = map[string]int32{
+		"FOR":     0,
+		"OWN":     1,
+		"WATCH":   2,
+		"REQUEUE": 3,
+	}
+)
+
+func (x Trigger_Type) Enum() *Trigger_Type {
+	p := new(Trigger_Type)
+	*p = x
+	return p
+}
+
+func (x Trigger_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Trigger_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_runner_proto_enumTypes[3].Descriptor()
+}
+
+func (Trigger_Type) Type() protoreflect.EnumType {
+	return &file_runner_proto_enumTypes[3]
+}
+
+func (x Trigger_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Trigger_Type.Descriptor instead.
+func (Trigger_Type) EnumDescriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{8, 0}
+}
+
 // Start start choreo
-// Removed branch since we only allow checkedout -> RW, other branches are RO
+// every branch has its own runner, an empty branch selects the checked out branch
 type Start struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -186,7 +298,7 @@ func (*Start) Descriptor() ([]byte, []int) {
 	return file_runner_proto_rawDescGZIP(), []int{0}
 }
 
-// Removed branch since we only allow checkedout -> RW, other branches are RO
+// Stop stops the runner of the branch, this also cancels a run once
 type Stop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -225,7 +337,7 @@ func (*Stop) Descriptor() ([]byte, []int) {
 	return file_runner_proto_rawDescGZIP(), []int{1}
 }
 
-// Removed branch since we only allow checkedout -> RW, other branches are RO
+// Once runs the reconcilers of the branch once, an empty branch selects the checked out branch
 type Once struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -302,6 +414,84 @@ func (*Load) Descriptor() ([]byte, []int) {
 	return file_runner_proto_rawDescGZIP(), []int{3}
 }
 
+// Status returns the status of the runners per branch
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{4}
+}
+
+// Deps returns the dependency graph of the reconcilers
+type Deps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Deps) Reset() {
+	*x = Deps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deps) ProtoMessage() {}
+
+func (x *Deps) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deps.ProtoReflect.Descriptor instead.
+func (*Deps) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{5}
+}
+
 type ReconcileResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -314,12 +504,14 @@ type ReconcileResult struct {
 	Success        bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
 	Message        string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
 	Resource       *Resource              `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	// trace is only set on the result that ends a reconcile when tracing is enabled
+	Trace *ReconcileTrace `protobuf:"bytes,8,opt,name=trace,proto3" json:"trace,omitempty"`
 }
 
 func (x *ReconcileResult) Reset() {
 	*x = ReconcileResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[4]
+		mi := &file_runner_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -332,7 +524,7 @@ func (x *ReconcileResult) String() string {
 func (*ReconcileResult) ProtoMessage() {}
 
 func (x *ReconcileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[4]
+	mi := &file_runner_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +537,7 @@ func (x *ReconcileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReconcileResult.ProtoReflect.Descriptor instead.
 func (*ReconcileResult) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{4}
+	return file_runner_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ReconcileResult) GetReconcilerName() string {
@@ -397,34 +589,48 @@ func (x *ReconcileResult) GetResource() *Resource {
 	return nil
 }
 
-type Resource struct {
+func (x *ReconcileResult) GetTrace() *ReconcileTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+// ReconcileTrace records what a single reconcile did
+type ReconcileTrace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// triggers are the events that queued the reconcile
+	Triggers []*Trigger           `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Calls    []*ClientCall        `protobuf:"bytes,3,rep,name=calls,proto3" json:"calls,omitempty"`
+	// children are the resources applied by the reconcile, other than the for resource
+	Children []*Resource `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	// pruned are the resources deleted by the reconcile
+	Pruned []*Resource `protobuf:"bytes,5,rep,name=pruned,proto3" json:"pruned,omitempty"`
+	// condition is the condition of the reconciler on the for resource after the reconcile
+	Condition *Condition `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`
 }
 
-func (x *Resource) Reset() {
-	*x = Resource{}
+func (x *ReconcileTrace) Reset() {
+	*x = ReconcileTrace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[5]
+		mi := &file_runner_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Resource) String() string {
+func (x *ReconcileTrace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resource) ProtoMessage() {}
+func (*ReconcileTrace) ProtoMessage() {}
 
-func (x *Resource) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[5]
+func (x *ReconcileTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,64 +641,80 @@ func (x *Resource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
-func (*Resource) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReconcileTrace.ProtoReflect.Descriptor instead.
+func (*ReconcileTrace) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Resource) GetGroup() string {
+func (x *ReconcileTrace) GetTriggers() []*Trigger {
 	if x != nil {
-		return x.Group
+		return x.Triggers
 	}
-	return ""
+	return nil
 }
 
-func (x *Resource) GetKind() string {
+func (x *ReconcileTrace) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Kind
+		return x.Duration
 	}
-	return ""
+	return nil
 }
 
-func (x *Resource) GetName() string {
+func (x *ReconcileTrace) GetCalls() []*ClientCall {
 	if x != nil {
-		return x.Name
+		return x.Calls
 	}
-	return ""
+	return nil
 }
 
-func (x *Resource) GetNamespace() string {
+func (x *ReconcileTrace) GetChildren() []*Resource {
 	if x != nil {
-		return x.Namespace
+		return x.Children
 	}
-	return ""
+	return nil
 }
 
-type Start_Request struct {
+func (x *ReconcileTrace) GetPruned() []*Resource {
+	if x != nil {
+		return x.Pruned
+	}
+	return nil
+}
+
+func (x *ReconcileTrace) GetCondition() *Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+type Trigger struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Options *Start_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Type Trigger_Type `protobuf:"varint,1,opt,name=type,proto3,enum=runnerpb.Trigger_Type" json:"type,omitempty"`
+	// source is the object of the event, not set for a requeue
+	Source *Resource `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *Start_Request) Reset() {
-	*x = Start_Request{}
+func (x *Trigger) Reset() {
+	*x = Trigger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[6]
+		mi := &file_runner_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Start_Request) String() string {
+func (x *Trigger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Start_Request) ProtoMessage() {}
+func (*Trigger) ProtoMessage() {}
 
-func (x *Start_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[6]
+func (x *Trigger) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,41 +725,54 @@ func (x *Start_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Start_Request.ProtoReflect.Descriptor instead.
-func (*Start_Request) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Start_Request) GetOptions() *Start_Options {
+func (x *Trigger) GetType() Trigger_Type {
 	if x != nil {
-		return x.Options
+		return x.Type
+	}
+	return Trigger_FOR
+}
+
+func (x *Trigger) GetSource() *Resource {
+	if x != nil {
+		return x.Source
 	}
 	return nil
 }
 
-type Start_Response struct {
+type ClientCall struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// verb is one of get, list, apply, applyStatus, create, update, updateStatus, delete
+	Verb       string    `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	ApiVersion string    `protobuf:"bytes,2,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Resource   *Resource `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Error      string    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *Start_Response) Reset() {
-	*x = Start_Response{}
+func (x *ClientCall) Reset() {
+	*x = ClientCall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[7]
+		mi := &file_runner_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Start_Response) String() string {
+func (x *ClientCall) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Start_Response) ProtoMessage() {}
+func (*ClientCall) ProtoMessage() {}
 
-func (x *Start_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[7]
+func (x *ClientCall) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,37 +783,67 @@ func (x *Start_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Start_Response.ProtoReflect.Descriptor instead.
-func (*Start_Response) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use ClientCall.ProtoReflect.Descriptor instead.
+func (*ClientCall) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{9}
 }
 
-type Start_Options struct {
+func (x *ClientCall) GetVerb() string {
+	if x != nil {
+		return x.Verb
+	}
+	return ""
+}
+
+func (x *ClientCall) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ClientCall) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ClientCall) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Condition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProxyName      string `protobuf:"bytes,1,opt,name=proxyName,proto3" json:"proxyName,omitempty"`
-	ProxyNamespace string `protobuf:"bytes,2,opt,name=proxyNamespace,proto3" json:"proxyNamespace,omitempty"`
+	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Start_Options) Reset() {
-	*x = Start_Options{}
+func (x *Condition) Reset() {
+	*x = Condition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[8]
+		mi := &file_runner_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Start_Options) String() string {
+func (x *Condition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Start_Options) ProtoMessage() {}
+func (*Condition) ProtoMessage() {}
 
-func (x *Start_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[8]
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,37 +854,270 @@ func (x *Start_Options) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Start_Options.ProtoReflect.Descriptor instead.
-func (*Start_Options) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{0, 2}
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Start_Options) GetProxyName() string {
+func (x *Condition) GetType() string {
 	if x != nil {
-		return x.ProxyName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Start_Options) GetProxyNamespace() string {
+func (x *Condition) GetStatus() string {
 	if x != nil {
-		return x.ProxyNamespace
+		return x.Status
 	}
 	return ""
 }
 
-type Stop_Request struct {
+func (x *Condition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Condition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Resource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Options *Stop_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
-}
-
-func (x *Stop_Request) Reset() {
+	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Resource) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Resource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type Start_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *Start_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *Start_Request) Reset() {
+	*x = Start_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Start_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Start_Request) ProtoMessage() {}
+
+func (x *Start_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Start_Request.ProtoReflect.Descriptor instead.
+func (*Start_Request) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Start_Request) GetOptions() *Start_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type Start_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Start_Response) Reset() {
+	*x = Start_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Start_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Start_Response) ProtoMessage() {}
+
+func (x *Start_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Start_Response.ProtoReflect.Descriptor instead.
+func (*Start_Response) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type Start_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProxyName      string `protobuf:"bytes,1,opt,name=proxyName,proto3" json:"proxyName,omitempty"`
+	ProxyNamespace string `protobuf:"bytes,2,opt,name=proxyNamespace,proto3" json:"proxyNamespace,omitempty"`
+	Branch         string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *Start_Options) Reset() {
+	*x = Start_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Start_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Start_Options) ProtoMessage() {}
+
+func (x *Start_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Start_Options.ProtoReflect.Descriptor instead.
+func (*Start_Options) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Start_Options) GetProxyName() string {
+	if x != nil {
+		return x.ProxyName
+	}
+	return ""
+}
+
+func (x *Start_Options) GetProxyNamespace() string {
+	if x != nil {
+		return x.ProxyNamespace
+	}
+	return ""
+}
+
+func (x *Start_Options) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type Stop_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *Stop_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *Stop_Request) Reset() {
 	*x = Stop_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[9]
+		mi := &file_runner_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -632,7 +1130,7 @@ func (x *Stop_Request) String() string {
 func (*Stop_Request) ProtoMessage() {}
 
 func (x *Stop_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[9]
+	mi := &file_runner_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +1162,7 @@ type Stop_Response struct {
 func (x *Stop_Response) Reset() {
 	*x = Stop_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[10]
+		mi := &file_runner_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -677,7 +1175,7 @@ func (x *Stop_Response) String() string {
 func (*Stop_Response) ProtoMessage() {}
 
 func (x *Stop_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[10]
+	mi := &file_runner_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,12 +1198,13 @@ type Stop_Options struct {
 
 	ProxyName      string `protobuf:"bytes,1,opt,name=proxyName,proto3" json:"proxyName,omitempty"`
 	ProxyNamespace string `protobuf:"bytes,2,opt,name=proxyNamespace,proto3" json:"proxyNamespace,omitempty"`
+	Branch         string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
 }
 
 func (x *Stop_Options) Reset() {
 	*x = Stop_Options{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[11]
+		mi := &file_runner_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -718,7 +1217,7 @@ func (x *Stop_Options) String() string {
 func (*Stop_Options) ProtoMessage() {}
 
 func (x *Stop_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[11]
+	mi := &file_runner_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,6 +1247,13 @@ func (x *Stop_Options) GetProxyNamespace() string {
 	return ""
 }
 
+func (x *Stop_Options) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
 type Once_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -759,7 +1265,7 @@ type Once_Request struct {
 func (x *Once_Request) Reset() {
 	*x = Once_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[12]
+		mi := &file_runner_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -772,7 +1278,7 @@ func (x *Once_Request) String() string {
 func (*Once_Request) ProtoMessage() {}
 
 func (x *Once_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[12]
+	mi := &file_runner_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,12 +1308,23 @@ type Once_Options struct {
 
 	ProxyName      string `protobuf:"bytes,1,opt,name=proxyName,proto3" json:"proxyName,omitempty"`
 	ProxyNamespace string `protobuf:"bytes,2,opt,name=proxyNamespace,proto3" json:"proxyNamespace,omitempty"`
+	// maxIterations is the amount of times a reconciler can reconcile the same object
+	// before the run is failed as a requeue loop; 0 uses the server default
+	MaxIterations int64 `protobuf:"varint,3,opt,name=maxIterations,proto3" json:"maxIterations,omitempty"`
+	// deadline is the overall time the reconcilers get to converge; 0 uses the server default
+	Deadline *durationpb.Duration `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// trace records a trace per reconcile, the traces are streamed and stored with the snapshot
+	Trace bool `protobuf:"varint,5,opt,name=trace,proto3" json:"trace,omitempty"`
+	// incremental only reloads the input that changed since the last load and only runs
+	// the affected reconcilers, it falls back to a full run when the apis or reconcilers changed
+	Incremental bool   `protobuf:"varint,6,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	Branch      string `protobuf:"bytes,7,opt,name=branch,proto3" json:"branch,omitempty"`
 }
 
 func (x *Once_Options) Reset() {
 	*x = Once_Options{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[13]
+		mi := &file_runner_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -820,7 +1337,7 @@ func (x *Once_Options) String() string {
 func (*Once_Options) ProtoMessage() {}
 
 func (x *Once_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[13]
+	mi := &file_runner_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,25 +1367,60 @@ func (x *Once_Options) GetProxyNamespace() string {
 	return ""
 }
 
-type Once_Response struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Once_Options) GetMaxIterations() int64 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
 
-	Type Once_MessageType `protobuf:"varint,1,opt,name=Type,proto3,enum=runnerpb.Once_MessageType" json:"Type,omitempty"`
-	// Types that are assignable to Data:
-	//
-	//	*Once_Response_ProgressUpdate
-	//	*Once_Response_Error
-	//	*Once_Response_RunResponse
+func (x *Once_Options) GetDeadline() *durationpb.Duration {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Once_Options) GetTrace() bool {
+	if x != nil {
+		return x.Trace
+	}
+	return false
+}
+
+func (x *Once_Options) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *Once_Options) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type Once_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Once_MessageType `protobuf:"varint,1,opt,name=Type,proto3,enum=runnerpb.Once_MessageType" json:"Type,omitempty"`
+	// Types that are assignable to Data:
+	//	*Once_Response_ProgressUpdate
+	//	*Once_Response_Error
+	//	*Once_Response_RunResponse
 	//	*Once_Response_SdcResponse
+	//	*Once_Response_Trace
 	Data isOnce_Response_Data `protobuf_oneof:"data"`
 }
 
 func (x *Once_Response) Reset() {
 	*x = Once_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[14]
+		mi := &file_runner_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -881,7 +1433,7 @@ func (x *Once_Response) String() string {
 func (*Once_Response) ProtoMessage() {}
 
 func (x *Once_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[14]
+	mi := &file_runner_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,62 +1488,708 @@ func (x *Once_Response) GetSdcResponse() *Once_SDCResponse {
 	if x, ok := x.GetData().(*Once_Response_SdcResponse); ok {
 		return x.SdcResponse
 	}
-	return nil
+	return nil
+}
+
+func (x *Once_Response) GetTrace() *ReconcileResult {
+	if x, ok := x.GetData().(*Once_Response_Trace); ok {
+		return x.Trace
+	}
+	return nil
+}
+
+type isOnce_Response_Data interface {
+	isOnce_Response_Data()
+}
+
+type Once_Response_ProgressUpdate struct {
+	ProgressUpdate *Once_ProgressUpdate `protobuf:"bytes,2,opt,name=progressUpdate,proto3,oneof"`
+}
+
+type Once_Response_Error struct {
+	Error *Once_Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+type Once_Response_RunResponse struct {
+	RunResponse *Once_RunResponse `protobuf:"bytes,4,opt,name=runResponse,proto3,oneof"`
+}
+
+type Once_Response_SdcResponse struct {
+	SdcResponse *Once_SDCResponse `protobuf:"bytes,5,opt,name=sdcResponse,proto3,oneof"`
+}
+
+type Once_Response_Trace struct {
+	Trace *ReconcileResult `protobuf:"bytes,6,opt,name=trace,proto3,oneof"`
+}
+
+func (*Once_Response_ProgressUpdate) isOnce_Response_Data() {}
+
+func (*Once_Response_Error) isOnce_Response_Data() {}
+
+func (*Once_Response_RunResponse) isOnce_Response_Data() {}
+
+func (*Once_Response_SdcResponse) isOnce_Response_Data() {}
+
+func (*Once_Response_Trace) isOnce_Response_Data() {}
+
+type Once_ProgressUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Once_ProgressUpdate) Reset() {
+	*x = Once_ProgressUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Once_ProgressUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Once_ProgressUpdate) ProtoMessage() {}
+
+func (x *Once_ProgressUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Once_ProgressUpdate.ProtoReflect.Descriptor instead.
+func (*Once_ProgressUpdate) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Once_ProgressUpdate) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Once_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Once_Error) Reset() {
+	*x = Once_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Once_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Once_Error) ProtoMessage() {}
+
+func (x *Once_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Once_Error.ProtoReflect.Descriptor instead.
+func (*Once_Error) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Once_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Once_RunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Results []*Once_RunResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *Once_RunResponse) Reset() {
+	*x = Once_RunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Once_RunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Once_RunResponse) ProtoMessage() {}
+
+func (x *Once_RunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Once_RunResponse.ProtoReflect.Descriptor instead.
+func (*Once_RunResponse) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Once_RunResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Once_RunResponse) GetResults() []*Once_RunResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Once_RunResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReconcilerRunner  string                   `protobuf:"bytes,1,opt,name=reconcilerRunner,proto3" json:"reconcilerRunner,omitempty"`
+	Success           bool                     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	TaskId            string                   `protobuf:"bytes,3,opt,name=taskId,proto3" json:"taskId,omitempty"` // reconcilername + group + kind + namespace + name when failed
+	Message           string                   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	ExecutionTime     string                   `protobuf:"bytes,5,opt,name=executionTime,proto3" json:"executionTime,omitempty"`
+	Results           []*ReconcileResult       `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
+	ConvergenceIssues []*Once_ConvergenceIssue `protobuf:"bytes,7,rep,name=convergenceIssues,proto3" json:"convergenceIssues,omitempty"`
+}
+
+func (x *Once_RunResult) Reset() {
+	*x = Once_RunResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Once_RunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Once_RunResult) ProtoMessage() {}
+
+func (x *Once_RunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Once_RunResult.ProtoReflect.Descriptor instead.
+func (*Once_RunResult) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Once_RunResult) GetReconcilerRunner() string {
+	if x != nil {
+		return x.ReconcilerRunner
+	}
+	return ""
+}
+
+func (x *Once_RunResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Once_RunResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Once_RunResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Once_RunResult) GetExecutionTime() string {
+	if x != nil {
+		return x.ExecutionTime
+	}
+	return ""
+}
+
+func (x *Once_RunResult) GetResults() []*ReconcileResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *Once_RunResult) GetConvergenceIssues() []*Once_ConvergenceIssue {
+	if x != nil {
+		return x.ConvergenceIssues
+	}
+	return nil
+}
+
+// ConvergenceIssue reports why the reconcilers did not converge
+type Once_ConvergenceIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        Once_ConvergenceIssueType `protobuf:"varint,1,opt,name=type,proto3,enum=runnerpb.Once_ConvergenceIssueType" json:"type,omitempty"`
+	Reconcilers []string                  `protobuf:"bytes,2,rep,name=reconcilers,proto3" json:"reconcilers,omitempty"`
+	Resource    *Resource                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Fields      []string                  `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Iterations  int64                     `protobuf:"varint,5,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Message     string                    `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Once_ConvergenceIssue) Reset() {
+	*x = Once_ConvergenceIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Once_ConvergenceIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Once_ConvergenceIssue) ProtoMessage() {}
+
+func (x *Once_ConvergenceIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Once_ConvergenceIssue.ProtoReflect.Descriptor instead.
+func (*Once_ConvergenceIssue) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *Once_ConvergenceIssue) GetType() Once_ConvergenceIssueType {
+	if x != nil {
+		return x.Type
+	}
+	return Once_CONVERGENCE_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *Once_ConvergenceIssue) GetReconcilers() []string {
+	if x != nil {
+		return x.Reconcilers
+	}
+	return nil
+}
+
+func (x *Once_ConvergenceIssue) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *Once_ConvergenceIssue) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Once_ConvergenceIssue) GetIterations() int64 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *Once_ConvergenceIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Once_SDCResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Once_SDCResponse) Reset() {
+	*x = Once_SDCResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Once_SDCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Once_SDCResponse) ProtoMessage() {}
+
+func (x *Once_SDCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Once_SDCResponse.ProtoReflect.Descriptor instead.
+func (*Once_SDCResponse) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{2, 8}
+}
+
+func (x *Once_SDCResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Load_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *Load_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *Load_Request) Reset() {
+	*x = Load_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Load_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Load_Request) ProtoMessage() {}
+
+func (x *Load_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Load_Request.ProtoReflect.Descriptor instead.
+func (*Load_Request) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Load_Request) GetOptions() *Load_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type Load_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Object []byte `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *Load_Response) Reset() {
+	*x = Load_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Load_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Load_Response) ProtoMessage() {}
+
+func (x *Load_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Load_Response.ProtoReflect.Descriptor instead.
+func (*Load_Response) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Load_Response) GetObject() []byte {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+type Load_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProxyName      string `protobuf:"bytes,1,opt,name=proxyName,proto3" json:"proxyName,omitempty"`
+	ProxyNamespace string `protobuf:"bytes,2,opt,name=proxyNamespace,proto3" json:"proxyNamespace,omitempty"`
+	Branch         string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *Load_Options) Reset() {
+	*x = Load_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Load_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Load_Options) ProtoMessage() {}
+
+func (x *Load_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Load_Options.ProtoReflect.Descriptor instead.
+func (*Load_Options) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Load_Options) GetProxyName() string {
+	if x != nil {
+		return x.ProxyName
+	}
+	return ""
+}
+
+func (x *Load_Options) GetProxyNamespace() string {
+	if x != nil {
+		return x.ProxyNamespace
+	}
+	return ""
+}
+
+func (x *Load_Options) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type Status_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *Status_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *Status_Request) Reset() {
+	*x = Status_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status_Request) ProtoMessage() {}
+
+func (x *Status_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type isOnce_Response_Data interface {
-	isOnce_Response_Data()
+// Deprecated: Use Status_Request.ProtoReflect.Descriptor instead.
+func (*Status_Request) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{4, 0}
 }
 
-type Once_Response_ProgressUpdate struct {
-	ProgressUpdate *Once_ProgressUpdate `protobuf:"bytes,2,opt,name=progressUpdate,proto3,oneof"`
+func (x *Status_Request) GetOptions() *Status_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
 }
 
-type Once_Response_Error struct {
-	Error *Once_Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+type Status_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branches []*Status_BranchStatus `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
 }
 
-type Once_Response_RunResponse struct {
-	RunResponse *Once_RunResponse `protobuf:"bytes,4,opt,name=runResponse,proto3,oneof"`
+func (x *Status_Response) Reset() {
+	*x = Status_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Once_Response_SdcResponse struct {
-	SdcResponse *Once_SDCResponse `protobuf:"bytes,5,opt,name=sdcResponse,proto3,oneof"`
+func (x *Status_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Once_Response_ProgressUpdate) isOnce_Response_Data() {}
+func (*Status_Response) ProtoMessage() {}
 
-func (*Once_Response_Error) isOnce_Response_Data() {}
+func (x *Status_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*Once_Response_RunResponse) isOnce_Response_Data() {}
+// Deprecated: Use Status_Response.ProtoReflect.Descriptor instead.
+func (*Status_Response) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{4, 1}
+}
 
-func (*Once_Response_SdcResponse) isOnce_Response_Data() {}
+func (x *Status_Response) GetBranches() []*Status_BranchStatus {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
 
-type Once_ProgressUpdate struct {
+type Status_Options struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ProxyName      string `protobuf:"bytes,1,opt,name=proxyName,proto3" json:"proxyName,omitempty"`
+	ProxyNamespace string `protobuf:"bytes,2,opt,name=proxyNamespace,proto3" json:"proxyNamespace,omitempty"`
+	// branch selects the runner of a single branch, empty returns the runners of all branches
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
 }
 
-func (x *Once_ProgressUpdate) Reset() {
-	*x = Once_ProgressUpdate{}
+func (x *Status_Options) Reset() {
+	*x = Status_Options{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[15]
+		mi := &file_runner_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Once_ProgressUpdate) String() string {
+func (x *Status_Options) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Once_ProgressUpdate) ProtoMessage() {}
+func (*Status_Options) ProtoMessage() {}
 
-func (x *Once_ProgressUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[15]
+func (x *Status_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,43 +2200,60 @@ func (x *Once_ProgressUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Once_ProgressUpdate.ProtoReflect.Descriptor instead.
-func (*Once_ProgressUpdate) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{2, 3}
+// Deprecated: Use Status_Options.ProtoReflect.Descriptor instead.
+func (*Status_Options) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{4, 2}
 }
 
-func (x *Once_ProgressUpdate) GetMessage() string {
+func (x *Status_Options) GetProxyName() string {
 	if x != nil {
-		return x.Message
+		return x.ProxyName
 	}
 	return ""
 }
 
-type Once_Error struct {
+func (x *Status_Options) GetProxyNamespace() string {
+	if x != nil {
+		return x.ProxyNamespace
+	}
+	return ""
+}
+
+func (x *Status_Options) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type Status_BranchStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Branch     string `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	CheckedOut bool   `protobuf:"varint,2,opt,name=checkedOut,proto3" json:"checkedOut,omitempty"`
+	// status of the runner: Stopped, Running or Once
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *Once_Error) Reset() {
-	*x = Once_Error{}
+func (x *Status_BranchStatus) Reset() {
+	*x = Status_BranchStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[16]
+		mi := &file_runner_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Once_Error) String() string {
+func (x *Status_BranchStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Once_Error) ProtoMessage() {}
+func (*Status_BranchStatus) ProtoMessage() {}
 
-func (x *Once_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[16]
+func (x *Status_BranchStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,44 +2264,57 @@ func (x *Once_Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Once_Error.ProtoReflect.Descriptor instead.
-func (*Once_Error) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{2, 4}
+// Deprecated: Use Status_BranchStatus.ProtoReflect.Descriptor instead.
+func (*Status_BranchStatus) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{4, 3}
 }
 
-func (x *Once_Error) GetMessage() string {
+func (x *Status_BranchStatus) GetBranch() string {
 	if x != nil {
-		return x.Message
+		return x.Branch
 	}
 	return ""
 }
 
-type Once_RunResponse struct {
+func (x *Status_BranchStatus) GetCheckedOut() bool {
+	if x != nil {
+		return x.CheckedOut
+	}
+	return false
+}
+
+func (x *Status_BranchStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Deps_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Results []*Once_RunResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Options *Deps_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *Once_RunResponse) Reset() {
-	*x = Once_RunResponse{}
+func (x *Deps_Request) Reset() {
+	*x = Deps_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[17]
+		mi := &file_runner_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Once_RunResponse) String() string {
+func (x *Deps_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Once_RunResponse) ProtoMessage() {}
+func (*Deps_Request) ProtoMessage() {}
 
-func (x *Once_RunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[17]
+func (x *Deps_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,55 +2325,44 @@ func (x *Once_RunResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Once_RunResponse.ProtoReflect.Descriptor instead.
-func (*Once_RunResponse) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{2, 5}
-}
-
-func (x *Once_RunResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use Deps_Request.ProtoReflect.Descriptor instead.
+func (*Deps_Request) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *Once_RunResponse) GetResults() []*Once_RunResult {
+func (x *Deps_Request) GetOptions() *Deps_Options {
 	if x != nil {
-		return x.Results
+		return x.Options
 	}
 	return nil
 }
 
-type Once_RunResult struct {
+type Deps_Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReconcilerRunner string             `protobuf:"bytes,1,opt,name=reconcilerRunner,proto3" json:"reconcilerRunner,omitempty"`
-	Success          bool               `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	TaskId           string             `protobuf:"bytes,3,opt,name=taskId,proto3" json:"taskId,omitempty"` // reconcilername + group + kind + namespace + name when failed
-	Message          string             `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	ExecutionTime    string             `protobuf:"bytes,5,opt,name=executionTime,proto3" json:"executionTime,omitempty"`
-	Results          []*ReconcileResult `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
+	// a graph per reconciler runner (upstream refs and root)
+	Graphs []*Deps_Graph `protobuf:"bytes,1,rep,name=graphs,proto3" json:"graphs,omitempty"`
 }
 
-func (x *Once_RunResult) Reset() {
-	*x = Once_RunResult{}
+func (x *Deps_Response) Reset() {
+	*x = Deps_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[18]
+		mi := &file_runner_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Once_RunResult) String() string {
+func (x *Deps_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Once_RunResult) ProtoMessage() {}
+func (*Deps_Response) ProtoMessage() {}
 
-func (x *Once_RunResult) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[18]
+func (x *Deps_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1156,78 +2373,108 @@ func (x *Once_RunResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Once_RunResult.ProtoReflect.Descriptor instead.
-func (*Once_RunResult) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{2, 6}
+// Deprecated: Use Deps_Response.ProtoReflect.Descriptor instead.
+func (*Deps_Response) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (x *Once_RunResult) GetReconcilerRunner() string {
+func (x *Deps_Response) GetGraphs() []*Deps_Graph {
 	if x != nil {
-		return x.ReconcilerRunner
+		return x.Graphs
 	}
-	return ""
+	return nil
 }
 
-func (x *Once_RunResult) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+type Deps_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProxyName      string `protobuf:"bytes,1,opt,name=proxyName,proto3" json:"proxyName,omitempty"`
+	ProxyNamespace string `protobuf:"bytes,2,opt,name=proxyNamespace,proto3" json:"proxyNamespace,omitempty"`
+	Branch         string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *Deps_Options) Reset() {
+	*x = Deps_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_runner_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *Once_RunResult) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
+func (x *Deps_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deps_Options) ProtoMessage() {}
+
+func (x *Deps_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Once_RunResult) GetMessage() string {
+// Deprecated: Use Deps_Options.ProtoReflect.Descriptor instead.
+func (*Deps_Options) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *Deps_Options) GetProxyName() string {
 	if x != nil {
-		return x.Message
+		return x.ProxyName
 	}
 	return ""
 }
 
-func (x *Once_RunResult) GetExecutionTime() string {
+func (x *Deps_Options) GetProxyNamespace() string {
 	if x != nil {
-		return x.ExecutionTime
+		return x.ProxyNamespace
 	}
 	return ""
 }
 
-func (x *Once_RunResult) GetResults() []*ReconcileResult {
+func (x *Deps_Options) GetBranch() string {
 	if x != nil {
-		return x.Results
+		return x.Branch
 	}
-	return nil
+	return ""
 }
 
-type Once_SDCResponse struct {
+type Deps_Graph struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ReconcilerRunner string             `protobuf:"bytes,1,opt,name=reconcilerRunner,proto3" json:"reconcilerRunner,omitempty"`
+	Reconcilers      []*Deps_Reconciler `protobuf:"bytes,2,rep,name=reconcilers,proto3" json:"reconcilers,omitempty"`
+	Cycles           []*Deps_Cycle      `protobuf:"bytes,3,rep,name=cycles,proto3" json:"cycles,omitempty"`
 }
 
-func (x *Once_SDCResponse) Reset() {
-	*x = Once_SDCResponse{}
+func (x *Deps_Graph) Reset() {
+	*x = Deps_Graph{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[19]
+		mi := &file_runner_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Once_SDCResponse) String() string {
+func (x *Deps_Graph) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Once_SDCResponse) ProtoMessage() {}
+func (*Deps_Graph) ProtoMessage() {}
 
-func (x *Once_SDCResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[19]
+func (x *Deps_Graph) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,43 +2485,62 @@ func (x *Once_SDCResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Once_SDCResponse.ProtoReflect.Descriptor instead.
-func (*Once_SDCResponse) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{2, 7}
+// Deprecated: Use Deps_Graph.ProtoReflect.Descriptor instead.
+func (*Deps_Graph) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{5, 3}
 }
 
-func (x *Once_SDCResponse) GetMessage() string {
+func (x *Deps_Graph) GetReconcilerRunner() string {
 	if x != nil {
-		return x.Message
+		return x.ReconcilerRunner
 	}
 	return ""
 }
 
-type Load_Request struct {
+func (x *Deps_Graph) GetReconcilers() []*Deps_Reconciler {
+	if x != nil {
+		return x.Reconcilers
+	}
+	return nil
+}
+
+func (x *Deps_Graph) GetCycles() []*Deps_Cycle {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+type Deps_Reconciler struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Options *Load_Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Name      string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	For       *Deps_GroupKind   `protobuf:"bytes,2,opt,name=for,proto3" json:"for,omitempty"`
+	Owns      []*Deps_GroupKind `protobuf:"bytes,3,rep,name=owns,proto3" json:"owns,omitempty"`
+	Watches   []*Deps_GroupKind `protobuf:"bytes,4,rep,name=watches,proto3" json:"watches,omitempty"`
+	DependsOn []string          `protobuf:"bytes,5,rep,name=dependsOn,proto3" json:"dependsOn,omitempty"`
+	Wave      int32             `protobuf:"varint,6,opt,name=wave,proto3" json:"wave,omitempty"`
 }
 
-func (x *Load_Request) Reset() {
-	*x = Load_Request{}
+func (x *Deps_Reconciler) Reset() {
+	*x = Deps_Reconciler{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[20]
+		mi := &file_runner_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Load_Request) String() string {
+func (x *Deps_Reconciler) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Load_Request) ProtoMessage() {}
+func (*Deps_Reconciler) ProtoMessage() {}
 
-func (x *Load_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[20]
+func (x *Deps_Reconciler) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,43 +2551,79 @@ func (x *Load_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Load_Request.ProtoReflect.Descriptor instead.
-func (*Load_Request) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use Deps_Reconciler.ProtoReflect.Descriptor instead.
+func (*Deps_Reconciler) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{5, 4}
 }
 
-func (x *Load_Request) GetOptions() *Load_Options {
+func (x *Deps_Reconciler) GetName() string {
 	if x != nil {
-		return x.Options
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Deps_Reconciler) GetFor() *Deps_GroupKind {
+	if x != nil {
+		return x.For
 	}
 	return nil
 }
 
-type Load_Response struct {
+func (x *Deps_Reconciler) GetOwns() []*Deps_GroupKind {
+	if x != nil {
+		return x.Owns
+	}
+	return nil
+}
+
+func (x *Deps_Reconciler) GetWatches() []*Deps_GroupKind {
+	if x != nil {
+		return x.Watches
+	}
+	return nil
+}
+
+func (x *Deps_Reconciler) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *Deps_Reconciler) GetWave() int32 {
+	if x != nil {
+		return x.Wave
+	}
+	return 0
+}
+
+type Deps_GroupKind struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Object []byte `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind  string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
 }
 
-func (x *Load_Response) Reset() {
-	*x = Load_Response{}
+func (x *Deps_GroupKind) Reset() {
+	*x = Deps_GroupKind{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[21]
+		mi := &file_runner_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Load_Response) String() string {
+func (x *Deps_GroupKind) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Load_Response) ProtoMessage() {}
+func (*Deps_GroupKind) ProtoMessage() {}
 
-func (x *Load_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[21]
+func (x *Deps_GroupKind) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,44 +2634,50 @@ func (x *Load_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Load_Response.ProtoReflect.Descriptor instead.
-func (*Load_Response) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use Deps_GroupKind.ProtoReflect.Descriptor instead.
+func (*Deps_GroupKind) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{5, 5}
+}
+
+func (x *Deps_GroupKind) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
 }
 
-func (x *Load_Response) GetObject() []byte {
+func (x *Deps_GroupKind) GetKind() string {
 	if x != nil {
-		return x.Object
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-type Load_Options struct {
+type Deps_Cycle struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProxyName      string `protobuf:"bytes,1,opt,name=proxyName,proto3" json:"proxyName,omitempty"`
-	ProxyNamespace string `protobuf:"bytes,2,opt,name=proxyNamespace,proto3" json:"proxyNamespace,omitempty"`
+	Reconcilers []string `protobuf:"bytes,1,rep,name=reconcilers,proto3" json:"reconcilers,omitempty"`
 }
 
-func (x *Load_Options) Reset() {
-	*x = Load_Options{}
+func (x *Deps_Cycle) Reset() {
+	*x = Deps_Cycle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_runner_proto_msgTypes[22]
+		mi := &file_runner_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Load_Options) String() string {
+func (x *Deps_Cycle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Load_Options) ProtoMessage() {}
+func (*Deps_Cycle) ProtoMessage() {}
 
-func (x *Load_Options) ProtoReflect() protoreflect.Message {
-	mi := &file_runner_proto_msgTypes[22]
+func (x *Deps_Cycle) ProtoReflect() protoreflect.Message {
+	mi := &file_runner_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,176 +2688,339 @@ func (x *Load_Options) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Load_Options.ProtoReflect.Descriptor instead.
-func (*Load_Options) Descriptor() ([]byte, []int) {
-	return file_runner_proto_rawDescGZIP(), []int{3, 2}
-}
-
-func (x *Load_Options) GetProxyName() string {
-	if x != nil {
-		return x.ProxyName
-	}
-	return ""
+// Deprecated: Use Deps_Cycle.ProtoReflect.Descriptor instead.
+func (*Deps_Cycle) Descriptor() ([]byte, []int) {
+	return file_runner_proto_rawDescGZIP(), []int{5, 6}
 }
 
-func (x *Load_Options) GetProxyNamespace() string {
+func (x *Deps_Cycle) GetReconcilers() []string {
 	if x != nil {
-		return x.ProxyNamespace
+		return x.Reconcilers
 	}
-	return ""
+	return nil
 }
 
 var File_runner_proto protoreflect.FileDescriptor
 
 var file_runner_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x01, 0x0a, 0x05, 0x53, 0x74,
 	0x61, 0x72, 0x74, 0x1a, 0x3c, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
 	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
 	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x4f, 0x0a,
+	0x73, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x67, 0x0a,
 	0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78,
 	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
 	0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xa0,
-	0x01, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x6f, 0x70, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x1a, 0x4f, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x22, 0xe8, 0x07, 0x0a, 0x04, 0x4f, 0x6e, 0x63, 0x65, 0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0xb8, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x1a,
+	0x3b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0a, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x67, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x22, 0x88, 0x0d, 0x0a, 0x04, 0x4f, 0x6e, 0x63, 0x65, 0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70,
 	0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x4f, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0xb9, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f,
-	0x6e, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0e,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0b,
-	0x72, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63,
-	0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x0b, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
-	0x73, 0x64, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63,
-	0x65, 0x2e, 0x53, 0x44, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
-	0x0b, 0x73, 0x64, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x1a, 0x2a, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x21, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x1a, 0x5b, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x75,
-	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x1a, 0xde, 0x01, 0x0a, 0x09, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a,
-	0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
-	0x69, 0x6c, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x1a, 0x27, 0x0a, 0x0b, 0x53, 0x44, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x0b, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f,
-	0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x55, 0x4e,
-	0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x53,
-	0x44, 0x43, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a,
-	0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x22, 0xb8, 0x01, 0x0a,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xfc, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x35, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x1a, 0xec, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63,
+	0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0b, 0x72, 0x75,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x72,
+	0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x64,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e,
+	0x53, 0x44, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73,
+	0x64, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2a, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x21, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x5b, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x52,
+	0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x1a, 0xad, 0x02, 0x0a, 0x09, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x11,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x1a, 0xef, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72,
+	0x73, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x27, 0x0a, 0x0b, 0x53, 0x44, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x0b,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x52,
+	0x55, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x53, 0x44, 0x43, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x04, 0x12,
+	0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x09,
+	0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x06, 0x22, 0x71, 0x0a, 0x14, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x45,
+	0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x53, 0x43,
+	0x49, 0x4c, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41,
+	0x58, 0x5f, 0x49, 0x54, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x22, 0xd0, 0x01, 0x0a,
 	0x04, 0x4c, 0x6f, 0x61, 0x64, 0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x30, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61,
 	0x64, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x1a, 0x22, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
 	0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x4f, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x67, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
 	0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f,
-	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72,
-	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
-	0x72, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f,
-	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72,
-	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x66, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2a, 0x45, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x54, 0x4f, 0x50, 0x10,
-	0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
-	0x52, 0x45, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x04, 0x32, 0xf9, 0x01, 0x0a, 0x06, 0x52, 0x75,
-	0x6e, 0x6e, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x39, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x16, 0x2e, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74,
-	0x6f, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x04, 0x4f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x04, 0x4c, 0x6f,
-	0x61, 0x64, 0x12, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
-	0x61, 0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x63, 0x68,
-	0x6f, 0x72, 0x65, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72,
-	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22,
+	0xd7, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x3d, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x45, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x1a, 0x67, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x1a, 0x5e, 0x0a, 0x0c, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xcc, 0x05, 0x0a, 0x04, 0x44, 0x65,
+	0x70, 0x73, 0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x73, 0x2e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x38, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x06, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x1a, 0x67, 0x0a, 0x07, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x1a, 0x9e, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x2a, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x73, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x70, 0x73, 0x2e, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x06, 0x63, 0x79, 0x63,
+	0x6c, 0x65, 0x73, 0x1a, 0xe0, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x66, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x70, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x03, 0x66,
+	0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x73,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6f, 0x77, 0x6e, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70,
+	0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x07, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f,
+	0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73,
+	0x4f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x77, 0x61, 0x76, 0x65, 0x1a, 0x35, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x1a, 0x29, 0x0a,
+	0x05, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x72, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x72, 0x55, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x22, 0xb1, 0x02, 0x0a, 0x0e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x2d,
+	0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x12, 0x2e, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x12, 0x2a, 0x0a, 0x06, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x93, 0x01, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x46,
+	0x4f, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x57, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x55, 0x45, 0x10, 0x03, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x61, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x69,
+	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x08, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2a, 0x45, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x02,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x04, 0x32, 0xf5, 0x02, 0x0a, 0x06, 0x52, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x39, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
+	0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x04,
+	0x4f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x04, 0x4c, 0x6f, 0x61,
+	0x64, 0x12, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x04, 0x44, 0x65, 0x70, 0x73, 0x12, 0x16, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
+	0x66, 0x6f, 0x72, 0x6d, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x63, 0x68, 0x6f, 0x72, 0x65, 0x6f, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1564,64 +3035,113 @@ func file_runner_proto_rawDescGZIP() []byte {
 	return file_runner_proto_rawDescData
 }
 
-var file_runner_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_runner_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_runner_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_runner_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_runner_proto_goTypes = []interface{}{
-	(Operation)(0),                // 0: runnerpb.Operation
-	(Once_MessageType)(0),         // 1: runnerpb.Once.MessageType
-	(*Start)(nil),                 // 2: runnerpb.Start
-	(*Stop)(nil),                  // 3: runnerpb.Stop
-	(*Once)(nil),                  // 4: runnerpb.Once
-	(*Load)(nil),                  // 5: runnerpb.Load
-	(*ReconcileResult)(nil),       // 6: runnerpb.ReconcileResult
-	(*Resource)(nil),              // 7: runnerpb.Resource
-	(*Start_Request)(nil),         // 8: runnerpb.Start.Request
-	(*Start_Response)(nil),        // 9: runnerpb.Start.Response
-	(*Start_Options)(nil),         // 10: runnerpb.Start.Options
-	(*Stop_Request)(nil),          // 11: runnerpb.Stop.Request
-	(*Stop_Response)(nil),         // 12: runnerpb.Stop.Response
-	(*Stop_Options)(nil),          // 13: runnerpb.Stop.Options
-	(*Once_Request)(nil),          // 14: runnerpb.Once.Request
-	(*Once_Options)(nil),          // 15: runnerpb.Once.Options
-	(*Once_Response)(nil),         // 16: runnerpb.Once.Response
-	(*Once_ProgressUpdate)(nil),   // 17: runnerpb.Once.ProgressUpdate
-	(*Once_Error)(nil),            // 18: runnerpb.Once.Error
-	(*Once_RunResponse)(nil),      // 19: runnerpb.Once.RunResponse
-	(*Once_RunResult)(nil),        // 20: runnerpb.Once.RunResult
-	(*Once_SDCResponse)(nil),      // 21: runnerpb.Once.SDCResponse
-	(*Load_Request)(nil),          // 22: runnerpb.Load.Request
-	(*Load_Response)(nil),         // 23: runnerpb.Load.Response
-	(*Load_Options)(nil),          // 24: runnerpb.Load.Options
-	(*timestamppb.Timestamp)(nil), // 25: google.protobuf.Timestamp
+	(Operation)(0),                 // 0: runnerpb.Operation
+	(Once_MessageType)(0),          // 1: runnerpb.Once.MessageType
+	(Once_ConvergenceIssueType)(0), // 2: runnerpb.Once.ConvergenceIssueType
+	(Trigger_Type)(0),              // 3: runnerpb.Trigger.Type
+	(*Start)(nil),                  // 4: runnerpb.Start
+	(*Stop)(nil),                   // 5: runnerpb.Stop
+	(*Once)(nil),                   // 6: runnerpb.Once
+	(*Load)(nil),                   // 7: runnerpb.Load
+	(*Status)(nil),                 // 8: runnerpb.Status
+	(*Deps)(nil),                   // 9: runnerpb.Deps
+	(*ReconcileResult)(nil),        // 10: runnerpb.ReconcileResult
+	(*ReconcileTrace)(nil),         // 11: runnerpb.ReconcileTrace
+	(*Trigger)(nil),                // 12: runnerpb.Trigger
+	(*ClientCall)(nil),             // 13: runnerpb.ClientCall
+	(*Condition)(nil),              // 14: runnerpb.Condition
+	(*Resource)(nil),               // 15: runnerpb.Resource
+	(*Start_Request)(nil),          // 16: runnerpb.Start.Request
+	(*Start_Response)(nil),         // 17: runnerpb.Start.Response
+	(*Start_Options)(nil),          // 18: runnerpb.Start.Options
+	(*Stop_Request)(nil),           // 19: runnerpb.Stop.Request
+	(*Stop_Response)(nil),          // 20: runnerpb.Stop.Response
+	(*Stop_Options)(nil),           // 21: runnerpb.Stop.Options
+	(*Once_Request)(nil),           // 22: runnerpb.Once.Request
+	(*Once_Options)(nil),           // 23: runnerpb.Once.Options
+	(*Once_Response)(nil),          // 24: runnerpb.Once.Response
+	(*Once_ProgressUpdate)(nil),    // 25: runnerpb.Once.ProgressUpdate
+	(*Once_Error)(nil),             // 26: runnerpb.Once.Error
+	(*Once_RunResponse)(nil),       // 27: runnerpb.Once.RunResponse
+	(*Once_RunResult)(nil),         // 28: runnerpb.Once.RunResult
+	(*Once_ConvergenceIssue)(nil),  // 29: runnerpb.Once.ConvergenceIssue
+	(*Once_SDCResponse)(nil),       // 30: runnerpb.Once.SDCResponse
+	(*Load_Request)(nil),           // 31: runnerpb.Load.Request
+	(*Load_Response)(nil),          // 32: runnerpb.Load.Response
+	(*Load_Options)(nil),           // 33: runnerpb.Load.Options
+	(*Status_Request)(nil),         // 34: runnerpb.Status.Request
+	(*Status_Response)(nil),        // 35: runnerpb.Status.Response
+	(*Status_Options)(nil),         // 36: runnerpb.Status.Options
+	(*Status_BranchStatus)(nil),    // 37: runnerpb.Status.BranchStatus
+	(*Deps_Request)(nil),           // 38: runnerpb.Deps.Request
+	(*Deps_Response)(nil),          // 39: runnerpb.Deps.Response
+	(*Deps_Options)(nil),           // 40: runnerpb.Deps.Options
+	(*Deps_Graph)(nil),             // 41: runnerpb.Deps.Graph
+	(*Deps_Reconciler)(nil),        // 42: runnerpb.Deps.Reconciler
+	(*Deps_GroupKind)(nil),         // 43: runnerpb.Deps.GroupKind
+	(*Deps_Cycle)(nil),             // 44: runnerpb.Deps.Cycle
+	(*timestamppb.Timestamp)(nil),  // 45: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 46: google.protobuf.Duration
 }
 var file_runner_proto_depIdxs = []int32{
-	25, // 0: runnerpb.ReconcileResult.eventTime:type_name -> google.protobuf.Timestamp
+	45, // 0: runnerpb.ReconcileResult.eventTime:type_name -> google.protobuf.Timestamp
 	0,  // 1: runnerpb.ReconcileResult.operation:type_name -> runnerpb.Operation
-	7,  // 2: runnerpb.ReconcileResult.resource:type_name -> runnerpb.Resource
-	10, // 3: runnerpb.Start.Request.options:type_name -> runnerpb.Start.Options
-	13, // 4: runnerpb.Stop.Request.options:type_name -> runnerpb.Stop.Options
-	15, // 5: runnerpb.Once.Request.options:type_name -> runnerpb.Once.Options
-	1,  // 6: runnerpb.Once.Response.Type:type_name -> runnerpb.Once.MessageType
-	17, // 7: runnerpb.Once.Response.progressUpdate:type_name -> runnerpb.Once.ProgressUpdate
-	18, // 8: runnerpb.Once.Response.error:type_name -> runnerpb.Once.Error
-	19, // 9: runnerpb.Once.Response.runResponse:type_name -> runnerpb.Once.RunResponse
-	21, // 10: runnerpb.Once.Response.sdcResponse:type_name -> runnerpb.Once.SDCResponse
-	20, // 11: runnerpb.Once.RunResponse.results:type_name -> runnerpb.Once.RunResult
-	6,  // 12: runnerpb.Once.RunResult.results:type_name -> runnerpb.ReconcileResult
-	24, // 13: runnerpb.Load.Request.options:type_name -> runnerpb.Load.Options
-	8,  // 14: runnerpb.Runner.Start:input_type -> runnerpb.Start.Request
-	11, // 15: runnerpb.Runner.Stop:input_type -> runnerpb.Stop.Request
-	14, // 16: runnerpb.Runner.Once:input_type -> runnerpb.Once.Request
-	22, // 17: runnerpb.Runner.Load:input_type -> runnerpb.Load.Request
-	9,  // 18: runnerpb.Runner.Start:output_type -> runnerpb.Start.Response
-	12, // 19: runnerpb.Runner.Stop:output_type -> runnerpb.Stop.Response
-	16, // 20: runnerpb.Runner.Once:output_type -> runnerpb.Once.Response
-	23, // 21: runnerpb.Runner.Load:output_type -> runnerpb.Load.Response
-	18, // [18:22] is the sub-list for method output_type
-	14, // [14:18] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	15, // 2: runnerpb.ReconcileResult.resource:type_name -> runnerpb.Resource
+	11, // 3: runnerpb.ReconcileResult.trace:type_name -> runnerpb.ReconcileTrace
+	12, // 4: runnerpb.ReconcileTrace.triggers:type_name -> runnerpb.Trigger
+	46, // 5: runnerpb.ReconcileTrace.duration:type_name -> google.protobuf.Duration
+	13, // 6: runnerpb.ReconcileTrace.calls:type_name -> runnerpb.ClientCall
+	15, // 7: runnerpb.ReconcileTrace.children:type_name -> runnerpb.Resource
+	15, // 8: runnerpb.ReconcileTrace.pruned:type_name -> runnerpb.Resource
+	14, // 9: runnerpb.ReconcileTrace.condition:type_name -> runnerpb.Condition
+	3,  // 10: runnerpb.Trigger.type:type_name -> runnerpb.Trigger.Type
+	15, // 11: runnerpb.Trigger.source:type_name -> runnerpb.Resource
+	15, // 12: runnerpb.ClientCall.resource:type_name -> runnerpb.Resource
+	18, // 13: runnerpb.Start.Request.options:type_name -> runnerpb.Start.Options
+	21, // 14: runnerpb.Stop.Request.options:type_name -> runnerpb.Stop.Options
+	23, // 15: runnerpb.Once.Request.options:type_name -> runnerpb.Once.Options
+	46, // 16: runnerpb.Once.Options.deadline:type_name -> google.protobuf.Duration
+	1,  // 17: runnerpb.Once.Response.Type:type_name -> runnerpb.Once.MessageType
+	25, // 18: runnerpb.Once.Response.progressUpdate:type_name -> runnerpb.Once.ProgressUpdate
+	26, // 19: runnerpb.Once.Response.error:type_name -> runnerpb.Once.Error
+	27, // 20: runnerpb.Once.Response.runResponse:type_name -> runnerpb.Once.RunResponse
+	30, // 21: runnerpb.Once.Response.sdcResponse:type_name -> runnerpb.Once.SDCResponse
+	10, // 22: runnerpb.Once.Response.trace:type_name -> runnerpb.ReconcileResult
+	28, // 23: runnerpb.Once.RunResponse.results:type_name -> runnerpb.Once.RunResult
+	10, // 24: runnerpb.Once.RunResult.results:type_name -> runnerpb.ReconcileResult
+	29, // 25: runnerpb.Once.RunResult.convergenceIssues:type_name -> runnerpb.Once.ConvergenceIssue
+	2,  // 26: runnerpb.Once.ConvergenceIssue.type:type_name -> runnerpb.Once.ConvergenceIssueType
+	15, // 27: runnerpb.Once.ConvergenceIssue.resource:type_name -> runnerpb.Resource
+	33, // 28: runnerpb.Load.Request.options:type_name -> runnerpb.Load.Options
+	36, // 29: runnerpb.Status.Request.options:type_name -> runnerpb.Status.Options
+	37, // 30: runnerpb.Status.Response.branches:type_name -> runnerpb.Status.BranchStatus
+	40, // 31: runnerpb.Deps.Request.options:type_name -> runnerpb.Deps.Options
+	41, // 32: runnerpb.Deps.Response.graphs:type_name -> runnerpb.Deps.Graph
+	42, // 33: runnerpb.Deps.Graph.reconcilers:type_name -> runnerpb.Deps.Reconciler
+	44, // 34: runnerpb.Deps.Graph.cycles:type_name -> runnerpb.Deps.Cycle
+	43, // 35: runnerpb.Deps.Reconciler.for:type_name -> runnerpb.Deps.GroupKind
+	43, // 36: runnerpb.Deps.Reconciler.owns:type_name -> runnerpb.Deps.GroupKind
+	43, // 37: runnerpb.Deps.Reconciler.watches:type_name -> runnerpb.Deps.GroupKind
+	16, // 38: runnerpb.Runner.Start:input_type -> runnerpb.Start.Request
+	19, // 39: runnerpb.Runner.Stop:input_type -> runnerpb.Stop.Request
+	22, // 40: runnerpb.Runner.Once:input_type -> runnerpb.Once.Request
+	31, // 41: runnerpb.Runner.Load:input_type -> runnerpb.Load.Request
+	38, // 42: runnerpb.Runner.Deps:input_type -> runnerpb.Deps.Request
+	34, // 43: runnerpb.Runner.Status:input_type -> runnerpb.Status.Request
+	17, // 44: runnerpb.Runner.Start:output_type -> runnerpb.Start.Response
+	20, // 45: runnerpb.Runner.Stop:output_type -> runnerpb.Stop.Response
+	24, // 46: runnerpb.Runner.Once:output_type -> runnerpb.Once.Response
+	32, // 47: runnerpb.Runner.Load:output_type -> runnerpb.Load.Response
+	39, // 48: runnerpb.Runner.Deps:output_type -> runnerpb.Deps.Response
+	35, // 49: runnerpb.Runner.Status:output_type -> runnerpb.Status.Response
+	44, // [44:50] is the sub-list for method output_type
+	38, // [38:44] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_runner_proto_init() }
@@ -1679,7 +3199,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReconcileResult); i {
+			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,7 +3211,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resource); i {
+			switch v := v.(*Deps); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,7 +3223,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Start_Request); i {
+			switch v := v.(*ReconcileResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1715,7 +3235,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Start_Response); i {
+			switch v := v.(*ReconcileTrace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1727,7 +3247,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Start_Options); i {
+			switch v := v.(*Trigger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1739,7 +3259,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stop_Request); i {
+			switch v := v.(*ClientCall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1751,7 +3271,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stop_Response); i {
+			switch v := v.(*Condition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1763,7 +3283,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stop_Options); i {
+			switch v := v.(*Resource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1775,7 +3295,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Once_Request); i {
+			switch v := v.(*Start_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1787,7 +3307,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Once_Options); i {
+			switch v := v.(*Start_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1799,7 +3319,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Once_Response); i {
+			switch v := v.(*Start_Options); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1811,7 +3331,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Once_ProgressUpdate); i {
+			switch v := v.(*Stop_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1823,7 +3343,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Once_Error); i {
+			switch v := v.(*Stop_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1835,7 +3355,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Once_RunResponse); i {
+			switch v := v.(*Stop_Options); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1847,7 +3367,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Once_RunResult); i {
+			switch v := v.(*Once_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1859,7 +3379,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Once_SDCResponse); i {
+			switch v := v.(*Once_Options); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1871,7 +3391,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Load_Request); i {
+			switch v := v.(*Once_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1883,7 +3403,7 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Load_Response); i {
+			switch v := v.(*Once_ProgressUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1895,6 +3415,90 @@ func file_runner_proto_init() {
 			}
 		}
 		file_runner_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Once_Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Once_RunResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Once_RunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Once_ConvergenceIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Once_SDCResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Load_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Load_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Load_Options); i {
 			case 0:
 				return &v.state
@@ -1906,20 +3510,153 @@ func file_runner_proto_init() {
 				return nil
 			}
 		}
+		file_runner_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status_Options); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status_BranchStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deps_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deps_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deps_Options); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deps_Graph); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deps_Reconciler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deps_GroupKind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_runner_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deps_Cycle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_runner_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_runner_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*Once_Response_ProgressUpdate)(nil),
 		(*Once_Response_Error)(nil),
 		(*Once_Response_RunResponse)(nil),
 		(*Once_Response_SdcResponse)(nil),
+		(*Once_Response_Trace)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_runner_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   23,
+			NumEnums:      4,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,8 @@ type RunnerClient interface {
 	Stop(ctx context.Context, in *Stop_Request, opts ...grpc.CallOption) (*Stop_Response, error)
 	Once(ctx context.Context, in *Once_Request, opts ...grpc.CallOption) (Runner_OnceClient, error)
 	Load(ctx context.Context, in *Load_Request, opts ...grpc.CallOption) (*Load_Response, error)
+	Deps(ctx context.Context, in *Deps_Request, opts ...grpc.CallOption) (*Deps_Response, error)
+	Status(ctx context.Context, in *Status_Request, opts ...grpc.CallOption) (*Status_Response, error)
 }
 
 type runnerClient struct {
@@ -95,6 +97,24 @@ func (c *runnerClient) Load(ctx context.Context, in *Load_Request, opts ...grpc.
 	return out, nil
 }
 
+func (c *runnerClient) Deps(ctx context.Context, in *Deps_Request, opts ...grpc.CallOption) (*Deps_Response, error) {
+	out := new(Deps_Response)
+	err := c.cc.Invoke(ctx, "/runnerpb.Runner/Deps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) Status(ctx context.Context, in *Status_Request, opts ...grpc.CallOption) (*Status_Response, error) {
+	out := new(Status_Response)
+	err := c.cc.Invoke(ctx, "/runnerpb.Runner/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RunnerServer is the server API for Runner service.
 // All implementations must embed UnimplementedRunnerServer
 // for forward compatibility
@@ -103,6 +123,8 @@ type RunnerServer interface {
 	Stop(context.Context, *Stop_Request) (*Stop_Response, error)
 	Once(*Once_Request, Runner_OnceServer) error
 	Load(context.Context, *Load_Request) (*Load_Response, error)
+	Deps(context.Context, *Deps_Request) (*Deps_Response, error)
+	Status(context.Context, *Status_Request) (*Status_Response, error)
 	mustEmbedUnimplementedRunnerServer()
 }
 
@@ -122,6 +144,12 @@ func (UnimplementedRunnerServer) Once(*Once_Request, Runner_OnceServer) error {
 func (UnimplementedRunnerServer) Load(context.Context, *Load_Request) (*Load_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Load not implemented")
 }
+func (UnimplementedRunnerServer) Deps(context.Context, *Deps_Request) (*Deps_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deps not implemented")
+}
+func (UnimplementedRunnerServer) Status(context.Context, *Status_Request) (*Status_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
 func (UnimplementedRunnerServer) mustEmbedUnimplementedRunnerServer() {}
 
 // UnsafeRunnerServer may be embedded to opt out of forward compatibility for this service.
@@ -210,6 +238,42 @@ func _Runner_Load_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Runner_Deps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Deps_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).Deps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runnerpb.Runner/Deps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).Deps(ctx, req.(*Deps_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Status_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/runnerpb.Runner/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).Status(ctx, req.(*Status_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Runner_ServiceDesc is the grpc.ServiceDesc for Runner service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +293,14 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Load",
 			Handler:    _Runner_Load_Handler,
 		},
+		{
+			MethodName: "Deps",
+			Handler:    _Runner_Deps_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Runner_Status_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
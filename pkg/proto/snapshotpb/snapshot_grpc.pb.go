@@ -27,6 +27,8 @@ type SnapshotClient interface {
 	Delete(ctx context.Context, in *Delete_Request, opts ...grpc.CallOption) (*Delete_Response, error)
 	Diff(ctx context.Context, in *Diff_Request, opts ...grpc.CallOption) (*Diff_Response, error)
 	Result(ctx context.Context, in *Result_Request, opts ...grpc.CallOption) (*Result_Response, error)
+	Tag(ctx context.Context, in *Tag_Request, opts ...grpc.CallOption) (*Tag_Response, error)
+	Restore(ctx context.Context, in *Restore_Request, opts ...grpc.CallOption) (*Restore_Response, error)
 	Watch(ctx context.Context, in *Watch_Request, opts ...grpc.CallOption) (Snapshot_WatchClient, error)
 }
 
@@ -83,6 +85,24 @@ func (c *snapshotClient) Result(ctx context.Context, in *Result_Request, opts ..
 	return out, nil
 }
 
+func (c *snapshotClient) Tag(ctx context.Context, in *Tag_Request, opts ...grpc.CallOption) (*Tag_Response, error) {
+	out := new(Tag_Response)
+	err := c.cc.Invoke(ctx, "/snapshotpb.Snapshot/Tag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snapshotClient) Restore(ctx context.Context, in *Restore_Request, opts ...grpc.CallOption) (*Restore_Response, error) {
+	out := new(Restore_Response)
+	err := c.cc.Invoke(ctx, "/snapshotpb.Snapshot/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *snapshotClient) Watch(ctx context.Context, in *Watch_Request, opts ...grpc.CallOption) (Snapshot_WatchClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Snapshot_ServiceDesc.Streams[0], "/snapshotpb.Snapshot/Watch", opts...)
 	if err != nil {
@@ -124,6 +144,8 @@ type SnapshotServer interface {
 	Delete(context.Context, *Delete_Request) (*Delete_Response, error)
 	Diff(context.Context, *Diff_Request) (*Diff_Response, error)
 	Result(context.Context, *Result_Request) (*Result_Response, error)
+	Tag(context.Context, *Tag_Request) (*Tag_Response, error)
+	Restore(context.Context, *Restore_Request) (*Restore_Response, error)
 	Watch(*Watch_Request, Snapshot_WatchServer) error
 	mustEmbedUnimplementedSnapshotServer()
 }
@@ -147,6 +169,12 @@ func (UnimplementedSnapshotServer) Diff(context.Context, *Diff_Request) (*Diff_R
 func (UnimplementedSnapshotServer) Result(context.Context, *Result_Request) (*Result_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Result not implemented")
 }
+func (UnimplementedSnapshotServer) Tag(context.Context, *Tag_Request) (*Tag_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tag not implemented")
+}
+func (UnimplementedSnapshotServer) Restore(context.Context, *Restore_Request) (*Restore_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedSnapshotServer) Watch(*Watch_Request, Snapshot_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
@@ -253,6 +281,42 @@ func _Snapshot_Result_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Snapshot_Tag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tag_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServer).Tag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snapshotpb.Snapshot/Tag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServer).Tag(ctx, req.(*Tag_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Snapshot_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Restore_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnapshotServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snapshotpb.Snapshot/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnapshotServer).Restore(ctx, req.(*Restore_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Snapshot_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Watch_Request)
 	if err := stream.RecvMsg(m); err != nil {
@@ -301,6 +365,14 @@ var Snapshot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Result",
 			Handler:    _Snapshot_Result_Handler,
 		},
+		{
+			MethodName: "Tag",
+			Handler:    _Snapshot_Tag_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Snapshot_Restore_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
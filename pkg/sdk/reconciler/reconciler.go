@@ -0,0 +1,171 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reconciler provides the building blocks to implement an external
+// reconciler in go. Choreo calls the reconciler over gRPC with the for resource
+// and its children, and applies the returned children and status.
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kform-dev/choreo/pkg/proto/reconcilerpb"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// AddressEnv is the environment variable through which choreo provides the address
+// to a reconciler that is started as a subprocess
+const AddressEnv = "CHOREO_RECONCILER_ADDRESS"
+
+type Request struct {
+	// Name of the reconciler
+	Name string
+	// Object is the for resource
+	Object *unstructured.Unstructured
+	// Children are the existing child resources owned by the for resource
+	Children []*unstructured.Unstructured
+}
+
+type Result struct {
+	// Children are the desired child resources; the existing children that are
+	// not part of the desired children are deleted
+	Children []*unstructured.Unstructured
+	// Object holds the spec and status of the for resource; the spec is only
+	// applied when the reconciler has specUpdate set
+	Object  *unstructured.Unstructured
+	Requeue bool
+	// RequeueAfter is rounded to seconds
+	RequeueAfter time.Duration
+	// Message indicates a failure of the reconcile when not empty
+	Message string
+	// Fatal indicates the failure is not recoverable by a requeue
+	Fatal bool
+}
+
+// ReconcileFunc implements the business logic of the reconciler.
+// A returned error is reported as a failure of the reconcile and is requeued.
+type ReconcileFunc func(ctx context.Context, req *Request) (*Result, error)
+
+// Serve serves the reconciler on the address until the context is cancelled.
+// When the address is empty the address is retrieved from the AddressEnv environment variable.
+func Serve(ctx context.Context, address string, fn ReconcileFunc) error {
+	if address == "" {
+		address = os.Getenv(AddressEnv)
+	}
+	if address == "" {
+		return fmt.Errorf("no address specified and %s is not set", AddressEnv)
+	}
+	network, addr := ParseAddress(address)
+	if network == "unix" {
+		// remove a stale socket of a previous run
+		if err := os.Remove(addr); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	lis, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+	s := grpc.NewServer()
+	reconcilerpb.RegisterReconcilerServer(s, &server{fn: fn})
+	go func() {
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+	return s.Serve(lis)
+}
+
+// ParseAddress returns the network and address to listen on; addresses with a unix:// or
+// unix: prefix are unix sockets, all others are tcp addresses
+func ParseAddress(address string) (string, string) {
+	switch {
+	case strings.HasPrefix(address, "unix://"):
+		return "unix", strings.TrimPrefix(address, "unix://")
+	case strings.HasPrefix(address, "unix:"):
+		return "unix", strings.TrimPrefix(address, "unix:")
+	default:
+		return "tcp", address
+	}
+}
+
+type server struct {
+	reconcilerpb.UnimplementedReconcilerServer
+	fn ReconcileFunc
+}
+
+func (r *server) Reconcile(ctx context.Context, req *reconcilerpb.Reconcile_Request) (*reconcilerpb.Reconcile_Response, error) {
+	obj, err := decode(req.Object)
+	if err != nil {
+		return &reconcilerpb.Reconcile_Response{Message: fmt.Sprintf("invalid object: %s", err.Error()), Fatal: true}, nil
+	}
+	children := make([]*unstructured.Unstructured, 0, len(req.Children))
+	for _, b := range req.Children {
+		child, err := decode(b)
+		if err != nil {
+			return &reconcilerpb.Reconcile_Response{Message: fmt.Sprintf("invalid child: %s", err.Error()), Fatal: true}, nil
+		}
+		children = append(children, child)
+	}
+
+	result, err := r.fn(ctx, &Request{Name: req.Name, Object: obj, Children: children})
+	if err != nil {
+		return &reconcilerpb.Reconcile_Response{Message: err.Error(), Requeue: true}, nil
+	}
+	if result == nil {
+		result = &Result{}
+	}
+	return encodeResult(result)
+}
+
+func encodeResult(result *Result) (*reconcilerpb.Reconcile_Response, error) {
+	rsp := &reconcilerpb.Reconcile_Response{
+		Children:     make([][]byte, 0, len(result.Children)),
+		Requeue:      result.Requeue,
+		RequeueAfter: int64(result.RequeueAfter / time.Second),
+		Message:      result.Message,
+		Fatal:        result.Fatal,
+	}
+	for _, child := range result.Children {
+		b, err := json.Marshal(child.Object)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Children = append(rsp.Children, b)
+	}
+	if result.Object != nil {
+		b, err := json.Marshal(result.Object.Object)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Object = b
+	}
+	return rsp, nil
+}
+
+func decode(b []byte) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(b, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
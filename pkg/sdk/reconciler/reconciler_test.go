@@ -0,0 +1,118 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kform-dev/choreo/pkg/proto/reconcilerpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestServe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	address := "unix://" + filepath.Join(t.TempDir(), "reconciler.sock")
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- Serve(ctx, address, func(ctx context.Context, req *Request) (*Result, error) {
+			if req.Object.GetName() == "fail" {
+				return nil, fmt.Errorf("failed")
+			}
+			child := &unstructured.Unstructured{}
+			child.SetAPIVersion("v1")
+			child.SetKind("ConfigMap")
+			child.SetName(fmt.Sprintf("%s-%d", req.Object.GetName(), len(req.Children)))
+			return &Result{
+				Children:     []*unstructured.Unstructured{child},
+				Object:       &unstructured.Unstructured{Object: map[string]any{"status": map[string]any{"ready": true}}},
+				RequeueAfter: 5 * time.Second,
+			}, nil
+		})
+	}()
+
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("cannot connect: %v", err)
+	}
+	defer conn.Close()
+	client := reconcilerpb.NewReconcilerClient(conn)
+
+	cases := map[string]struct {
+		name            string
+		expectedMsg     string
+		expectedChild   string
+		expectedRequeue int64
+	}{
+		"Success": {
+			name:            "a",
+			expectedChild:   "a-1",
+			expectedRequeue: 5,
+		},
+		"Failure": {
+			name:        "fail",
+			expectedMsg: "failed",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj, _ := json.Marshal(map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]any{"name": tc.name}})
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			rsp, err := client.Reconcile(ctx, &reconcilerpb.Reconcile_Request{
+				Name:     "test",
+				Object:   obj,
+				Children: [][]byte{obj},
+			}, grpc.WaitForReady(true))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rsp.Message != tc.expectedMsg {
+				t.Errorf("expected message %q, got %q", tc.expectedMsg, rsp.Message)
+			}
+			if tc.expectedChild == "" {
+				return
+			}
+			if len(rsp.Children) != 1 {
+				t.Fatalf("expected 1 child, got %d", len(rsp.Children))
+			}
+			child := map[string]any{}
+			if err := json.Unmarshal(rsp.Children[0], &child); err != nil {
+				t.Fatalf("invalid child: %v", err)
+			}
+			if got := child["metadata"].(map[string]any)["name"]; got != tc.expectedChild {
+				t.Errorf("expected child %s, got %v", tc.expectedChild, got)
+			}
+			if rsp.RequeueAfter != tc.expectedRequeue {
+				t.Errorf("expected requeueAfter %d, got %d", tc.expectedRequeue, rsp.RequeueAfter)
+			}
+		})
+	}
+
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Errorf("unexpected serve error: %v", err)
+	}
+}
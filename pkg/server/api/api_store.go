@@ -68,6 +68,19 @@ func (r *ResourceContext) InternalGVK() schema.GroupVersionKind {
 	}
 }
 
+// StatusEnabled returns true when the storage version of the CRD enables the status subresource
+func (r *ResourceContext) StatusEnabled() bool {
+	if r.CRD == nil {
+		return false
+	}
+	for _, v := range r.CRD.Spec.Versions {
+		if v.Storage {
+			return v.Subresources != nil && v.Subresources.Status != nil
+		}
+	}
+	return false
+}
+
 // key 1 is gvk.String; key 2 is the name of the choreoinst
 type APIStore struct {
 	store.Storer[*ResourceContext]
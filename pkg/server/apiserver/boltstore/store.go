@@ -0,0 +1,307 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/henderiw/logger/log"
+	"github.com/henderiw/store"
+	"github.com/henderiw/store/watch"
+	bolt "go.etcd.io/bbolt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// FileName is the name of the database file in the db path
+	FileName = "choreo.db"
+	// errors
+	NotFound = "not found"
+
+	openTimeout = 5 * time.Second
+)
+
+type Config struct {
+	GroupResource schema.GroupResource
+	// RootPath is the directory of the database file
+	RootPath string
+	NewFunc  func() runtime.Unstructured
+}
+
+// NewStore returns a store backed by a single file bolt database in the root path.
+// Every group resource is stored in its own bucket of the shared database file,
+// such that objects of different resources can be written in a single transaction.
+// Watches are not provided by the store, they are handled by the registry on top of it.
+func NewStore(cfg *Config) (store.UnstructuredStore, error) {
+	db, err := open(filepath.Join(cfg.RootPath, FileName))
+	if err != nil {
+		return nil, err
+	}
+	r := &bstore{
+		db:     db,
+		bucket: []byte(cfg.GroupResource.String()),
+		new:    cfg.NewFunc,
+	}
+	if r.new == nil {
+		r.new = func() runtime.Unstructured { return &unstructured.Unstructured{} }
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(r.bucket)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+var (
+	m   sync.Mutex
+	dbs = map[string]*bolt.DB{}
+)
+
+// open returns the database of the file; a bolt database file can only be opened once,
+// so the stores of the different resources share the same database handle
+func open(path string) (*bolt.DB, error) {
+	m.Lock()
+	defer m.Unlock()
+	if db, ok := dbs[path]; ok {
+		return db, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("cannot open db %s, err: %v", path, err)
+	}
+	dbs[path] = db
+	return db, nil
+}
+
+// Close closes the database of the file in the root path
+func Close(rootPath string) error {
+	m.Lock()
+	defer m.Unlock()
+	path := filepath.Join(rootPath, FileName)
+	db, ok := dbs[path]
+	if !ok {
+		return nil
+	}
+	delete(dbs, path)
+	return db.Close()
+}
+
+// CloseAll closes all open databases
+func CloseAll() error {
+	m.Lock()
+	defer m.Unlock()
+	var errm error
+	for path, db := range dbs {
+		if err := db.Close(); err != nil {
+			errm = errors.Join(errm, fmt.Errorf("cannot close db %s, err: %v", path, err))
+		}
+		delete(dbs, path)
+	}
+	return errm
+}
+
+type bstore struct {
+	db     *bolt.DB
+	bucket []byte
+	new    func() runtime.Unstructured
+}
+
+func (r *bstore) Get(key store.Key, opts ...store.GetOption) (runtime.Unstructured, error) {
+	o := store.GetOptions{}
+	o.ApplyOptions(opts)
+	if o.Commit != nil {
+		return nil, fmt.Errorf("reading a commit is not supported by the bolt backend, nsn: %s", key.String())
+	}
+
+	var obj runtime.Unstructured
+	if err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		obj, err = r.get(tx, key)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (r *bstore) List(visitorFunc func(key store.Key, obj runtime.Unstructured), opts ...store.ListOption) {
+	log := log.FromContext(context.Background())
+	o := store.ListOptions{}
+	o.ApplyOptions(opts)
+	if o.Commit != nil {
+		// reading a commit is not supported by the bolt backend
+		return
+	}
+
+	type entry struct {
+		key store.Key
+		obj runtime.Unstructured
+	}
+	// the objects are visited outside of the transaction, such that the visitor
+	// can access the store
+	entries := []entry{}
+	_ = r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(r.bucket).ForEach(func(k, v []byte) error {
+			obj, err := r.decode(v)
+			if err != nil {
+				// skip objects that cannot be decoded, such that the other objects can be listed
+				log.Error("cannot decode object", "bucket", string(r.bucket), "key", string(k), "err", err)
+				return nil
+			}
+			entries = append(entries, entry{key: decodeKey(k), obj: obj})
+			return nil
+		})
+	})
+	if visitorFunc == nil {
+		return
+	}
+	for _, e := range entries {
+		visitorFunc(e.key, e.obj)
+	}
+}
+
+func (r *bstore) ListKeys(opts ...store.ListOption) []string {
+	keys := []string{}
+	r.List(func(key store.Key, _ runtime.Unstructured) {
+		keys = append(keys, key.Name)
+	}, opts...)
+	return keys
+}
+
+func (r *bstore) Len(opts ...store.ListOption) int {
+	o := store.ListOptions{}
+	o.ApplyOptions(opts)
+	if o.Commit != nil {
+		// reading a commit is not supported by the bolt backend
+		return 0
+	}
+
+	n := 0
+	_ = r.db.View(func(tx *bolt.Tx) error {
+		n = tx.Bucket(r.bucket).Stats().KeyN
+		return nil
+	})
+	return n
+}
+
+func (r *bstore) Apply(key store.Key, obj runtime.Unstructured, opts ...store.ApplyOption) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return r.put(tx, key, obj)
+	})
+}
+
+func (r *bstore) Create(key store.Key, obj runtime.Unstructured, opts ...store.CreateOption) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(r.bucket).Get(encodeKey(key)) != nil {
+			return fmt.Errorf("duplicate entry %v", key.String())
+		}
+		return r.put(tx, key, obj)
+	})
+}
+
+func (r *bstore) Update(key store.Key, obj runtime.Unstructured, opts ...store.UpdateOption) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return r.put(tx, key, obj)
+	})
+}
+
+func (r *bstore) UpdateWithKeyFn(key store.Key, updateFunc func(obj runtime.Unstructured, opts ...store.UpdateOption) runtime.Unstructured) {
+	if updateFunc == nil {
+		return
+	}
+	_ = r.db.Update(func(tx *bolt.Tx) error {
+		obj, err := r.get(tx, key)
+		if err != nil {
+			obj = nil
+		}
+		return r.put(tx, key, updateFunc(obj))
+	})
+}
+
+func (r *bstore) Delete(key store.Key, opts ...store.DeleteOption) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(r.bucket).Delete(encodeKey(key))
+	})
+}
+
+func (r *bstore) Watch(ctx context.Context, opts ...store.ListOption) (watch.WatchInterface[runtime.Unstructured], error) {
+	return nil, fmt.Errorf("watch is not supported by the bolt backend")
+}
+
+// Transaction applies the changes of the function atomically: either all changes are
+// stored or, when the function returns an error, none of them
+func (r *bstore) Transaction(fn func(txn Txn) error) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return fn(&txn{store: r, tx: tx})
+	})
+}
+
+func (r *bstore) get(tx *bolt.Tx, key store.Key) (runtime.Unstructured, error) {
+	b := tx.Bucket(r.bucket).Get(encodeKey(key))
+	if b == nil {
+		return nil, fmt.Errorf("%s, nsn: %s", NotFound, key.String())
+	}
+	return r.decode(b)
+}
+
+func (r *bstore) put(tx *bolt.Tx, key store.Key, obj runtime.Unstructured) error {
+	if obj == nil {
+		return fmt.Errorf("cannot store a nil object, nsn: %s", key.String())
+	}
+	b, err := json.Marshal(obj.UnstructuredContent())
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(r.bucket).Put(encodeKey(key), b)
+}
+
+func (r *bstore) decode(b []byte) (runtime.Unstructured, error) {
+	content := map[string]any{}
+	if err := json.Unmarshal(b, &content); err != nil {
+		return nil, err
+	}
+	obj := r.new()
+	obj.SetUnstructuredContent(content)
+	return obj, nil
+}
+
+// encodeKey returns the key of the object in the bucket; cluster scoped
+// objects have an empty namespace
+func encodeKey(key store.Key) []byte {
+	return []byte(key.Namespace + "/" + key.Name)
+}
+
+func decodeKey(b []byte) store.Key {
+	namespace, name, _ := strings.Cut(string(b), "/")
+	return store.KeyFromNSN(types.NamespacedName{Namespace: namespace, Name: name})
+}
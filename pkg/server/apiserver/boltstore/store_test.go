@@ -0,0 +1,202 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/util/testhelper"
+	bolt "go.etcd.io/bbolt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newTestStore(t *testing.T, dir, resource string) store.UnstructuredStore {
+	s, err := NewStore(&Config{
+		GroupResource: schema.GroupResource{Group: "example.com", Resource: resource},
+		RootPath:      dir,
+		NewFunc:       func() runtime.Unstructured { return &unstructured.Unstructured{} },
+	})
+	if err != nil {
+		t.Fatalf("cannot create bolt store: %v", err)
+	}
+	return s
+}
+
+func key(namespace, name string) store.Key {
+	return store.KeyFromNSN(types.NamespacedName{Namespace: namespace, Name: name})
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	t.Cleanup(func() { _ = Close(dir) })
+
+	s := newTestStore(t, dir, "dummies")
+	for _, k := range []store.Key{key("a", "site"), key("b", "site"), key("", "site")} {
+		if err := s.Create(k, testhelper.NewObject(k.Namespace, k.Name)); err != nil {
+			t.Fatalf("unexpected create error: %v", err)
+		}
+	}
+	if err := s.Create(key("a", "site"), testhelper.NewObject("a", "site")); err == nil {
+		t.Fatalf("expected a duplicate entry error")
+	}
+	// a store of another resource in the same database file
+	other := newTestStore(t, dir, "others")
+	if err := other.Create(key("a", "site"), testhelper.NewObject("a", "site")); err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+
+	// the data survives reopening the database
+	if err := Close(dir); err != nil {
+		t.Fatalf("unexpected close error: %v", err)
+	}
+	s = newTestStore(t, dir, "dummies")
+
+	cases := map[string]struct {
+		key         store.Key
+		expectedErr bool
+	}{
+		"Namespaced": {
+			key: key("a", "site"),
+		},
+		"SameNameOtherNamespace": {
+			key: key("b", "site"),
+		},
+		"ClusterScoped": {
+			key: key("", "site"),
+		},
+		"WrongNamespace": {
+			key:         key("c", "site"),
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj, err := s.Get(tc.key)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error for %s", tc.key.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected get error: %v", err)
+			}
+			u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+			if u.GetNamespace() != tc.key.Namespace || u.GetName() != tc.key.Name {
+				t.Errorf("expected %s, got %s/%s", tc.key.String(), u.GetNamespace(), u.GetName())
+			}
+		})
+	}
+
+	if s.Len() != 3 {
+		t.Errorf("expected 3 objects, got %d", s.Len())
+	}
+	if err := s.Delete(key("b", "site")); err != nil {
+		t.Fatalf("unexpected delete error: %v", err)
+	}
+	if _, err := s.Get(key("b", "site")); err == nil {
+		t.Errorf("expected a not found error after delete")
+	}
+}
+
+func TestTransaction(t *testing.T) {
+	dir := t.TempDir()
+	t.Cleanup(func() { _ = Close(dir) })
+
+	s := newTestStore(t, dir, "dummies")
+	other := newTestStore(t, dir, "others")
+
+	cases := map[string]struct {
+		err         error
+		expectedLen int
+	}{
+		"Commit": {
+			expectedLen: 2,
+		},
+		"Rollback": {
+			err:         fmt.Errorf("abort"),
+			expectedLen: 0,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			namespace := name
+			err := s.(Transactional).Transaction(func(txn Txn) error {
+				for _, n := range []string{"x", "y"} {
+					if err := txn.Apply(key(namespace, n), testhelper.NewObject(namespace, n)); err != nil {
+						return err
+					}
+				}
+				otherTxn, err := txn.Store(other)
+				if err != nil {
+					return err
+				}
+				if err := otherTxn.Apply(key(namespace, "z"), testhelper.NewObject(namespace, "z")); err != nil {
+					return err
+				}
+				return tc.err
+			})
+			if (err != nil) != (tc.err != nil) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			n := 0
+			s.List(func(k store.Key, _ runtime.Unstructured) {
+				if k.Namespace == namespace {
+					n++
+				}
+			})
+			if n != tc.expectedLen {
+				t.Errorf("expected %d objects, got %d", tc.expectedLen, n)
+			}
+			_, err = other.Get(key(namespace, "z"))
+			if (err == nil) != (tc.expectedLen > 0) {
+				t.Errorf("expected the other store to follow the transaction, err: %v", err)
+			}
+		})
+	}
+}
+
+func TestListUndecodable(t *testing.T) {
+	dir := t.TempDir()
+	t.Cleanup(func() { _ = Close(dir) })
+
+	s := newTestStore(t, dir, "dummies")
+	if err := s.Create(key("a", "good"), testhelper.NewObject("a", "good")); err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+	bs := s.(*bstore)
+	if err := bs.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bs.bucket).Put(encodeKey(key("a", "bad")), []byte("{invalid"))
+	}); err != nil {
+		t.Fatalf("unexpected put error: %v", err)
+	}
+
+	keys := s.ListKeys()
+	if diff := cmp.Diff([]string{"good"}, keys); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+	if n := s.Len(&store.ListOptions{Commit: &object.Commit{}}); n != 0 {
+		t.Errorf("expected no objects when reading a commit, got %d", n)
+	}
+}
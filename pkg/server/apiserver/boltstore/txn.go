@@ -0,0 +1,66 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package boltstore
+
+import (
+	"fmt"
+
+	"github.com/henderiw/store"
+	bolt "go.etcd.io/bbolt"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Transactional is implemented by stores that write multiple objects atomically
+type Transactional interface {
+	Transaction(fn func(txn Txn) error) error
+}
+
+// Txn provides the operations of a transaction; the changes are only
+// visible to other readers once the transaction is committed
+type Txn interface {
+	Get(key store.Key) (runtime.Unstructured, error)
+	Apply(key store.Key, obj runtime.Unstructured) error
+	Delete(key store.Key) error
+	// Store returns the transaction on the store of another resource in the same database,
+	// such that objects of different resources can be written atomically
+	Store(other store.UnstructuredStore) (Txn, error)
+}
+
+type txn struct {
+	store *bstore
+	tx    *bolt.Tx
+}
+
+func (r *txn) Get(key store.Key) (runtime.Unstructured, error) {
+	return r.store.get(r.tx, key)
+}
+
+func (r *txn) Apply(key store.Key, obj runtime.Unstructured) error {
+	return r.store.put(r.tx, key, obj)
+}
+
+func (r *txn) Delete(key store.Key) error {
+	return r.tx.Bucket(r.store.bucket).Delete(encodeKey(key))
+}
+
+func (r *txn) Store(other store.UnstructuredStore) (Txn, error) {
+	s, ok := other.(*bstore)
+	if !ok || s.db != r.store.db {
+		return nil, fmt.Errorf("store is not part of the same database")
+	}
+	return &txn{store: s, tx: r.tx}, nil
+}
@@ -0,0 +1,469 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package garbagecollector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/henderiw/logger/log"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
+	"github.com/kform-dev/choreo/pkg/server/selector"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	origin = "garbagecollector"
+	// pollInterval is the interval used to check if the dependents of an owner are gone
+	pollInterval = 100 * time.Millisecond
+	// foregroundTimeout bounds the wait for the deletion of the dependents of an owner
+	foregroundTimeout = 30 * time.Second
+	// scanInterval is the interval used to look for dependents of owners that are gone
+	scanInterval = 5 * time.Second
+)
+
+// GarbageCollector deletes the dependents of an owner based on the ownerReferences
+// of the objects stored in the apis of a branch
+type GarbageCollector interface {
+	// Start runs the garbage collector that deletes the dependents of the owners
+	// that were deleted in the background; the stored objects are scanned periodically
+	// such that the dependents of owners deleted by reconcilers, the runner or the
+	// removal of finalizers are collected as well
+	Start(ctx context.Context)
+	Stop()
+	// Enqueue schedules the deletion of the dependents of the owner once the owner is gone
+	Enqueue(owner Owner)
+	// DeleteDependents deletes the dependents of the owner and blocks till they are gone
+	DeleteDependents(ctx context.Context, owner Owner) error
+	// OrphanDependents removes the owner from the ownerReferences of its dependents
+	OrphanDependents(ctx context.Context, owner Owner) error
+}
+
+// Owner identifies an object that can be referenced in the ownerReferences of other objects
+type Owner struct {
+	schema.GroupKind
+	types.NamespacedName
+	UID types.UID
+}
+
+func (r Owner) String() string {
+	return fmt.Sprintf("%s %s", r.GroupKind.String(), r.NamespacedName.String())
+}
+
+// OwnerFromObject returns the owner identity of the object
+func OwnerFromObject(gk schema.GroupKind, u *unstructured.Unstructured) Owner {
+	return Owner{
+		GroupKind:      gk,
+		NamespacedName: types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()},
+		UID:            u.GetUID(),
+	}
+}
+
+// isOwnerOf returns true if the ownerReference of an object in the namespace refers to the owner.
+// Owner references don't carry a namespace, so a namespaced owner only owns objects
+// in its own namespace, while a cluster scoped owner owns objects in any namespace.
+func (r Owner) isOwnerOf(namespace string, ref metav1.OwnerReference) bool {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return false
+	}
+	if gv.Group != r.Group || ref.Kind != r.Kind || ref.Name != r.Name {
+		return false
+	}
+	if r.Namespace != "" && r.Namespace != namespace {
+		return false
+	}
+	if r.UID != "" && ref.UID != "" && r.UID != ref.UID {
+		return false
+	}
+	return true
+}
+
+func New(apiStore *api.APIStore) GarbageCollector {
+	return &gc{
+		apiStore: apiStore,
+	}
+}
+
+type gc struct {
+	apiStore *api.APIStore
+
+	m      sync.Mutex
+	queue  workqueue.TypedRateLimitingInterface[Owner]
+	cancel context.CancelFunc
+	// missing are the owners that were not found by the previous scan
+	missing sets.Set[Owner]
+}
+
+func (r *gc) Start(ctx context.Context) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.cancel != nil {
+		// already running
+		return
+	}
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.queue = workqueue.NewTypedRateLimitingQueueWithConfig(
+		workqueue.DefaultTypedControllerRateLimiter[Owner](),
+		workqueue.TypedRateLimitingQueueConfig[Owner]{
+			Name: origin,
+		})
+	queue := r.queue
+	go func() {
+		<-ctx.Done()
+		queue.ShutDown()
+	}()
+	go func() {
+		for r.processNextWorkItem(ctx, queue) {
+		}
+	}()
+	go func() {
+		ticker := time.NewTicker(scanInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.scan(ctx)
+			}
+		}
+	}()
+}
+
+func (r *gc) Stop() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+}
+
+func (r *gc) Enqueue(owner Owner) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.queue != nil {
+		r.queue.Add(owner)
+	}
+}
+
+func (r *gc) processNextWorkItem(ctx context.Context, queue workqueue.TypedRateLimitingInterface[Owner]) bool {
+	log := log.FromContext(ctx)
+	owner, shutdown := queue.Get()
+	if shutdown {
+		return false
+	}
+	defer queue.Done(owner)
+
+	exists, err := r.exists(ctx, owner)
+	if err != nil {
+		log.Error("garbage collection failed", "owner", owner.String(), "error", err)
+		queue.AddRateLimited(owner)
+		return true
+	}
+	if exists {
+		// the owner is not gone yet, e.g. it has pending finalizers
+		queue.AddRateLimited(owner)
+		return true
+	}
+	if err := r.deleteDependents(ctx, owner, r.Enqueue); err != nil {
+		log.Error("garbage collection failed", "owner", owner.String(), "error", err)
+		queue.AddRateLimited(owner)
+		return true
+	}
+	queue.Forget(owner)
+	return true
+}
+
+func (r *gc) DeleteDependents(ctx context.Context, owner Owner) error {
+	ctx, cancel := context.WithTimeout(ctx, foregroundTimeout)
+	defer cancel()
+	deleteFn := func(dependent Owner) {
+		if err := r.DeleteDependents(ctx, dependent); err != nil {
+			log.FromContext(ctx).Error("foreground deletion failed", "owner", dependent.String(), "error", err)
+		}
+	}
+	if err := r.deleteDependents(ctx, owner, deleteFn); err != nil {
+		return err
+	}
+
+	// wait till the dependents are gone, dependents with finalizers
+	// are only gone once their finalizers are removed
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		dependents, err := r.dependents(ctx, owner)
+		if err != nil {
+			return err
+		}
+		if len(dependents) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return status.Errorf(codes.DeadlineExceeded, "%s is waiting for the deletion of %d dependents", owner.String(), len(dependents))
+		case <-ticker.C:
+		}
+	}
+}
+
+// deleteDependents deletes the dependents of the owner; dependents that have other owners
+// which still exist are not deleted, but the owner is removed from their ownerReferences.
+// cascade is called with the dependents that are deleted such that their dependents
+// are deleted as well.
+func (r *gc) deleteDependents(ctx context.Context, owner Owner, cascade func(Owner)) error {
+	dependents, err := r.dependents(ctx, owner)
+	if err != nil {
+		return err
+	}
+	var errm error
+	for _, dep := range dependents {
+		remainingOwners, err := r.hasRemainingOwners(ctx, owner, dep.obj)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			continue
+		}
+		if remainingOwners {
+			if err := r.orphan(ctx, owner, dep); err != nil {
+				errm = errors.Join(errm, err)
+			}
+			continue
+		}
+		// the dependents of the dependent are deleted before the dependent,
+		// in case of background deletion they are enqueued
+		depOwner := OwnerFromObject(dep.gk, dep.obj)
+		cascade(depOwner)
+		if _, err := dep.storage.Delete(ctx, depOwner.NamespacedName, &rest.DeleteOptions{
+			Origin: origin,
+		}); err != nil && !grpcerrors.IsNotFound(err) {
+			errm = errors.Join(errm, err)
+		}
+	}
+	return errm
+}
+
+func (r *gc) OrphanDependents(ctx context.Context, owner Owner) error {
+	dependents, err := r.dependents(ctx, owner)
+	if err != nil {
+		return err
+	}
+	var errm error
+	for _, dep := range dependents {
+		if err := r.orphan(ctx, owner, dep); err != nil {
+			errm = errors.Join(errm, err)
+		}
+	}
+	return errm
+}
+
+// scan enqueues the owners that are referenced by the stored objects but no longer exist;
+// an owner is only collected when it is missing in 2 consecutive scans, such that
+// dependents that are stored before their owner, e.g. while loading, are retained
+func (r *gc) scan(ctx context.Context) {
+	log := log.FromContext(ctx)
+	owners := sets.New[Owner]()
+	for _, rctx := range r.getResourceContexts() {
+		ul, err := r.list(ctx, rctx, "", nil)
+		if err != nil {
+			log.Error("garbage collection scan failed", "kind", rctx.GV().String(), "error", err)
+			continue
+		}
+		for i := range ul.Items {
+			u := &ul.Items[i]
+			for _, ref := range u.GetOwnerReferences() {
+				if owner, ok := r.ownerFromRef(u.GetNamespace(), ref); ok {
+					owners.Insert(owner)
+				}
+			}
+		}
+	}
+	missing := sets.New[Owner]()
+	for owner := range owners {
+		exists, err := r.exists(ctx, owner)
+		if err != nil {
+			log.Error("garbage collection scan failed", "owner", owner.String(), "error", err)
+			continue
+		}
+		if exists {
+			continue
+		}
+		if r.missing.Has(owner) {
+			r.Enqueue(owner)
+			continue
+		}
+		missing.Insert(owner)
+	}
+	r.missing = missing
+}
+
+func (r *gc) orphan(ctx context.Context, owner Owner, dep *dependent) error {
+	refs := []metav1.OwnerReference{}
+	for _, ref := range dep.obj.GetOwnerReferences() {
+		if !owner.isOwnerOf(dep.obj.GetNamespace(), ref) {
+			refs = append(refs, ref)
+		}
+	}
+	dep.obj.SetOwnerReferences(refs)
+	if _, err := dep.storage.Update(ctx, dep.obj, &rest.UpdateOptions{
+		Origin: origin,
+	}); err != nil && !grpcerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+type dependent struct {
+	gk      schema.GroupKind
+	storage rest.Storage
+	obj     *unstructured.Unstructured
+}
+
+// dependents returns the objects that have an ownerReference to the owner
+func (r *gc) dependents(ctx context.Context, owner Owner) ([]*dependent, error) {
+	// the candidates are resolved by the ownerReferences index of the storage
+	req, err := selector.NewRequirement(fmt.Sprintf("metadata.ownerReferences.exists(ref, ref.name == %q)", owner.Name), resourcepb.Operator_Equals, []string{"true"})
+	if err != nil {
+		return nil, err
+	}
+	sel := selector.NewSelector().Add(req)
+
+	dependents := []*dependent{}
+	for _, rctx := range r.getResourceContexts() {
+		// a namespaced owner only owns objects in its own namespace
+		ul, err := r.list(ctx, rctx, owner.Namespace, sel)
+		if err != nil {
+			return nil, err
+		}
+		for i := range ul.Items {
+			u := &ul.Items[i]
+			for _, ref := range u.GetOwnerReferences() {
+				if owner.isOwnerOf(u.GetNamespace(), ref) {
+					dependents = append(dependents, &dependent{gk: rctx.GV(), storage: rctx.Storage, obj: u})
+					break
+				}
+			}
+		}
+	}
+	return dependents, nil
+}
+
+// hasRemainingOwners returns true if the object has owners, other than the owner, that still exist
+func (r *gc) hasRemainingOwners(ctx context.Context, owner Owner, u *unstructured.Unstructured) (bool, error) {
+	for _, ref := range u.GetOwnerReferences() {
+		if owner.isOwnerOf(u.GetNamespace(), ref) {
+			continue
+		}
+		other, ok := r.ownerFromRef(u.GetNamespace(), ref)
+		if !ok {
+			// the api of the owner is not known, so the owner cannot exist
+			continue
+		}
+		exists, err := r.exists(ctx, other)
+		if err != nil {
+			return false, err
+		}
+		if exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ownerFromRef returns the owner the ownerReference of an object in the namespace refers to;
+// ok is false when the api of the owner is not known
+func (r *gc) ownerFromRef(namespace string, ref metav1.OwnerReference) (Owner, bool) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return Owner{}, false
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: ref.Kind}
+	rctx, err := r.apiStore.Get(gk)
+	if err != nil {
+		return Owner{}, false
+	}
+	if rctx.External != nil && !rctx.External.Namespaced {
+		namespace = ""
+	}
+	return Owner{
+		GroupKind:      gk,
+		NamespacedName: types.NamespacedName{Namespace: namespace, Name: ref.Name},
+		UID:            ref.UID,
+	}, true
+}
+
+// exists returns true if the owner is found in the storage; an object with the same name
+// but a different uid is a new incarnation and does not count as the owner
+func (r *gc) exists(ctx context.Context, owner Owner) (bool, error) {
+	storage, err := r.apiStore.GetStorage(owner.GroupKind)
+	if err != nil {
+		return false, nil
+	}
+	obj, err := storage.Get(ctx, owner.NamespacedName, &rest.GetOptions{
+		Origin: origin,
+	})
+	if err != nil {
+		if grpcerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	if owner.UID != "" && u.GetUID() != "" && owner.UID != u.GetUID() {
+		return false, nil
+	}
+	return true, nil
+}
+
+func (r *gc) getResourceContexts() []*api.ResourceContext {
+	// the resource contexts are collected first since a store operation
+	// within the list callback would deadlock
+	rctxs := []*api.ResourceContext{}
+	r.apiStore.List(func(k store.Key, rctx *api.ResourceContext) {
+		rctxs = append(rctxs, rctx)
+	})
+	return rctxs
+}
+
+func (r *gc) list(ctx context.Context, rctx *api.ResourceContext, namespace string, sel selector.Selector) (*unstructured.UnstructuredList, error) {
+	obj, err := rctx.Storage.List(ctx, &rest.ListOptions{
+		ShowManagedFields: true, // the resourceVersion is needed to update the object
+		Origin:            origin,
+		Namespace:         namespace,
+		Selector:          sel,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ul := &unstructured.UnstructuredList{}
+	ul.SetUnstructuredContent(obj.UnstructuredContent())
+	return ul, nil
+}
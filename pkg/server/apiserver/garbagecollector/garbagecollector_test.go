@@ -0,0 +1,372 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package garbagecollector
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestIsOwnerOf(t *testing.T) {
+	owner := func(namespace string, uid types.UID) Owner {
+		return Owner{
+			GroupKind:      schema.GroupKind{Group: "example.com", Kind: "Topology"},
+			NamespacedName: types.NamespacedName{Namespace: namespace, Name: "topo"},
+			UID:            uid,
+		}
+	}
+	ref := func(apiVersion, kind, name string, uid types.UID) metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: apiVersion, Kind: kind, Name: name, UID: uid}
+	}
+
+	cases := map[string]struct {
+		owner     Owner
+		namespace string
+		ref       metav1.OwnerReference
+		expected  bool
+	}{
+		"SameNamespace": {
+			owner:     owner("default", ""),
+			namespace: "default",
+			ref:       ref("example.com/v1alpha1", "Topology", "topo", ""),
+			expected:  true,
+		},
+		"OtherVersion": {
+			owner:     owner("default", ""),
+			namespace: "default",
+			ref:       ref("example.com/v1", "Topology", "topo", ""),
+			expected:  true,
+		},
+		"OtherNamespace": {
+			owner:     owner("default", ""),
+			namespace: "other",
+			ref:       ref("example.com/v1alpha1", "Topology", "topo", ""),
+			expected:  false,
+		},
+		"ClusterScopedOwner": {
+			owner:     owner("", ""),
+			namespace: "other",
+			ref:       ref("example.com/v1alpha1", "Topology", "topo", ""),
+			expected:  true,
+		},
+		"OtherKind": {
+			owner:     owner("default", ""),
+			namespace: "default",
+			ref:       ref("example.com/v1alpha1", "Node", "topo", ""),
+			expected:  false,
+		},
+		"OtherGroup": {
+			owner:     owner("default", ""),
+			namespace: "default",
+			ref:       ref("other.com/v1alpha1", "Topology", "topo", ""),
+			expected:  false,
+		},
+		"SameUID": {
+			owner:     owner("default", "a"),
+			namespace: "default",
+			ref:       ref("example.com/v1alpha1", "Topology", "topo", "a"),
+			expected:  true,
+		},
+		"OtherUID": {
+			owner:     owner("default", "a"),
+			namespace: "default",
+			ref:       ref("example.com/v1alpha1", "Topology", "topo", "b"),
+			expected:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.owner.isOwnerOf(tc.namespace, tc.ref); got != tc.expected {
+				t.Errorf("want %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+// testStorage is an in memory storage of a single kind
+type testStorage struct {
+	m       sync.Mutex
+	objects map[types.NamespacedName]*unstructured.Unstructured
+}
+
+var _ rest.Storage = &testStorage{}
+
+func newTestStorage() *testStorage {
+	return &testStorage{objects: map[types.NamespacedName]*unstructured.Unstructured{}}
+}
+
+func (r *testStorage) Get(ctx context.Context, key types.NamespacedName, opts ...rest.GetOption) (runtime.Unstructured, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	u, ok := r.objects[key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s not found", key.String())
+	}
+	return u.DeepCopy(), nil
+}
+
+func (r *testStorage) List(ctx context.Context, opts ...rest.ListOption) (runtime.Unstructured, error) {
+	o := rest.ListOptions{}
+	o.ApplyOptions(opts)
+	r.m.Lock()
+	defer r.m.Unlock()
+	ul := &unstructured.UnstructuredList{Object: map[string]any{}}
+	for key, u := range r.objects {
+		if !o.MatchesNamespace(key.Namespace) {
+			continue
+		}
+		if o.Selector != nil && !o.Selector.Matches(u.Object) {
+			continue
+		}
+		ul.Items = append(ul.Items, *u.DeepCopy())
+	}
+	return ul, nil
+}
+
+func (r *testStorage) Create(ctx context.Context, obj runtime.Unstructured, opts ...rest.CreateOption) (runtime.Unstructured, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	u := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(obj.UnstructuredContent())}
+	r.objects[types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}] = u
+	return u, nil
+}
+
+func (r *testStorage) Update(ctx context.Context, obj runtime.Unstructured, opts ...rest.UpdateOption) (runtime.Unstructured, error) {
+	return r.Create(ctx, obj)
+}
+
+func (r *testStorage) Apply(ctx context.Context, obj runtime.Unstructured, opts ...rest.ApplyOption) (runtime.Unstructured, error) {
+	return r.Create(ctx, obj)
+}
+
+func (r *testStorage) Delete(ctx context.Context, key types.NamespacedName, opts ...rest.DeleteOption) (runtime.Unstructured, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	u, ok := r.objects[key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s not found", key.String())
+	}
+	delete(r.objects, key)
+	return u, nil
+}
+
+func (r *testStorage) Watch(ctx context.Context, opts ...rest.ListOption) (watch.Interface, error) {
+	return nil, status.Errorf(codes.Unimplemented, "watch not implemented")
+}
+
+func (r *testStorage) names() []string {
+	r.m.Lock()
+	defer r.m.Unlock()
+	names := []string{}
+	for key := range r.objects {
+		names = append(names, key.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func testOwnedObject(kind, name string, owners ...string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("example.com/v1alpha1")
+	u.SetKind(kind)
+	u.SetNamespace("default")
+	u.SetName(name)
+	u.SetUID(types.UID(name))
+	refs := []metav1.OwnerReference{}
+	for _, owner := range owners {
+		refs = append(refs, metav1.OwnerReference{APIVersion: "example.com/v1alpha1", Kind: "Topology", Name: owner, UID: types.UID(owner)})
+	}
+	u.SetOwnerReferences(refs)
+	return u
+}
+
+// newTestGarbageCollector returns a garbage collector for the topologies
+// and the nodes they own
+func newTestGarbageCollector(t *testing.T) (*gc, *testStorage, *testStorage) {
+	t.Helper()
+	ctx := context.Background()
+	apiStore := api.NewAPIStore()
+	topologies := newTestStorage()
+	nodes := newTestStorage()
+	for kind, storage := range map[string]*testStorage{"Topology": topologies, "Node": nodes} {
+		if err := apiStore.Apply(schema.GroupKind{Group: "example.com", Kind: kind}, &api.ResourceContext{
+			External: &discoverypb.APIResource{Group: "example.com", Version: "v1alpha1", Kind: kind, Namespaced: true},
+			Storage:  storage,
+		}); err != nil {
+			t.Fatalf("apply api failed: %v", err)
+		}
+	}
+	for _, u := range []*unstructured.Unstructured{
+		testOwnedObject("Topology", "topo1"),
+		testOwnedObject("Topology", "topo2"),
+	} {
+		if _, err := topologies.Create(ctx, u); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+	}
+	for _, u := range []*unstructured.Unstructured{
+		testOwnedObject("Node", "node1", "topo1"),
+		testOwnedObject("Node", "node2", "topo1", "topo2"),
+		testOwnedObject("Node", "node3", "topo2"),
+	} {
+		if _, err := nodes.Create(ctx, u); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+	}
+	return New(apiStore).(*gc), topologies, nodes
+}
+
+func waitForNames(t *testing.T, storage *testStorage, expected []string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got := storage.names()
+		diff := cmp.Diff(expected, got)
+		if diff == "" {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("-want, +got:\n%s", diff)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func TestDeletion(t *testing.T) {
+	topo1 := Owner{
+		GroupKind:      schema.GroupKind{Group: "example.com", Kind: "Topology"},
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "topo1"},
+		UID:            "topo1",
+	}
+
+	cases := map[string]struct {
+		// deleteFn deletes topo1 and its dependents
+		deleteFn      func(ctx context.Context, g *gc, topologies *testStorage) error
+		expectedNodes []string
+		expectedRefs  []metav1.OwnerReference
+	}{
+		"Background": {
+			deleteFn: func(ctx context.Context, g *gc, topologies *testStorage) error {
+				if _, err := topologies.Delete(ctx, topo1.NamespacedName); err != nil {
+					return err
+				}
+				g.Enqueue(topo1)
+				return nil
+			},
+			// node2 is retained since topo2 still exists
+			expectedNodes: []string{"node2", "node3"},
+			expectedRefs:  []metav1.OwnerReference{{APIVersion: "example.com/v1alpha1", Kind: "Topology", Name: "topo2", UID: "topo2"}},
+		},
+		"BackgroundScan": {
+			// the owner is deleted without enqueueing it, e.g. by a reconciler
+			deleteFn: func(ctx context.Context, g *gc, topologies *testStorage) error {
+				if _, err := topologies.Delete(ctx, topo1.NamespacedName); err != nil {
+					return err
+				}
+				// the owner is collected once it is missing in 2 scans
+				g.scan(ctx)
+				g.scan(ctx)
+				return nil
+			},
+			expectedNodes: []string{"node2", "node3"},
+			expectedRefs:  []metav1.OwnerReference{{APIVersion: "example.com/v1alpha1", Kind: "Topology", Name: "topo2", UID: "topo2"}},
+		},
+		"Foreground": {
+			deleteFn: func(ctx context.Context, g *gc, topologies *testStorage) error {
+				if err := g.DeleteDependents(ctx, topo1); err != nil {
+					return err
+				}
+				_, err := topologies.Delete(ctx, topo1.NamespacedName)
+				return err
+			},
+			expectedNodes: []string{"node2", "node3"},
+			expectedRefs:  []metav1.OwnerReference{{APIVersion: "example.com/v1alpha1", Kind: "Topology", Name: "topo2", UID: "topo2"}},
+		},
+		"Orphan": {
+			deleteFn: func(ctx context.Context, g *gc, topologies *testStorage) error {
+				if err := g.OrphanDependents(ctx, topo1); err != nil {
+					return err
+				}
+				_, err := topologies.Delete(ctx, topo1.NamespacedName)
+				return err
+			},
+			expectedNodes: []string{"node1", "node2", "node3"},
+			expectedRefs:  []metav1.OwnerReference{{APIVersion: "example.com/v1alpha1", Kind: "Topology", Name: "topo2", UID: "topo2"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			g, topologies, nodes := newTestGarbageCollector(t)
+			g.Start(ctx)
+			defer g.Stop()
+
+			if err := tc.deleteFn(ctx, g, topologies); err != nil {
+				t.Fatalf("delete failed: %v", err)
+			}
+			waitForNames(t, nodes, tc.expectedNodes)
+
+			node2, err := nodes.Get(ctx, types.NamespacedName{Namespace: "default", Name: "node2"})
+			if err != nil {
+				t.Fatalf("get failed: %v", err)
+			}
+			refs := (&unstructured.Unstructured{Object: node2.UnstructuredContent()}).GetOwnerReferences()
+			if diff := cmp.Diff(tc.expectedRefs, refs); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScanRetainsOwnerStoredLater(t *testing.T) {
+	ctx := context.Background()
+	g, topologies, nodes := newTestGarbageCollector(t)
+	g.Start(ctx)
+	defer g.Stop()
+
+	// node4 is stored before its owner, the owner is stored before the next scan
+	if _, err := nodes.Create(ctx, testOwnedObject("Node", "node4", "topo3")); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	g.scan(ctx)
+	if _, err := topologies.Create(ctx, testOwnedObject("Topology", "topo3")); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	g.scan(ctx)
+	// give the garbage collector the time to process a wrongly enqueued owner
+	time.Sleep(5 * pollInterval)
+	waitForNames(t, nodes, []string{"node1", "node2", "node3", "node4"})
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/henderiw/logger/log"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
 	"github.com/kform-dev/choreo/pkg/util/object"
 	"google.golang.org/grpc/codes"
@@ -32,6 +33,7 @@ import (
 func (r *storage) Apply(ctx context.Context, new runtime.Unstructured, opts ...rest.ApplyOption) (runtime.Unstructured, error) {
 	o := rest.ApplyOptions{}
 	o.ApplyOptions(opts)
+	m := mutation{operation: auditpb.Operation_APPLY, origin: o.Origin, fieldManager: o.FieldManager}
 
 	log := log.FromContext(ctx)
 
@@ -43,19 +45,32 @@ func (r *storage) Apply(ctx context.Context, new runtime.Unstructured, opts ...r
 		return nil, status.Errorf(codes.Internal, "cannot apply a resource without a fieldmanager")
 	}
 
+	if err := r.validateSubresource(o.Subresource); err != nil {
+		return nil, err
+	}
+	fieldManager := r.fieldManager
+	if o.Subresource == rest.SubresourceStatus {
+		fieldManager = r.statusFieldManager
+	}
+
 	newObjectMeta, err := meta.Accessor(new)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot access objectMeta err: %s", err.Error())
 	}
+	r.defaultNamespace(newObjectMeta)
 
 	u := &unstructured.Unstructured{Object: new.UnstructuredContent()}
 	log.Debug("apply choreoapiserver", "apiVersion", u.GetAPIVersion(), "kind", u.GetKind(), "name", u.GetName())
 
-	old, err := r.Get(ctx, newObjectMeta.GetName(), &rest.GetOptions{
+	old, err := r.Get(ctx, getKey(newObjectMeta), &rest.GetOptions{
 		ShowManagedFields: true,
 		Trace:             "apply",
 	})
 	if err != nil {
+		if o.Subresource != "" {
+			// the subresource of an object that does not exist cannot be applied
+			return nil, err
+		}
 		// Create an empty object we supply to the Apply fieldmanager as the liveObject
 		// this ensures the fieldmanager does not add the before-first-apply manager as the operation
 
@@ -68,7 +83,7 @@ func (r *storage) Apply(ctx context.Context, new runtime.Unstructured, opts ...r
 		if !ok {
 			return nil, status.Errorf(codes.Internal, "fieldmanager does not return an unstructured object")
 		}
-		return r.Create(ctx, uobj, &rest.CreateOptions{DryRun: o.DryRun})
+		return r.create(ctx, uobj, &rest.CreateOptions{DryRun: o.DryRun, Recursion: o.Recursion}, m)
 	}
 	oldu := &unstructured.Unstructured{
 		Object: old.UnstructuredContent(),
@@ -81,7 +96,7 @@ func (r *storage) Apply(ctx context.Context, new runtime.Unstructured, opts ...r
 	//newu.SetResourceVersion(oldu.GetResourceVersion())
 	//newu.SetGeneration(oldu.GetGeneration())
 
-	newobj, err := r.fieldManager.Apply(old, new, o.FieldManager, o.Force)
+	newobj, err := fieldManager.Apply(old, new, o.FieldManager, o.Force)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "fieldmanager apply failed err: %s", err.Error())
 	}
@@ -89,6 +104,11 @@ func (r *storage) Apply(ctx context.Context, new runtime.Unstructured, opts ...r
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "fieldmanager does not return an unstructured object")
 	}
+	if o.Subresource == rest.SubresourceStatus {
+		new = r.updateStrategy.PrepareForStatusUpdate(ctx, new, old)
+	} else {
+		r.updateStrategy.PrepareForUpdate(ctx, new, old)
+	}
 
-	return r.update(ctx, new, old, &rest.UpdateOptions{DryRun: o.DryRun})
+	return r.update(ctx, new, old, &rest.UpdateOptions{DryRun: o.DryRun, Recursion: o.Recursion}, m)
 }
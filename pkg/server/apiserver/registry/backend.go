@@ -0,0 +1,152 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/henderiw/store"
+	"github.com/henderiw/store/gitu"
+	"github.com/henderiw/store/memoryu"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
+	"github.com/kform-dev/kform/pkg/fsys"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Backend identifies the storage backend of the resources
+type Backend string
+
+const (
+	// BackendGit stores every object as a yaml file in the git repository
+	BackendGit Backend = "git"
+	// BackendBolt stores all objects in a single file embedded database
+	BackendBolt Backend = "bolt"
+	// BackendMemory keeps the objects in memory only
+	BackendMemory Backend = "memory"
+)
+
+var backends = []Backend{BackendGit, BackendBolt, BackendMemory}
+
+// ParseBackend validates the backend name; an empty name selects the git backend
+func ParseBackend(name string) (Backend, error) {
+	if name == "" {
+		return BackendGit, nil
+	}
+	for _, backend := range backends {
+		if string(backend) == name {
+			return backend, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported db backend %q, supported: %v", name, backends)
+}
+
+type StorageConfig struct {
+	Backend Backend
+	// PathInRepo is the path of the choreo instance in the repository
+	PathInRepo string
+	// DBPath is the path where the backend stores the data; an empty path
+	// keeps the data in memory irrespective of the backend
+	DBPath string
+}
+
+// backend returns the backend that stores the data
+func (r *StorageConfig) backend() Backend {
+	if r == nil || r.DBPath == "" {
+		return BackendMemory
+	}
+	if r.Backend == "" {
+		return BackendGit
+	}
+	return r.Backend
+}
+
+// readsCommits returns true when the backend can read the objects of a commit
+func (r Backend) readsCommits() bool {
+	return r != BackendBolt
+}
+
+func newStore(cfg *StorageConfig, gr schema.GroupResource, newFn NewFn) (store.UnstructuredStore, error) {
+	switch cfg.backend() {
+	case BackendMemory:
+		return memoryu.NewStore(), nil
+	case BackendBolt:
+		s, err := boltstore.NewStore(&boltstore.Config{
+			GroupResource: gr,
+			RootPath:      cfg.DBPath,
+			NewFunc:       newFn,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := importFiles(s, cfg, gr, newFn); err != nil {
+			return nil, err
+		}
+		return s, nil
+	case BackendGit:
+		s, err := gitu.NewStore(&gitu.Config{
+			GroupResource: gr,
+			PathInRepo:    cfg.PathInRepo,
+			RootPath:      cfg.DBPath,
+			NewFunc:       newFn,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return newNamespacedStore(s)
+	default:
+		return nil, fmt.Errorf("unsupported db backend %q, supported: %v", cfg.Backend, backends)
+	}
+}
+
+// importFiles loads the objects of the resource stored as yaml files in the db path into an
+// empty bolt store, such that the files are only read once when switching to the bolt backend.
+// The objects are written in a single transaction, a failed import leaves the store empty.
+func importFiles(s store.UnstructuredStore, cfg *StorageConfig, gr schema.GroupResource, newFn NewFn) error {
+	if s.Len() > 0 || !fsys.PathExists(filepath.Join(cfg.DBPath, gr.Group, gr.Resource)) {
+		return nil
+	}
+	fileStore, err := gitu.NewStore(&gitu.Config{
+		GroupResource: gr,
+		PathInRepo:    cfg.PathInRepo,
+		RootPath:      cfg.DBPath,
+		NewFunc:       newFn,
+	})
+	if err != nil {
+		return err
+	}
+	transactional, ok := s.(boltstore.Transactional)
+	if !ok {
+		return fmt.Errorf("cannot import the db files, store is not transactional")
+	}
+	return transactional.Transaction(func(txn boltstore.Txn) error {
+		var errm error
+		fileStore.List(func(_ store.Key, obj runtime.Unstructured) {
+			key, err := objectKey(obj)
+			if err != nil {
+				errm = errors.Join(errm, err)
+				return
+			}
+			if err := txn.Apply(key, obj); err != nil {
+				errm = errors.Join(errm, fmt.Errorf("cannot import %s, err: %v", key.String(), err))
+			}
+		})
+		return errm
+	})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
+	"github.com/kform-dev/choreo/pkg/util/testhelper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBoltImportFiles(t *testing.T) {
+	dir := t.TempDir()
+	t.Cleanup(func() { _ = boltstore.Close(dir) })
+
+	files, err := newNamespacedStore(newTestGitStore(t, dir))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, u := range []*unstructured.Unstructured{testhelper.NewObject("default", "a"), testhelper.NewObject("", "b")} {
+		if err := files.Apply(store.KeyFromNSN(types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}), u); err != nil {
+			t.Fatalf("unexpected apply error: %v", err)
+		}
+	}
+
+	cfg := &StorageConfig{Backend: BackendBolt, DBPath: dir}
+	gr := schema.GroupResource{Group: "example.com", Resource: "dummies"}
+	newFn := func() runtime.Unstructured { return &unstructured.Unstructured{} }
+	s, err := newStore(cfg, gr, newFn)
+	if err != nil {
+		t.Fatalf("unexpected store error: %v", err)
+	}
+	for _, nsn := range []types.NamespacedName{{Namespace: "default", Name: "a"}, {Name: "b"}} {
+		if _, err := s.Get(store.KeyFromNSN(nsn)); err != nil {
+			t.Errorf("want %s imported, got %v", nsn.String(), err)
+		}
+	}
+
+	// the files are only imported in an empty store
+	if err := s.Delete(store.KeyFromNSN(types.NamespacedName{Name: "b"})); err != nil {
+		t.Fatalf("unexpected delete error: %v", err)
+	}
+	if err := importFiles(s, cfg, gr, newFn); err != nil {
+		t.Fatalf("unexpected import error: %v", err)
+	}
+	if n := s.Len(); n != 1 {
+		t.Errorf("want 1 object, got %d", n)
+	}
+}
+
+func TestListCommit(t *testing.T) {
+	cases := map[string]struct {
+		backend      Backend
+		expectedCode codes.Code
+	}{
+		"Git": {
+			backend:      BackendGit,
+			expectedCode: codes.OK,
+		},
+		"Bolt": {
+			backend:      BackendBolt,
+			expectedCode: codes.Unimplemented,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, _ := newTestStorage(true)
+			s.backend = tc.backend
+			_, err := s.List(context.Background(), &rest.ListOptions{Commit: &object.Commit{}})
+			if status.Code(err) != tc.expectedCode {
+				t.Errorf("want %s, got %v", tc.expectedCode, err)
+			}
+		})
+	}
+}
@@ -21,9 +21,10 @@ import (
 
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
-	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -36,6 +37,10 @@ func (r *storage) Create(ctx context.Context, obj runtime.Unstructured, opts ...
 	o := rest.CreateOptions{}
 	o.ApplyOptions(opts)
 
+	return r.create(ctx, obj, &o, mutation{operation: auditpb.Operation_CREATE, origin: o.Origin})
+}
+
+func (r *storage) create(ctx context.Context, obj runtime.Unstructured, o *rest.CreateOptions, m mutation) (runtime.Unstructured, error) {
 	log := log.FromContext(ctx)
 	log.Debug("create choreoapiserver")
 
@@ -43,41 +48,45 @@ func (r *storage) Create(ctx context.Context, obj runtime.Unstructured, opts ...
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot access objectMeta err: %s", err.Error())
 	}
+	r.defaultNamespace(objectMeta)
 	objectMeta.SetCreationTimestamp(metav1.Now())
 	objectMeta.SetUID(uuid.NewUUID())
-	objectMeta.SetResourceVersion("0")
+	r.createStrategy.PrepareForCreate(ctx, obj)
 
 	if errs := r.createStrategy.ValidateCreate(ctx, obj); len(errs) > 0 {
 		log.Error("validation create failed", "obj", obj, "error", errs)
 		return nil, status.Errorf(codes.InvalidArgument, "validation create failed: %v", errs)
 	}
 
-	recursion := false
-	if len(o.DryRun) == 1 && o.DryRun[0] == "recursion" {
-		recursion = true
-		o.DryRun = []string{}
-	}
-
-	robj, err := r.createStrategy.InvokeCreate(ctx, obj, recursion)
-	if err != nil {
-		log.Error("invoke synchronous create failed", "obj", obj, "error", err)
-		return nil, status.Errorf(codes.InvalidArgument, "invoke synchronous create failed: %v", err)
+	// a dry run does not invoke the backend since the backend persists its own state
+	if !isDryRun(o.DryRun) {
+		robj, err := r.createStrategy.InvokeCreate(ctx, obj, o.Recursion)
+		if err != nil {
+			log.Error("invoke synchronous create failed", "obj", obj, "error", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invoke synchronous create failed: %v", err)
+		}
+		obj = robj.(runtime.Unstructured)
 	}
-	obj = robj.(runtime.Unstructured)
 
-	// namespace is ignore, name is assumed to be always present
-	key := objectMeta.GetName()
+	// name is assumed to be always present, namespace is empty for cluster scoped resources
+	key := getKey(objectMeta)
 
-	if len(o.DryRun) > 0 {
+	if isDryRun(o.DryRun) {
 		return obj, nil
 	}
 
-	if err := r.storage.Create(store.ToKey(key), obj); err != nil {
-		return obj, status.Errorf(codes.Internal, "err: %s", err.Error())
+	if err := r.write(ctx, resourcepb.Watch_ADDED, obj, nil, m, func() error {
+		return r.index.Transaction(func(txn boltstore.Txn) error {
+			if _, err := txn.Get(store.KeyFromNSN(key)); err == nil {
+				return status.Errorf(codes.AlreadyExists, "duplicate entry %s", key.String())
+			}
+			if err := txn.Apply(store.KeyFromNSN(key), obj); err != nil {
+				return status.Errorf(codes.Internal, "err: %s", err.Error())
+			}
+			return nil
+		})
+	}); err != nil {
+		return obj, err
 	}
-	r.notifyWatcher(ctx, watch.Event{
-		Type:   resourcepb.Watch_ADDED,
-		Object: obj,
-	})
 	return obj, nil
 }
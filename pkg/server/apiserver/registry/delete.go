@@ -22,25 +22,29 @@ import (
 
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
-	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
-func (r *storage) Delete(ctx context.Context, name string, opts ...rest.DeleteOption) (runtime.Unstructured, error) {
+func (r *storage) Delete(ctx context.Context, key types.NamespacedName, opts ...rest.DeleteOption) (runtime.Unstructured, error) {
 	o := rest.DeleteOptions{}
 	o.ApplyOptions(opts)
+	key = r.defaultKey(key)
+	m := mutation{operation: auditpb.Operation_DELETE, origin: o.Origin}
 
 	log := log.FromContext(ctx)
 	log.Debug("delete")
 
-	old, err := r.Get(ctx, name, &rest.GetOptions{
+	old, err := r.Get(ctx, key, &rest.GetOptions{
 		ShowManagedFields: true,
 		Trace:             "delete",
 	})
@@ -56,15 +60,11 @@ func (r *storage) Delete(ctx context.Context, name string, opts ...rest.DeleteOp
 		return nil, status.Errorf(codes.InvalidArgument, "cannot access objectMeta err: %s", err.Error())
 	}
 
-	recursion := false
-	if len(o.DryRun) == 1 && o.DryRun[0] == "recursion" {
-		recursion = true
-		o.DryRun = []string{}
-	}
-
-	_, err = r.deleteStrategy.InvokeDelete(ctx, old, recursion)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invoke synchronous delete failed: %v", err)
+	// a dry run does not invoke the backend since the backend persists its own state
+	if !isDryRun(o.DryRun) {
+		if _, err := r.deleteStrategy.InvokeDelete(ctx, old, o.Recursion); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invoke synchronous delete failed: %v", err)
+		}
 	}
 
 	pendingFinalizers := len(oldObjectMeta.GetFinalizers()) != 0
@@ -85,7 +85,7 @@ func (r *storage) Delete(ctx context.Context, name string, opts ...rest.DeleteOp
 			if !ok {
 				return nil, status.Errorf(codes.Internal, "fieldmanager does not return an unstructured object")
 			}
-			obj, err := r.update(ctx, new, old, &rest.UpdateOptions{DryRun: o.DryRun})
+			obj, err := r.update(ctx, new, old, &rest.UpdateOptions{DryRun: o.DryRun, Recursion: o.Recursion}, m)
 			if err != nil {
 				return nil, err
 			}
@@ -97,17 +97,25 @@ func (r *storage) Delete(ctx context.Context, name string, opts ...rest.DeleteOp
 		return old, nil
 	}
 
-	if len(o.DryRun) > 0 {
+	if isDryRun(o.DryRun) {
 		return old, nil
 	}
 
-	if err := r.storage.Delete(store.ToKey(name)); err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot delete object err: %s", err.Error())
+	// the deleted object gets the revision of the deletion as resourceVersion
+	deleted := old.DeepCopyObject().(runtime.Unstructured)
+	if err := r.write(ctx, resourcepb.Watch_DELETED, deleted, old, m, func() error {
+		return r.index.Transaction(func(txn boltstore.Txn) error {
+			if err := r.checkResourceVersion(txn, key, old); err != nil {
+				return err
+			}
+			if err := txn.Delete(store.KeyFromNSN(key)); err != nil {
+				return status.Errorf(codes.Internal, "cannot delete object err: %s", err.Error())
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
 	}
-	r.notifyWatcher(ctx, watch.Event{
-		Type:   resourcepb.Watch_DELETED,
-		Object: old,
-	})
-	return old, nil
+	return deleted, nil
 
 }
@@ -26,17 +26,19 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
-func (r *storage) Get(ctx context.Context, name string, opts ...rest.GetOption) (runtime.Unstructured, error) {
-	// key is the name of the object
+func (r *storage) Get(ctx context.Context, key types.NamespacedName, opts ...rest.GetOption) (runtime.Unstructured, error) {
+	// key is the namespace and name of the object, the namespace is empty for cluster scoped resources
 	o := rest.GetOptions{}
 	o.ApplyOptions(opts)
+	key = r.defaultKey(key)
 
-	log := log.FromContext(ctx).With("name", name)
+	log := log.FromContext(ctx).With("nsn", key.String())
 	log.Debug("get choreoapiserver")
 
-	obj, err := r.storage.Get(store.ToKey(name), &store.GetOptions{Commit: o.Commit})
+	obj, err := r.storage.Get(store.KeyFromNSN(key), &store.GetOptions{Commit: o.Commit})
 	if err != nil {
 		return obj, status.Errorf(codes.NotFound, "err: %s", err.Error())
 	}
@@ -18,23 +18,128 @@ package registry
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/henderiw/logger/log"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
-func (r *storage) notifyWatcher(ctx context.Context, event watch.Event) {
-	log := log.FromContext(ctx).With("eventType", event.Type)
-	log.Debug("notify watcherManager")
+// getKey returns the storage key of the object; cluster scoped resources
+// have an empty namespace
+func getKey(objectMeta metav1.Object) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: objectMeta.GetNamespace(),
+		Name:      objectMeta.GetName(),
+	}
+}
+
+// isDryRun returns true if the request is processed without persisting the result
+// or notifying the watchers
+func isDryRun(dryRun []string) bool {
+	return len(dryRun) > 0
+}
+
+// defaultNamespace sets the default namespace on a namespaced object without a namespace
+func (r *storage) defaultNamespace(objectMeta metav1.Object) {
+	if r.createStrategy.NamespaceScoped() && objectMeta.GetNamespace() == "" {
+		objectMeta.SetNamespace(metav1.NamespaceDefault)
+	}
+}
+
+// defaultKey returns the key with the default namespace for namespaced resources
+func (r *storage) defaultKey(key types.NamespacedName) types.NamespacedName {
+	if r.createStrategy.NamespaceScoped() && key.Namespace == "" {
+		key.Namespace = metav1.NamespaceDefault
+	}
+	return key
+}
+
+// mutation describes the request that writes to the storage, it is reported to the auditor
+type mutation struct {
+	operation    auditpb.Operation
+	origin       string
+	fieldManager string
+}
+
+// write calls writeFn to store the object with the next revision of the storage as
+// resourceVersion and notifies the watchers. The revision is assigned, the object is
+// stored and the event is recorded under the lock of the history, such that the
+// resourceVersion of an object is the revision of its event and a watch can resume
+// from the resourceVersion of any object. The mutation is reported to the auditor with
+// the stored object before the write; before is nil for a create.
+func (r *storage) write(ctx context.Context, eventType resourcepb.Watch_EventType, obj, before runtime.Unstructured, m mutation, writeFn func() error) error {
+	log := log.FromContext(ctx).With("eventType", eventType)
+	objectMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "cannot access objectMeta err: %s", err.Error())
+	}
 
+	r.history.m.Lock()
+	objectMeta.SetResourceVersion(r.history.next())
+	if err := writeFn(); err != nil {
+		r.history.m.Unlock()
+		return err
+	}
+	event := r.history.record(watch.Event{
+		Type:   eventType,
+		Object: obj,
+	})
+	auditor := r.auditor
+	// the send lock is taken before the history is released, such that the events are
+	// delivered in the order of their revision without blocking the readers of the history
+	r.sendm.Lock()
+	defer r.sendm.Unlock()
+	r.history.m.Unlock()
+
+	log.Debug("notify watcherManager")
 	r.watcherManager.WatchChan() <- event
+
+	if auditor != nil {
+		entry := &rest.AuditEntry{
+			Operation:    m.operation,
+			Origin:       m.origin,
+			FieldManager: m.fieldManager,
+			Before:       before,
+		}
+		if eventType != resourcepb.Watch_DELETED {
+			entry.After = obj
+		}
+		auditor.Audit(ctx, entry)
+	}
+	return nil
+}
+
+// checkResourceVersion verifies in the transaction that the stored object did not change
+// since the old object was read, such that concurrent writes do not overwrite each other
+func (r *storage) checkResourceVersion(txn boltstore.Txn, key types.NamespacedName, old runtime.Unstructured) error {
+	cur, err := txn.Get(store.KeyFromNSN(key))
+	if err != nil {
+		return status.Errorf(codes.NotFound, "err: %s", err.Error())
+	}
+	curObjectMeta, err := meta.Accessor(cur)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot access objectMeta err: %s", err.Error())
+	}
+	oldObjectMeta, err := meta.Accessor(old)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot access objectMeta err: %s", err.Error())
+	}
+	if curObjectMeta.GetResourceVersion() != oldObjectMeta.GetResourceVersion() {
+		return status.Errorf(codes.Aborted, "conflict resource version mismatch %s/%s", oldObjectMeta.GetResourceVersion(), curObjectMeta.GetResourceVersion())
+	}
+	return nil
 }
 
 func isSpecEqual(old, new runtime.Unstructured) (bool, error) {
@@ -58,16 +163,6 @@ func isSpecEqual(old, new runtime.Unstructured) (bool, error) {
 	return true, nil
 }
 
-func UpdateResourceVersion(new, old metav1.Object) error {
-	resourceVersion, err := strconv.Atoi(old.GetResourceVersion())
-	if err != nil {
-		return err
-	}
-	resourceVersion++
-	new.SetResourceVersion(strconv.Itoa(resourceVersion))
-	return nil
-}
-
 func UpdateGeneration(new metav1.Object, generation int64) {
 	generation++
 	new.SetGeneration(generation)
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultHistorySize = 1000
+
+// history keeps a window of the latest events of the storage such that a watch
+// can resume from a resourceVersion without listing all objects again.
+// The revision is initialized with the start time of the storage, so it keeps
+// increasing across restarts and a stale resourceVersion is never replayed.
+type history struct {
+	m        sync.RWMutex
+	size     int
+	revision uint64
+	events   []watch.Event
+}
+
+func newHistory(size int) *history {
+	return &history{
+		size:     size,
+		revision: uint64(time.Now().UnixNano()),
+		events:   make([]watch.Event, 0, size),
+	}
+}
+
+// next returns the revision of the next event; the caller holds the lock
+func (r *history) next() string {
+	return strconv.FormatUint(r.revision+1, 10)
+}
+
+// record assigns the next revision to the event and adds it to the window;
+// the caller holds the lock
+func (r *history) record(event watch.Event) watch.Event {
+	r.revision++
+	event.ResourceVersion = strconv.FormatUint(r.revision, 10)
+	if len(r.events) == r.size {
+		copy(r.events, r.events[1:])
+		r.events = r.events[:r.size-1]
+	}
+	r.events = append(r.events, event)
+	return event
+}
+
+// resourceVersion returns the current revision of the storage
+func (r *history) resourceVersion() string {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return strconv.FormatUint(r.revision, 10)
+}
+
+// since returns the events after the resourceVersion; an error is returned
+// when the resourceVersion is invalid or no longer part of the window
+func (r *history) since(resourceVersion string) ([]watch.Event, error) {
+	rv, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resourceVersion %q", resourceVersion)
+	}
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	oldest := r.revision - uint64(len(r.events))
+	if rv < oldest || rv > r.revision {
+		return nil, status.Errorf(codes.OutOfRange, "resourceVersion %s is no longer available", resourceVersion)
+	}
+	events := make([]watch.Event, 0, r.revision-rv)
+	events = append(events, r.events[len(r.events)-int(r.revision-rv):]...)
+	return events, nil
+}
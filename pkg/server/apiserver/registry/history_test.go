@@ -0,0 +1,128 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHistorySince(t *testing.T) {
+	h := newHistory(3)
+	start := h.revision
+	for i := 0; i < 5; i++ {
+		h.record(watch.Event{})
+	}
+	rv := func(revision uint64) string { return strconv.FormatUint(revision, 10) }
+
+	cases := map[string]struct {
+		resourceVersion string
+		expectedEvents  int
+		expectedCode    codes.Code
+	}{
+		"Current": {
+			resourceVersion: rv(start + 5),
+			expectedEvents:  0,
+		},
+		"Window": {
+			resourceVersion: rv(start + 2),
+			expectedEvents:  3,
+		},
+		"Evicted": {
+			resourceVersion: rv(start + 1),
+			expectedCode:    codes.OutOfRange,
+		},
+		"Future": {
+			resourceVersion: rv(start + 6),
+			expectedCode:    codes.OutOfRange,
+		},
+		"Invalid": {
+			resourceVersion: "abc",
+			expectedCode:    codes.InvalidArgument,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			events, err := h.since(tc.resourceVersion)
+			if tc.expectedCode != codes.OK {
+				if status.Code(err) != tc.expectedCode {
+					t.Errorf("want code %s, got err: %v", tc.expectedCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != tc.expectedEvents {
+				t.Errorf("want %d events, got %d", tc.expectedEvents, len(events))
+			}
+			if len(events) > 0 && events[len(events)-1].ResourceVersion != rv(start+5) {
+				t.Errorf("want last resourceVersion %s, got %s", rv(start+5), events[len(events)-1].ResourceVersion)
+			}
+		})
+	}
+}
+
+func TestContinueToken(t *testing.T) {
+	cases := map[string]struct {
+		key         string
+		token       string
+		expectedErr bool
+	}{
+		"Empty": {
+			key: "",
+		},
+		"Key": {
+			key: "default/a",
+		},
+		"Invalid": {
+			token:       "%%%",
+			expectedErr: true,
+		},
+		"NoNamespaceSeparator": {
+			token:       encodeContinue("a"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			token := tc.token
+			if token == "" {
+				token = encodeContinue(tc.key)
+			}
+			key, err := decodeContinue(token)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("want error, got key %q", key)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if key != tc.key {
+				t.Errorf("want key %q, got %q", tc.key, key)
+			}
+		})
+	}
+}
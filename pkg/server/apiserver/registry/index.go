@@ -0,0 +1,371 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/selector"
+	"github.com/kform-dev/choreo/pkg/server/selector/cel"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	labelIndexPrefix = "metadata.labels/"
+	fieldIndexPrefix = "field/"
+	ownerKindIndex   = "metadata.ownerReferences.kind"
+	ownerNameIndex   = "metadata.ownerReferences.name"
+	ownerUIDIndex    = "metadata.ownerReferences.uid"
+)
+
+var (
+	// matches metadata.labels['<key>'] and metadata.labels["<key>"]
+	labelExpr = regexp.MustCompile(`^metadata\.labels\[\s*(?:'([^'\\]*)'|"([^"\\]*)")\s*\]$`)
+	// matches metadata.ownerReferences.exists(ref, ref.<kind|name|uid> == '<value>')
+	ownerExpr = regexp.MustCompile(`^metadata\.ownerReferences\.exists\(\s*(\w+)\s*,\s*(\w+)\.(kind|name|uid)\s*==\s*(?:'([^'\\]*)'|"([^"\\]*)")\s*\)$`)
+)
+
+// newIndexedStore wraps a store with secondary indexes on the labels, the ownerReferences
+// and the declared field paths of the stored objects. The indexes are built on first use
+// and are kept up to date by the writes to the store, so a selector with equality requirements
+// on indexed expressions resolves to the matching keys without a full scan of the store.
+func newIndexedStore(s store.UnstructuredStore) *indexedStore {
+	return &indexedStore{
+		UnstructuredStore: s,
+		fields:            map[string]*cel.Expression{},
+		owners:            map[string]sets.Set[string]{},
+	}
+}
+
+type indexedStore struct {
+	store.UnstructuredStore
+
+	m     sync.Mutex
+	built bool
+	// fields are the declared field paths with their compiled expression
+	fields map[string]*cel.Expression
+	// owners are the field paths per owner that declared them
+	owners map[string]sets.Set[string]
+	// indexes maps the index name and the indexed value to the keys of the objects
+	indexes map[string]map[string]sets.Set[store.Key]
+	// entries holds the indexed values per object, used to unindex the object on update or delete
+	entries map[store.Key]map[string][]string
+	// keys holds the keys of the objects in list order, used to resume a list from a continue key
+	keys []store.Key
+}
+
+// SetIndexes declares the field paths to be indexed for the owner, replacing the field paths
+// the owner declared before. The field paths no longer declared by any owner are removed;
+// the indexes are rebuilt on the next lookup when the field paths changed.
+func (r *indexedStore) SetIndexes(owner string, expressions ...string) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	compiled := map[string]*cel.Expression{}
+	for _, expression := range expressions {
+		if labelExpr.MatchString(expression) || ownerExpr.MatchString(expression) {
+			// always indexed
+			continue
+		}
+		expr, ok := r.fields[expression]
+		if !ok {
+			var err error
+			if expr, err = cel.Compile(expression); err != nil {
+				return err
+			}
+		}
+		compiled[expression] = expr
+	}
+	if len(compiled) == 0 {
+		delete(r.owners, owner)
+	} else {
+		r.owners[owner] = sets.KeySet(compiled)
+	}
+
+	fields := map[string]*cel.Expression{}
+	for _, expressions := range r.owners {
+		for expression := range expressions {
+			if expr, ok := r.fields[expression]; ok {
+				fields[expression] = expr
+				continue
+			}
+			fields[expression] = compiled[expression]
+		}
+	}
+	if !sets.KeySet(fields).Equal(sets.KeySet(r.fields)) {
+		r.fields = fields
+		r.built = false
+	}
+	return nil
+}
+
+// Lookup returns the keys of the objects matching the indexed requirements together with the
+// names of the indexes used; ok is false when none of the requirements is indexed.
+// The keys are candidates, the other requirements still need to be matched by the caller.
+func (r *indexedStore) Lookup(reqs selector.Requirements) (keys []store.Key, indexes []string, ok bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	var candidates sets.Set[store.Key]
+	for _, req := range reqs {
+		index, values, ok := r.indexFor(req)
+		if !ok {
+			continue
+		}
+		if !r.built {
+			r.build()
+		}
+		matches := sets.New[store.Key]()
+		for _, value := range values {
+			matches = matches.Union(r.indexes[index][value])
+		}
+		if candidates == nil {
+			candidates = matches
+		} else {
+			candidates = candidates.Intersection(matches)
+		}
+		indexes = append(indexes, index)
+	}
+	if candidates == nil {
+		return nil, nil, false
+	}
+	keys = candidates.UnsortedList()
+	sort.Slice(keys, func(i, j int) bool {
+		return listKey(keys[i]) < listKey(keys[j])
+	})
+	return keys, indexes, true
+}
+
+// Keys returns up to limit keys in list order after the start key, 0 means unlimited
+func (r *indexedStore) Keys(start string, limit int) []store.Key {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if !r.built {
+		r.build()
+	}
+	keys := keysAfter(r.keys, start)
+	if limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+	}
+	return slices.Clone(keys)
+}
+
+// keysAfter returns the keys after the start key, the keys are in list order
+func keysAfter(keys []store.Key, start string) []store.Key {
+	i := sort.Search(len(keys), func(i int) bool {
+		return listKey(keys[i]) > start
+	})
+	return keys[i:]
+}
+
+// indexFor returns the index and the values that resolve the requirement
+func (r *indexedStore) indexFor(req selector.Requirement) (string, []string, bool) {
+	switch req.Operator {
+	case resourcepb.Operator_Equals, resourcepb.Operator_DoubleEquals, resourcepb.Operator_In:
+	default:
+		return "", nil, false
+	}
+	if matches := labelExpr.FindStringSubmatch(req.Expression); matches != nil {
+		return labelIndexPrefix + matches[1] + matches[2], req.Values, true
+	}
+	if matches := ownerExpr.FindStringSubmatch(req.Expression); matches != nil {
+		// the exists macro evaluates to true or false, only the objects that have
+		// the owner are indexed
+		if matches[1] != matches[2] || len(req.Values) != 1 || req.Values[0] != "true" {
+			return "", nil, false
+		}
+		return ownerIndex(matches[3]), []string{matches[4] + matches[5]}, true
+	}
+	if _, ok := r.fields[req.Expression]; ok {
+		return fieldIndexPrefix + req.Expression, req.Values, true
+	}
+	return "", nil, false
+}
+
+func ownerIndex(field string) string {
+	switch field {
+	case "kind":
+		return ownerKindIndex
+	case "name":
+		return ownerNameIndex
+	default:
+		return ownerUIDIndex
+	}
+}
+
+// build indexes all the objects of the store, the lock should be held by the caller
+func (r *indexedStore) build() {
+	r.indexes = map[string]map[string]sets.Set[store.Key]{}
+	r.entries = map[store.Key]map[string][]string{}
+	r.keys = nil
+	r.UnstructuredStore.List(func(key store.Key, obj runtime.Unstructured) {
+		if objKey, err := objectKey(obj); err == nil {
+			key = objKey
+		}
+		r.add(key, obj)
+	})
+	r.built = true
+}
+
+// add indexes the object, the lock should be held by the caller
+func (r *indexedStore) add(key store.Key, obj runtime.Unstructured) {
+	if _, ok := r.entries[key]; !ok {
+		i, _ := r.search(key)
+		r.keys = slices.Insert(r.keys, i, key)
+	}
+	r.remove(key)
+	values := r.indexValues(obj)
+	for index, indexValues := range values {
+		if _, ok := r.indexes[index]; !ok {
+			r.indexes[index] = map[string]sets.Set[store.Key]{}
+		}
+		for _, value := range indexValues {
+			if _, ok := r.indexes[index][value]; !ok {
+				r.indexes[index][value] = sets.New[store.Key]()
+			}
+			r.indexes[index][value].Insert(key)
+		}
+	}
+	r.entries[key] = values
+}
+
+// remove unindexes the object, the lock should be held by the caller
+func (r *indexedStore) remove(key store.Key) {
+	for index, values := range r.entries[key] {
+		for _, value := range values {
+			keys := r.indexes[index][value]
+			keys.Delete(key)
+			if keys.Len() == 0 {
+				delete(r.indexes[index], value)
+			}
+		}
+		if len(r.indexes[index]) == 0 {
+			delete(r.indexes, index)
+		}
+	}
+	delete(r.entries, key)
+}
+
+// search returns the position of the key in the keys and whether the key is found
+func (r *indexedStore) search(key store.Key) (int, bool) {
+	return sort.Find(len(r.keys), func(i int) int {
+		return strings.Compare(listKey(key), listKey(r.keys[i]))
+	})
+}
+
+// indexValues returns the indexed values of the object per index
+func (r *indexedStore) indexValues(obj runtime.Unstructured) map[string][]string {
+	values := map[string][]string{}
+	objectMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return values
+	}
+	for k, v := range objectMeta.GetLabels() {
+		values[labelIndexPrefix+k] = []string{v}
+	}
+	for _, ref := range objectMeta.GetOwnerReferences() {
+		values[ownerKindIndex] = append(values[ownerKindIndex], ref.Kind)
+		values[ownerNameIndex] = append(values[ownerNameIndex], ref.Name)
+		values[ownerUIDIndex] = append(values[ownerUIDIndex], string(ref.UID))
+	}
+	for expression, expr := range r.fields {
+		v, found, err := expr.GetValue(obj.UnstructuredContent())
+		if err != nil || !found {
+			continue
+		}
+		values[fieldIndexPrefix+expression] = []string{v}
+	}
+	return values
+}
+
+// index indexes the written object, the lock should be held by the caller
+func (r *indexedStore) index(key store.Key, obj runtime.Unstructured) {
+	if r.built {
+		r.add(key, obj)
+	}
+}
+
+// unindex unindexes the deleted object, the lock should be held by the caller
+func (r *indexedStore) unindex(key store.Key) {
+	if r.built {
+		r.remove(key)
+		if i, ok := r.search(key); ok {
+			r.keys = slices.Delete(r.keys, i, i+1)
+		}
+	}
+}
+
+func (r *indexedStore) Apply(key store.Key, obj runtime.Unstructured, opts ...store.ApplyOption) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.UnstructuredStore.Apply(key, obj, opts...); err != nil {
+		return err
+	}
+	r.index(key, obj)
+	return nil
+}
+
+func (r *indexedStore) Create(key store.Key, obj runtime.Unstructured, opts ...store.CreateOption) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.UnstructuredStore.Create(key, obj, opts...); err != nil {
+		return err
+	}
+	r.index(key, obj)
+	return nil
+}
+
+func (r *indexedStore) Update(key store.Key, obj runtime.Unstructured, opts ...store.UpdateOption) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.UnstructuredStore.Update(key, obj, opts...); err != nil {
+		return err
+	}
+	r.index(key, obj)
+	return nil
+}
+
+func (r *indexedStore) UpdateWithKeyFn(key store.Key, updateFunc func(obj runtime.Unstructured, opts ...store.UpdateOption) runtime.Unstructured) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	var newObj runtime.Unstructured
+	// the object is indexed after the update since the store holds its lock during the update
+	r.UnstructuredStore.UpdateWithKeyFn(key, func(obj runtime.Unstructured, opts ...store.UpdateOption) runtime.Unstructured {
+		newObj = updateFunc(obj, opts...)
+		return newObj
+	})
+	if newObj != nil {
+		r.index(key, newObj)
+	}
+}
+
+func (r *indexedStore) Delete(key store.Key, opts ...store.DeleteOption) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if err := r.UnstructuredStore.Delete(key, opts...); err != nil {
+		return err
+	}
+	r.unindex(key)
+	return nil
+}
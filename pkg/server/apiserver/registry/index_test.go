@@ -0,0 +1,205 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"testing"
+
+	"github.com/henderiw/store"
+	"github.com/henderiw/store/memoryu"
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/selector"
+	"github.com/kform-dev/choreo/pkg/util/testhelper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func testIndexObject(name, index string, labels map[string]string, owner string) *unstructured.Unstructured {
+	u := testhelper.NewObject("default", name)
+	u.SetLabels(labels)
+	if owner != "" {
+		u.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "example.com/v1alpha1", Kind: owner, Name: index, UID: types.UID(index)}})
+	}
+	unstructured.SetNestedField(u.Object, index, "spec", "index")
+	return u
+}
+
+func testRequirement(t *testing.T, expression string, op resourcepb.Operator, values ...string) selector.Requirement {
+	req, err := selector.NewRequirement(expression, op, values)
+	if err != nil {
+		t.Fatalf("unexpected requirement error: %v", err)
+	}
+	return req
+}
+
+func TestIndexedStoreLookup(t *testing.T) {
+	s := newIndexedStore(memoryu.NewStore())
+	for _, u := range []*unstructured.Unstructured{
+		testIndexObject("a", "x", map[string]string{"app": "a"}, "Index"),
+		testIndexObject("b", "x", map[string]string{"app": "b"}, ""),
+		testIndexObject("c", "y", map[string]string{"app": "a"}, "Index"),
+	} {
+		if err := s.Create(store.KeyFromNSN(types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}), u); err != nil {
+			t.Fatalf("unexpected create error: %v", err)
+		}
+	}
+	if err := s.SetIndexes("test", "spec.index"); err != nil {
+		t.Fatalf("unexpected set indexes error: %v", err)
+	}
+
+	cases := map[string]struct {
+		reqs          selector.Requirements
+		expectedNames []string
+		expectedOK    bool
+	}{
+		"Label": {
+			reqs:          selector.Requirements{testRequirement(t, `metadata.labels["app"]`, resourcepb.Operator_Equals, "a")},
+			expectedNames: []string{"a", "c"},
+			expectedOK:    true,
+		},
+		"LabelSingleQuote": {
+			reqs:          selector.Requirements{testRequirement(t, `metadata.labels['app']`, resourcepb.Operator_Equals, "b")},
+			expectedNames: []string{"b"},
+			expectedOK:    true,
+		},
+		"Field": {
+			reqs:          selector.Requirements{testRequirement(t, "spec.index", resourcepb.Operator_In, "x", "y")},
+			expectedNames: []string{"a", "b", "c"},
+			expectedOK:    true,
+		},
+		"OwnerKind": {
+			reqs:          selector.Requirements{testRequirement(t, `metadata.ownerReferences.exists(ref, ref.kind == "Index")`, resourcepb.Operator_Equals, "true")},
+			expectedNames: []string{"a", "c"},
+			expectedOK:    true,
+		},
+		"Intersection": {
+			reqs: selector.Requirements{
+				testRequirement(t, "spec.index", resourcepb.Operator_Equals, "x"),
+				testRequirement(t, `metadata.labels["app"]`, resourcepb.Operator_Equals, "a"),
+				testRequirement(t, "metadata.name", resourcepb.Operator_NotEquals, "z"),
+			},
+			expectedNames: []string{"a"},
+			expectedOK:    true,
+		},
+		"NoMatch": {
+			reqs:          selector.Requirements{testRequirement(t, "spec.index", resourcepb.Operator_Equals, "z")},
+			expectedNames: []string{},
+			expectedOK:    true,
+		},
+		"OwnerNotExists": {
+			reqs:       selector.Requirements{testRequirement(t, `metadata.ownerReferences.exists(ref, ref.kind == "Index")`, resourcepb.Operator_Equals, "false")},
+			expectedOK: false,
+		},
+		"NotIndexed": {
+			reqs:       selector.Requirements{testRequirement(t, "metadata.name", resourcepb.Operator_Equals, "a")},
+			expectedOK: false,
+		},
+		"NotEquals": {
+			reqs:       selector.Requirements{testRequirement(t, "spec.index", resourcepb.Operator_NotEquals, "x")},
+			expectedOK: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			keys, _, ok := s.Lookup(tc.reqs)
+			if ok != tc.expectedOK {
+				t.Fatalf("expected ok %t, got %t", tc.expectedOK, ok)
+			}
+			if !ok {
+				return
+			}
+			names := []string{}
+			for _, key := range keys {
+				names = append(names, key.Name)
+			}
+			if len(names) != len(tc.expectedNames) {
+				t.Fatalf("expected %v, got %v", tc.expectedNames, names)
+			}
+			for i := range names {
+				if names[i] != tc.expectedNames[i] {
+					t.Errorf("expected %v, got %v", tc.expectedNames, names)
+				}
+			}
+		})
+	}
+}
+
+func TestIndexedStoreUpdate(t *testing.T) {
+	s := newIndexedStore(memoryu.NewStore())
+	if err := s.SetIndexes("test", "spec.index"); err != nil {
+		t.Fatalf("unexpected set indexes error: %v", err)
+	}
+	key := store.KeyFromNSN(types.NamespacedName{Namespace: "default", Name: "a"})
+	reqs := selector.Requirements{testRequirement(t, "spec.index", resourcepb.Operator_Equals, "x")}
+
+	lookup := func() int {
+		keys, _, ok := s.Lookup(reqs)
+		if !ok {
+			t.Fatalf("expected the index to be used")
+		}
+		return len(keys)
+	}
+
+	if err := s.Create(key, testIndexObject("a", "x", nil, "")); err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+	if n := lookup(); n != 1 {
+		t.Errorf("expected 1 key after create, got %d", n)
+	}
+	if err := s.Update(key, testIndexObject("a", "y", nil, "")); err != nil {
+		t.Fatalf("unexpected update error: %v", err)
+	}
+	if n := lookup(); n != 0 {
+		t.Errorf("expected 0 keys after update, got %d", n)
+	}
+	if err := s.Update(key, testIndexObject("a", "x", nil, "")); err != nil {
+		t.Fatalf("unexpected update error: %v", err)
+	}
+	if err := s.Delete(key); err != nil {
+		t.Fatalf("unexpected delete error: %v", err)
+	}
+	if n := lookup(); n != 0 {
+		t.Errorf("expected 0 keys after delete, got %d", n)
+	}
+}
+
+func TestIndexedStoreSetIndexes(t *testing.T) {
+	s := newIndexedStore(memoryu.NewStore())
+	reqs := selector.Requirements{testRequirement(t, "spec.index", resourcepb.Operator_Equals, "x")}
+
+	cases := []struct {
+		owner       string
+		expressions []string
+		expectedOK  bool
+	}{
+		{owner: "a", expressions: []string{"spec.index"}, expectedOK: true},
+		{owner: "b", expressions: []string{"spec.index"}, expectedOK: true},
+		// the field is still declared by b
+		{owner: "a", expectedOK: true},
+		{owner: "b", expectedOK: false},
+	}
+	for i, tc := range cases {
+		if err := s.SetIndexes(tc.owner, tc.expressions...); err != nil {
+			t.Fatalf("step %d: unexpected set indexes error: %v", i, err)
+		}
+		if _, _, ok := s.Lookup(reqs); ok != tc.expectedOK {
+			t.Errorf("step %d: expected indexed %t, got %t", i, tc.expectedOK, ok)
+		}
+	}
+}
@@ -18,19 +18,25 @@ package registry
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
+	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
 	"github.com/kform-dev/choreo/pkg/util/object"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func (r *storage) List(ctx context.Context, opts ...rest.ListOption) (runtime.Unstructured, error) {
-	//log := log.FromContext(ctx)
+	log := log.FromContext(ctx)
 	o := rest.ListOptions{}
 	o.ApplyOptions(opts)
 
@@ -40,32 +46,147 @@ func (r *storage) List(ctx context.Context, opts ...rest.ListOption) (runtime.Un
 		return nil, err
 	}
 
-	listFunc := func(key store.Key, obj runtime.Unstructured) {
-		// we don't filter by default
-		filter := false
-		if o.Selector != nil {
-			if !o.Selector.Matches(obj.UnstructuredContent()) {
-				filter = true
+	if o.Commit != nil && !r.backend.readsCommits() {
+		return nil, status.Errorf(codes.Unimplemented, "listing a commit is not supported by the %s backend", r.backend)
+	}
+
+	start, err := decodeContinue(o.Continue)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid continue token, err: %s", err.Error())
+	}
+	// the resourceVersion of the list is the revision of the storage before listing,
+	// a watch started from this resourceVersion does not miss any event
+	resourceVersion := r.history.resourceVersion()
+
+	items := []listItem{}
+	// add adds the object to the page when it matches, it returns true when the page is full;
+	// one item more than the limit is collected to know if there are more items
+	add := func(key store.Key, obj runtime.Unstructured) bool {
+		if !o.MatchesNamespace(key.Namespace) {
+			return false
+		}
+		if o.Selector != nil && !o.Selector.Matches(obj.UnstructuredContent()) {
+			return false
+		}
+		items = append(items, listItem{key: listKey(key), obj: obj})
+		return o.Limit > 0 && int64(len(items)) > o.Limit
+	}
+	// get adds the objects of the keys in list order till the page is full
+	get := func(keys []store.Key) bool {
+		for _, key := range keys {
+			obj, err := r.storage.Get(key)
+			if err != nil {
+				continue
+			}
+			if add(key, obj) {
+				return true
 			}
 		}
+		return false
+	}
 
-		if !filter {
-			if !o.ShowManagedFields {
-				copiedObj := obj.DeepCopyObject().(runtime.Unstructured)
-				object.RemoveManagedFieldsFromUnstructured(ctx, copiedObj)
-				object.RemoveResourceVersionAndGenerationFromUnstructured(ctx, copiedObj)
-				AppendItem(v, copiedObj)
-			} else {
-				AppendItem(v, obj)
+	if o.Commit != nil {
+		// the keys only reflect the latest state, the objects of a commit are scanned
+		// and sorted to provide a stable order across pages
+		r.storage.List(func(key store.Key, obj runtime.Unstructured) {
+			// skip the items returned in the previous pages
+			if listKey(key) > start {
+				add(key, obj)
+			}
+		}, &store.ListOptions{Commit: o.Commit})
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].key < items[j].key
+		})
+	} else if keys, indexes, ok := r.lookup(o); ok {
+		log.Debug("list using index", "indexes", indexes, "candidates", len(keys))
+		get(keysAfter(keys, start))
+	} else {
+		if o.Selector != nil {
+			log.Debug("list without index", "selector", o.Selector.String())
+		}
+		// resume after the continue key and fetch the keys in batches till the page is full
+		batch := 0
+		if o.Limit > 0 {
+			batch = int(o.Limit) + 1
+		}
+		for keys := r.index.Keys(start, batch); len(keys) > 0; keys = r.index.Keys(listKey(keys[len(keys)-1]), batch) {
+			if get(keys) || batch == 0 {
+				break
 			}
+		}
+	}
+
+	continueKey := ""
+	if o.Limit > 0 && int64(len(items)) > o.Limit {
+		items = items[:o.Limit]
+		continueKey = items[len(items)-1].key
+	}
 
+	for _, item := range items {
+		if !o.ShowManagedFields {
+			copiedObj := item.obj.DeepCopyObject().(runtime.Unstructured)
+			object.RemoveManagedFieldsFromUnstructured(ctx, copiedObj)
+			object.RemoveResourceVersionAndGenerationFromUnstructured(ctx, copiedObj)
+			AppendItem(v, copiedObj)
+		} else {
+			AppendItem(v, item.obj)
 		}
 	}
 
-	r.storage.List(listFunc, &store.ListOptions{Commit: o.Commit})
+	listMeta, err := meta.ListAccessor(newListObj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot access listMeta err: %s", err.Error())
+	}
+	listMeta.SetResourceVersion(resourceVersion)
+	listMeta.SetContinue(encodeContinue(continueKey))
 	return newListObj, nil
 }
 
+// lookup returns the candidate keys of the selector from the indexes, the indexes only
+// reflect the latest state so a list of a commit is not resolved by the indexes
+func (r *storage) lookup(o rest.ListOptions) ([]store.Key, []string, bool) {
+	if o.Selector == nil || o.Commit != nil {
+		return nil, nil, false
+	}
+	reqs, selectable := o.Selector.Requirements()
+	if !selectable {
+		return nil, nil, false
+	}
+	return r.index.Lookup(reqs)
+}
+
+type listItem struct {
+	key string
+	obj runtime.Unstructured
+}
+
+func listKey(key store.Key) string {
+	return key.Namespace + "/" + key.Name
+}
+
+// encodeContinue returns an opaque continue token for the key of the last item
+// of a page, an empty key indicates there are no more items
+func encodeContinue(key string) string {
+	if key == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodeContinue(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+	if !strings.Contains(string(b), "/") {
+		return "", fmt.Errorf("unexpected key %q", string(b))
+	}
+	return string(b), nil
+}
+
 func GetListPrt(listObj runtime.Object) (reflect.Value, error) {
 	listPtr, err := meta.GetItemsPtr(listObj)
 	if err != nil {
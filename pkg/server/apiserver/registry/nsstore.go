@@ -0,0 +1,125 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/henderiw/store"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// newNamespacedStore wraps a file backed store that only handles a flat name
+// as a key. Namespaced objects are stored as <namespace>.<name>, cluster scoped
+// objects keep <name>; since a namespace cannot contain a dot the encoding is
+// unambiguous. Objects stored in the legacy layout (name only) are migrated.
+func newNamespacedStore(s store.UnstructuredStore) (store.UnstructuredStore, error) {
+	r := &namespacedStore{UnstructuredStore: s}
+	if err := r.migrate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type namespacedStore struct {
+	store.UnstructuredStore
+}
+
+func encodeKey(key store.Key) store.Key {
+	if key.Namespace == "" {
+		return store.ToKey(key.Name)
+	}
+	return store.ToKey(fmt.Sprintf("%s.%s", key.Namespace, key.Name))
+}
+
+// objectKey returns the key based on the metadata of the stored object
+func objectKey(obj runtime.Unstructured) (store.Key, error) {
+	objectMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return store.Key{}, err
+	}
+	return store.KeyFromNSN(getKey(objectMeta)), nil
+}
+
+func (r *namespacedStore) migrate() error {
+	var errm error
+	moves := map[store.Key]runtime.Unstructured{}
+	r.UnstructuredStore.List(func(key store.Key, obj runtime.Unstructured) {
+		newKey, err := objectKey(obj)
+		if err != nil {
+			errm = errors.Join(errm, err)
+			return
+		}
+		if encodeKey(newKey) != key {
+			moves[key] = obj
+		}
+	})
+	for oldKey, obj := range moves {
+		newKey, _ := objectKey(obj)
+		if err := r.UnstructuredStore.Apply(encodeKey(newKey), obj); err != nil {
+			errm = errors.Join(errm, fmt.Errorf("cannot migrate %s, err: %v", newKey.String(), err))
+			continue
+		}
+		if err := r.UnstructuredStore.Delete(oldKey); err != nil {
+			errm = errors.Join(errm, fmt.Errorf("cannot migrate %s, err: %v", newKey.String(), err))
+		}
+	}
+	return errm
+}
+
+func (r *namespacedStore) Get(key store.Key, opts ...store.GetOption) (runtime.Unstructured, error) {
+	return r.UnstructuredStore.Get(encodeKey(key), opts...)
+}
+
+func (r *namespacedStore) List(visitorFunc func(key store.Key, obj runtime.Unstructured), opts ...store.ListOption) {
+	r.UnstructuredStore.List(func(key store.Key, obj runtime.Unstructured) {
+		if objKey, err := objectKey(obj); err == nil {
+			key = objKey
+		}
+		visitorFunc(key, obj)
+	}, opts...)
+}
+
+func (r *namespacedStore) ListKeys(opts ...store.ListOption) []string {
+	keys := []string{}
+	r.List(func(key store.Key, _ runtime.Unstructured) {
+		keys = append(keys, key.String())
+	}, opts...)
+	return keys
+}
+
+func (r *namespacedStore) Apply(key store.Key, obj runtime.Unstructured, opts ...store.ApplyOption) error {
+	return r.UnstructuredStore.Apply(encodeKey(key), obj, opts...)
+}
+
+func (r *namespacedStore) Create(key store.Key, obj runtime.Unstructured, opts ...store.CreateOption) error {
+	return r.UnstructuredStore.Create(encodeKey(key), obj, opts...)
+}
+
+func (r *namespacedStore) Update(key store.Key, obj runtime.Unstructured, opts ...store.UpdateOption) error {
+	return r.UnstructuredStore.Update(encodeKey(key), obj, opts...)
+}
+
+func (r *namespacedStore) UpdateWithKeyFn(key store.Key, updateFunc func(obj runtime.Unstructured, opts ...store.UpdateOption) runtime.Unstructured) {
+	r.UnstructuredStore.UpdateWithKeyFn(encodeKey(key), updateFunc)
+}
+
+func (r *namespacedStore) Delete(key store.Key, opts ...store.DeleteOption) error {
+	return r.UnstructuredStore.Delete(encodeKey(key), opts...)
+}
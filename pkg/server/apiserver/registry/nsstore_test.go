@@ -0,0 +1,116 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/henderiw/store"
+	"github.com/henderiw/store/gitu"
+	"github.com/kform-dev/choreo/pkg/util/testhelper"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newTestGitStore(t *testing.T, dir string) store.UnstructuredStore {
+	s, err := gitu.NewStore(&gitu.Config{
+		GroupResource: schema.GroupResource{Group: "example.com", Resource: "dummies"},
+		RootPath:      dir,
+		NewFunc:       func() runtime.Unstructured { return &unstructured.Unstructured{} },
+	})
+	if err != nil {
+		t.Fatalf("cannot create git store: %v", err)
+	}
+	return s
+}
+
+func TestNamespacedStore(t *testing.T) {
+	dir := t.TempDir()
+
+	// an object stored in the legacy layout, keyed by name only
+	legacy := newTestGitStore(t, dir)
+	if err := legacy.Create(store.ToKey("site"), testhelper.NewObject("a", "site")); err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+
+	s, err := newNamespacedStore(newTestGitStore(t, dir))
+	if err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+	if err := s.Create(store.KeyFromNSN(types.NamespacedName{Namespace: "b", Name: "site"}), testhelper.NewObject("b", "site")); err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+	if err := s.Create(store.ToKey("cluster.site"), testhelper.NewObject("", "cluster.site")); err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+
+	cases := map[string]struct {
+		key         types.NamespacedName
+		expectedErr bool
+	}{
+		"Migrated": {
+			key: types.NamespacedName{Namespace: "a", Name: "site"},
+		},
+		"SameNameOtherNamespace": {
+			key: types.NamespacedName{Namespace: "b", Name: "site"},
+		},
+		"ClusterScoped": {
+			key: types.NamespacedName{Name: "cluster.site"},
+		},
+		"NoNamespace": {
+			key:         types.NamespacedName{Name: "site"},
+			expectedErr: true,
+		},
+		"WrongNamespace": {
+			key:         types.NamespacedName{Namespace: "c", Name: "site"},
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			obj, err := s.Get(store.KeyFromNSN(tc.key))
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error for %s", tc.key.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected get error: %v", err)
+			}
+			u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+			if u.GetNamespace() != tc.key.Namespace || u.GetName() != tc.key.Name {
+				t.Errorf("expected %s, got %s/%s", tc.key.String(), u.GetNamespace(), u.GetName())
+			}
+		})
+	}
+
+	keys := s.ListKeys()
+	sort.Strings(keys)
+	expected := []string{"a.site", "b.site", "cluster.site"}
+	if len(keys) != len(expected) {
+		t.Fatalf("expected keys %v, got %v", expected, keys)
+	}
+	for i := range keys {
+		if keys[i] != expected[i] {
+			t.Errorf("expected keys %v, got %v", expected, keys)
+		}
+	}
+}
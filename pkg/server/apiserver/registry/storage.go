@@ -18,10 +18,9 @@ package registry
 
 import (
 	"context"
+	"sync"
 
 	"github.com/henderiw/store"
-	"github.com/henderiw/store/gitu"
-	"github.com/henderiw/store/memoryu"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/watchermanager"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,7 +31,7 @@ import (
 type NewFn func() runtime.Unstructured
 type NewListFn func() runtime.Unstructured
 
-type NewStorageFn func(ctx context.Context, dbpath string) rest.Storage
+type NewStorageFn func(ctx context.Context, dbpath string) (rest.Storage, error)
 
 type StorageCreator struct {
 	GroupResource schema.GroupResource
@@ -40,42 +39,40 @@ type StorageCreator struct {
 	NewListFn     NewListFn
 	Strategy      rest.Strategy
 	FieldManager  *managedfields.FieldManager
+	// StatusFieldManager is only set when the status subresource is enabled
+	StatusFieldManager *managedfields.FieldManager
 }
 
-func (r StorageCreator) NewStorage(ctx context.Context, pathInRepo, dbpath string) rest.Storage {
-	return NewStorage(ctx, pathInRepo, dbpath, r.GroupResource, r.NewFn, r.NewListFn, r.Strategy, r.FieldManager)
+func (r StorageCreator) NewStorage(ctx context.Context, storageConfig *StorageConfig) (rest.Storage, error) {
+	return NewStorage(ctx, storageConfig, r.GroupResource, r.NewFn, r.NewListFn, r.Strategy, r.FieldManager, r.StatusFieldManager)
 }
 
-func NewStorage(ctx context.Context, pathInRepo, dbpath string, gr schema.GroupResource, newFn NewFn, newListFn NewListFn, strategy rest.Strategy, fieldManager *managedfields.FieldManager) rest.Storage {
+// NewStorage returns the storage of the group resource; an error is returned when the
+// backend cannot be opened, e.g. when the bolt database is locked by another server
+func NewStorage(ctx context.Context, storageConfig *StorageConfig, gr schema.GroupResource, newFn NewFn, newListFn NewListFn, strategy rest.Strategy, fieldManager, statusFieldManager *managedfields.FieldManager) (rest.Storage, error) {
+	store, err := newStore(storageConfig, gr, newFn)
+	if err != nil {
+		return nil, err
+	}
+	indexedStore := newIndexedStore(store)
+
 	watcherManager := watchermanager.New(64)
 	go watcherManager.Start(ctx)
 
-	var store store.UnstructuredStore
-	if dbpath == "" {
-		store = memoryu.NewStore()
-	} else {
-		var err error
-		store, err = gitu.NewStore(&gitu.Config{
-			GroupResource: gr,
-			PathInRepo:    pathInRepo,
-			RootPath:      dbpath,
-			NewFunc:       newFn,
-		})
-		if err != nil {
-			panic(err)
-		}
-	}
-
 	return &storage{
-		newFn:          newFn,
-		newListFn:      newListFn,
-		createStrategy: strategy,
-		updateStrategy: strategy,
-		deleteStrategy: strategy,
-		storage:        store,
-		watcherManager: watcherManager,
-		fieldManager:   fieldManager,
-	}
+		newFn:              newFn,
+		newListFn:          newListFn,
+		createStrategy:     strategy,
+		updateStrategy:     strategy,
+		deleteStrategy:     strategy,
+		backend:            storageConfig.backend(),
+		storage:            indexedStore,
+		index:              indexedStore,
+		watcherManager:     watcherManager,
+		history:            newHistory(defaultHistorySize),
+		fieldManager:       fieldManager,
+		statusFieldManager: statusFieldManager,
+	}, nil
 }
 
 type storage struct {
@@ -92,9 +89,34 @@ type storage struct {
 	deleteStrategy rest.DeleteStrategy
 
 	fieldManager *managedfields.FieldManager
+	// statusFieldManager manages the fields of the status subresource
+	statusFieldManager *managedfields.FieldManager
 
+	// backend that stores the data
+	backend Backend
 	// holds the data
 	//storage        store.Storer[runtime.Unstructured]
-	storage        store.UnstructuredStore
+	storage store.UnstructuredStore
+	// secondary indexes on the data, used by list to resolve selectors
+	index          *indexedStore
 	watcherManager watchermanager.WatcherManager
+	// history of the latest events, used to resume watches
+	history *history
+	// sendm orders the delivery of the events to the watcherManager
+	sendm sync.Mutex
+	// auditor records the mutations, guarded by the lock of the history
+	auditor rest.Auditor
+}
+
+// SetIndexes declares the field paths to be indexed for the owner, labels and ownerReferences
+// are always indexed
+func (r *storage) SetIndexes(owner string, expressions ...string) error {
+	return r.index.SetIndexes(owner, expressions...)
+}
+
+// SetAuditor sets the auditor that records the mutations of the storage
+func (r *storage) SetAuditor(auditor rest.Auditor) {
+	r.history.m.Lock()
+	defer r.history.m.Unlock()
+	r.auditor = auditor
 }
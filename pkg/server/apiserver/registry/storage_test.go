@@ -0,0 +1,413 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/henderiw/store/memoryu"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/watchermanager"
+	"github.com/kform-dev/choreo/pkg/server/selector"
+	"github.com/kform-dev/choreo/pkg/util/testhelper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// testStrategy is a strategy without defaulting and validation
+type testStrategy struct {
+	namespaced bool
+}
+
+func (r testStrategy) NamespaceScoped() bool                                          { return r.namespaced }
+func (r testStrategy) PrepareForCreate(ctx context.Context, obj runtime.Unstructured) {}
+func (r testStrategy) ValidateCreate(ctx context.Context, obj runtime.Unstructured) field.ErrorList {
+	return nil
+}
+func (r testStrategy) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, nil
+}
+func (r testStrategy) StatusEnabled() bool                                                 { return false }
+func (r testStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Unstructured) {}
+func (r testStrategy) PrepareForStatusUpdate(ctx context.Context, obj, old runtime.Unstructured) runtime.Unstructured {
+	return obj
+}
+func (r testStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Unstructured) field.ErrorList {
+	return nil
+}
+func (r testStrategy) InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error) {
+	return obj, old, nil
+}
+func (r testStrategy) InvokeDelete(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
+	return obj, nil
+}
+
+// testWatcherManager records the events the storage notifies
+type testWatcherManager struct {
+	watchermanager.WatcherManager
+	watchCh chan watch.Event
+}
+
+func (r *testWatcherManager) WatchChan() chan watch.Event { return r.watchCh }
+
+func (r *testWatcherManager) events() []watch.Event {
+	events := []watch.Event{}
+	for {
+		select {
+		case event := <-r.watchCh:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+// testAuditor records the mutations the storage audits
+type testAuditor struct {
+	entries []*rest.AuditEntry
+}
+
+func (r *testAuditor) Audit(ctx context.Context, entry *rest.AuditEntry) {
+	r.entries = append(r.entries, entry)
+}
+
+func newTestStorage(namespaced bool) (*storage, *testWatcherManager) {
+	watcherManager := &testWatcherManager{watchCh: make(chan watch.Event, 64)}
+	indexedStore := newIndexedStore(memoryu.NewStore())
+	strategy := testStrategy{namespaced: namespaced}
+	return &storage{
+		newFn: func() runtime.Unstructured { return &unstructured.Unstructured{} },
+		newListFn: func() runtime.Unstructured {
+			u := &unstructured.UnstructuredList{}
+			u.SetAPIVersion("example.com/v1alpha1")
+			u.SetKind("DummyList")
+			return u
+		},
+		createStrategy: strategy,
+		updateStrategy: strategy,
+		deleteStrategy: strategy,
+		storage:        indexedStore,
+		index:          indexedStore,
+		watcherManager: watcherManager,
+		history:        newHistory(defaultHistorySize),
+	}, watcherManager
+}
+
+func TestDefaultNamespace(t *testing.T) {
+	cases := map[string]struct {
+		namespaced        bool
+		namespace         string
+		expectedNamespace string
+	}{
+		"Namespaced": {
+			namespaced:        true,
+			expectedNamespace: "default",
+		},
+		"NamespacedWithNamespace": {
+			namespaced:        true,
+			namespace:         "a",
+			expectedNamespace: "a",
+		},
+		"ClusterScoped": {
+			namespaced:        false,
+			expectedNamespace: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s, _ := newTestStorage(tc.namespaced)
+			obj, err := s.Create(ctx, testhelper.NewObject(tc.namespace, "x"))
+			if err != nil {
+				t.Fatalf("unexpected create error: %v", err)
+			}
+			u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+			if u.GetNamespace() != tc.expectedNamespace {
+				t.Errorf("want namespace %q, got %q", tc.expectedNamespace, u.GetNamespace())
+			}
+			// a get without a namespace finds the object
+			if _, err := s.Get(ctx, types.NamespacedName{Namespace: tc.namespace, Name: "x"}); err != nil {
+				t.Errorf("unexpected get error: %v", err)
+			}
+			ul, err := s.List(ctx, &rest.ListOptions{Namespace: tc.expectedNamespace})
+			if err != nil {
+				t.Fatalf("unexpected list error: %v", err)
+			}
+			if items := ul.(*unstructured.UnstructuredList).Items; len(items) != 1 {
+				t.Errorf("want 1 item in namespace %q, got %d", tc.expectedNamespace, len(items))
+			}
+		})
+	}
+}
+
+func TestResourceVersion(t *testing.T) {
+	ctx := context.Background()
+	s, watcherManager := newTestStorage(true)
+
+	obj, err := s.Create(ctx, testhelper.NewObject("default", "x"))
+	if err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+	created := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	events := watcherManager.events()
+	if len(events) != 1 {
+		t.Fatalf("want 1 event, got %d", len(events))
+	}
+	if events[0].ResourceVersion != created.GetResourceVersion() {
+		t.Errorf("want event resourceVersion %q, got %q", created.GetResourceVersion(), events[0].ResourceVersion)
+	}
+
+	update := created.DeepCopy()
+	update.SetLabels(map[string]string{"a": "b"})
+	obj, err = s.Update(ctx, update)
+	if err != nil {
+		t.Fatalf("unexpected update error: %v", err)
+	}
+	updated := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	events = watcherManager.events()
+	if len(events) != 1 || events[0].ResourceVersion != updated.GetResourceVersion() {
+		t.Fatalf("want 1 event with resourceVersion %q, got %v", updated.GetResourceVersion(), events)
+	}
+	// a watch resumed from the created object only replays the update
+	replay, err := s.history.since(created.GetResourceVersion())
+	if err != nil {
+		t.Fatalf("unexpected history error: %v", err)
+	}
+	if len(replay) != 1 || replay[0].ResourceVersion != updated.GetResourceVersion() {
+		t.Errorf("want the update replayed, got %v", replay)
+	}
+
+	// an update with a stale resourceVersion conflicts
+	stale := created.DeepCopy()
+	stale.SetLabels(map[string]string{"a": "c"})
+	if _, err := s.Update(ctx, stale); status.Code(err) != codes.Aborted {
+		t.Errorf("want %s, got %v", codes.Aborted, err)
+	}
+}
+
+func TestListPages(t *testing.T) {
+	cases := map[string]struct {
+		selector      bool
+		deleted       string
+		expectedNames []string
+	}{
+		"Scan": {
+			expectedNames: []string{"a", "b", "c", "d", "e"},
+		},
+		"Index": {
+			selector:      true,
+			expectedNames: []string{"a", "b", "e"},
+		},
+		"DeletedBetweenPages": {
+			deleted:       "c",
+			expectedNames: []string{"a", "b", "d", "e"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s, _ := newTestStorage(true)
+			for i, name := range []string{"e", "c", "a", "d", "b"} {
+				u := testhelper.NewObject("default", name)
+				u.SetLabels(map[string]string{"odd": strconv.FormatBool(i%2 == 0)})
+				if _, err := s.Create(ctx, u); err != nil {
+					t.Fatalf("unexpected create error: %v", err)
+				}
+			}
+			o := &rest.ListOptions{Limit: 2}
+			if tc.selector {
+				o.Selector = selector.NewSelector().Add(testRequirement(t, `metadata.labels["odd"]`, resourcepb.Operator_Equals, "true"))
+			}
+
+			names := []string{}
+			for {
+				ul, err := s.List(ctx, o)
+				if err != nil {
+					t.Fatalf("unexpected list error: %v", err)
+				}
+				list := ul.(*unstructured.UnstructuredList)
+				if len(list.Items) > int(o.Limit) {
+					t.Fatalf("want at most %d items, got %d", o.Limit, len(list.Items))
+				}
+				for _, item := range list.Items {
+					names = append(names, item.GetName())
+				}
+				if list.GetContinue() == "" {
+					break
+				}
+				if tc.deleted != "" && len(names) == 2 {
+					if _, err := s.Delete(ctx, types.NamespacedName{Namespace: "default", Name: tc.deleted}); err != nil {
+						t.Fatalf("unexpected delete error: %v", err)
+					}
+				}
+				o.Continue = list.GetContinue()
+			}
+			if diff := cmp.Diff(tc.expectedNames, names); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	dryRun := []string{"All"}
+	cases := map[string]struct {
+		dryRunFn func(ctx context.Context, s *storage, stored *unstructured.Unstructured) error
+	}{
+		"Create": {
+			dryRunFn: func(ctx context.Context, s *storage, stored *unstructured.Unstructured) error {
+				_, err := s.Create(ctx, testhelper.NewObject("default", "y"), &rest.CreateOptions{DryRun: dryRun})
+				return err
+			},
+		},
+		"Update": {
+			dryRunFn: func(ctx context.Context, s *storage, stored *unstructured.Unstructured) error {
+				update := stored.DeepCopy()
+				update.SetLabels(map[string]string{"a": "b"})
+				_, err := s.Update(ctx, update, &rest.UpdateOptions{DryRun: dryRun})
+				return err
+			},
+		},
+		"Delete": {
+			dryRunFn: func(ctx context.Context, s *storage, stored *unstructured.Unstructured) error {
+				_, err := s.Delete(ctx, types.NamespacedName{Namespace: "default", Name: "x"}, &rest.DeleteOptions{DryRun: dryRun})
+				return err
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s, watcherManager := newTestStorage(true)
+			obj, err := s.Create(ctx, testhelper.NewObject("default", "x"))
+			if err != nil {
+				t.Fatalf("unexpected create error: %v", err)
+			}
+			stored := (&unstructured.Unstructured{Object: obj.UnstructuredContent()}).DeepCopy()
+			watcherManager.events()
+
+			if err := tc.dryRunFn(ctx, s, stored); err != nil {
+				t.Fatalf("unexpected dry run error: %v", err)
+			}
+			if events := watcherManager.events(); len(events) != 0 {
+				t.Errorf("want no events, got %v", events)
+			}
+			ul, err := s.List(ctx, &rest.ListOptions{Namespace: "default", ShowManagedFields: true})
+			if err != nil {
+				t.Fatalf("unexpected list error: %v", err)
+			}
+			items := ul.(*unstructured.UnstructuredList).Items
+			if len(items) != 1 {
+				t.Fatalf("want 1 item, got %d", len(items))
+			}
+			if diff := cmp.Diff(stored.Object, items[0].Object); diff != "" {
+				t.Errorf("want the stored object unchanged (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAudit(t *testing.T) {
+	cases := map[string]struct {
+		mutateFn          func(ctx context.Context, s *storage, stored *unstructured.Unstructured) error
+		expectedOperation auditpb.Operation
+		expectedBefore    bool
+		expectedAfter     bool
+	}{
+		"Update": {
+			mutateFn: func(ctx context.Context, s *storage, stored *unstructured.Unstructured) error {
+				update := stored.DeepCopy()
+				update.SetLabels(map[string]string{"a": "b"})
+				_, err := s.Update(ctx, update, &rest.UpdateOptions{Origin: "test"})
+				return err
+			},
+			expectedOperation: auditpb.Operation_UPDATE,
+			expectedBefore:    true,
+			expectedAfter:     true,
+		},
+		"Delete": {
+			mutateFn: func(ctx context.Context, s *storage, stored *unstructured.Unstructured) error {
+				_, err := s.Delete(ctx, types.NamespacedName{Namespace: "default", Name: "x"}, &rest.DeleteOptions{Origin: "test"})
+				return err
+			},
+			expectedOperation: auditpb.Operation_DELETE,
+			expectedBefore:    true,
+		},
+		"DryRun": {
+			mutateFn: func(ctx context.Context, s *storage, stored *unstructured.Unstructured) error {
+				_, err := s.Delete(ctx, types.NamespacedName{Namespace: "default", Name: "x"}, &rest.DeleteOptions{Origin: "test", DryRun: []string{"All"}})
+				return err
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s, _ := newTestStorage(true)
+			auditor := &testAuditor{}
+			s.SetAuditor(auditor)
+			obj, err := s.Create(ctx, testhelper.NewObject("default", "x"), &rest.CreateOptions{Origin: "test"})
+			if err != nil {
+				t.Fatalf("unexpected create error: %v", err)
+			}
+			if len(auditor.entries) != 1 || auditor.entries[0].Operation != auditpb.Operation_CREATE || auditor.entries[0].Before != nil || auditor.entries[0].After == nil {
+				t.Fatalf("want 1 create entry, got %v", auditor.entries)
+			}
+			auditor.entries = nil
+
+			if err := tc.mutateFn(ctx, s, &unstructured.Unstructured{Object: obj.UnstructuredContent()}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.expectedOperation == auditpb.Operation_UNKNOWN {
+				if len(auditor.entries) != 0 {
+					t.Errorf("want no entries, got %v", auditor.entries)
+				}
+				return
+			}
+			if len(auditor.entries) != 1 {
+				t.Fatalf("want 1 entry, got %d", len(auditor.entries))
+			}
+			entry := auditor.entries[0]
+			if entry.Operation != tc.expectedOperation {
+				t.Errorf("want operation %s, got %s", tc.expectedOperation, entry.Operation)
+			}
+			if entry.Origin != "test" {
+				t.Errorf("want origin %q, got %q", "test", entry.Origin)
+			}
+			if (entry.Before != nil) != tc.expectedBefore {
+				t.Errorf("want before %t, got %v", tc.expectedBefore, entry.Before)
+			}
+			if (entry.After != nil) != tc.expectedAfter {
+				t.Errorf("want after %t, got %v", tc.expectedAfter, entry.After)
+			}
+		})
+	}
+}
@@ -41,10 +41,12 @@ func NewStrategy(
 	structuralSchema *structuralschema.Structural,
 	defaulter runtime.ObjectDefaulter,
 	invoker options.BackendInvoker,
+	statusEnabled bool,
 ) strategy {
 	return strategy{
 		namespaceScoped: namespaceScoped,
 		gvk:             gvk,
+		statusEnabled:   statusEnabled,
 		defaulter:       defaulter,
 		validator: apiValidator{
 			namespaceScoped:       namespaceScoped,
@@ -66,6 +68,54 @@ type strategy struct {
 	invoker          options.BackendInvoker
 	structuralSchema *structuralschema.Structural
 	celValidator     *cel.Validator
+	// statusEnabled indicates the status subresource is enabled, the status is
+	// only updated through the status subresource
+	statusEnabled bool
+}
+
+func (r strategy) NamespaceScoped() bool {
+	return r.namespaceScoped
+}
+
+func (r strategy) StatusEnabled() bool {
+	return r.statusEnabled
+}
+
+func (r strategy) PrepareForCreate(ctx context.Context, obj runtime.Unstructured) {
+	if r.statusEnabled {
+		delete(obj.UnstructuredContent(), "status")
+	}
+}
+
+func (r strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Unstructured) {
+	if !r.statusEnabled {
+		return
+	}
+	content := obj.UnstructuredContent()
+	if status, ok := old.UnstructuredContent()["status"]; ok {
+		content["status"] = runtime.DeepCopyJSONValue(status)
+	} else {
+		delete(content, "status")
+	}
+	obj.SetUnstructuredContent(content)
+}
+
+// PrepareForStatusUpdate returns a copy of old with the status of obj; the resourceVersion
+// of obj is retained for the conflict detection and the managedFields of obj, when present,
+// since they are updated by the fieldmanager
+func (r strategy) PrepareForStatusUpdate(ctx context.Context, obj, old runtime.Unstructured) runtime.Unstructured {
+	u := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(old.UnstructuredContent())}
+	if status, ok := obj.UnstructuredContent()["status"]; ok {
+		u.Object["status"] = runtime.DeepCopyJSONValue(status)
+	} else {
+		delete(u.Object, "status")
+	}
+	newu := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	u.SetResourceVersion(newu.GetResourceVersion())
+	if managedFields := newu.GetManagedFields(); len(managedFields) > 0 {
+		u.SetManagedFields(managedFields)
+	}
+	return u
 }
 
 func (r strategy) InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error) {
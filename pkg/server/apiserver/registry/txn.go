@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"fmt"
+
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Transaction runs the function in a transaction of the store, the changes are indexed
+// once the transaction is committed. Stores that are not transactional apply the changes
+// of the function directly, the storage serializes its writes. The lock is held during the
+// transaction, such that a lookup does not observe the store and the indexes out of sync.
+func (r *indexedStore) Transaction(fn func(txn boltstore.Txn) error) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	itxn := &indexTxn{changes: map[store.Key]runtime.Unstructured{}}
+	run := func(txn boltstore.Txn) error {
+		itxn.Txn = txn
+		return fn(itxn)
+	}
+
+	var err error
+	if s, ok := r.UnstructuredStore.(boltstore.Transactional); ok {
+		err = s.Transaction(run)
+	} else {
+		err = run(&storeTxn{store: r.UnstructuredStore})
+	}
+	if err != nil {
+		return err
+	}
+	for key, obj := range itxn.changes {
+		if obj == nil {
+			r.unindex(key)
+			continue
+		}
+		r.index(key, obj)
+	}
+	return nil
+}
+
+// indexTxn records the changes of the transaction, a nil object is a delete
+type indexTxn struct {
+	boltstore.Txn
+	changes map[store.Key]runtime.Unstructured
+}
+
+func (r *indexTxn) Apply(key store.Key, obj runtime.Unstructured) error {
+	if err := r.Txn.Apply(key, obj); err != nil {
+		return err
+	}
+	r.changes[key] = obj
+	return nil
+}
+
+func (r *indexTxn) Delete(key store.Key) error {
+	if err := r.Txn.Delete(key); err != nil {
+		return err
+	}
+	r.changes[key] = nil
+	return nil
+}
+
+// storeTxn applies the changes of a transaction directly to a store that is not transactional
+type storeTxn struct {
+	store store.UnstructuredStore
+}
+
+func (r *storeTxn) Get(key store.Key) (runtime.Unstructured, error) {
+	return r.store.Get(key)
+}
+
+func (r *storeTxn) Apply(key store.Key, obj runtime.Unstructured) error {
+	return r.store.Apply(key, obj)
+}
+
+func (r *storeTxn) Delete(key store.Key) error {
+	return r.store.Delete(key)
+}
+
+func (r *storeTxn) Store(other store.UnstructuredStore) (boltstore.Txn, error) {
+	return nil, fmt.Errorf("store is not transactional")
+}
@@ -21,9 +21,10 @@ import (
 
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
-	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
 	"github.com/kform-dev/choreo/pkg/util/object"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -43,17 +44,43 @@ func (r *storage) Update(ctx context.Context, new runtime.Unstructured, opts ...
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot access objectMeta err: %s", err.Error())
 	}
+	r.defaultNamespace(newObjectMeta)
 
-	old, err := r.Get(ctx, newObjectMeta.GetName(), &rest.GetOptions{ShowManagedFields: true})
+	if err := r.validateSubresource(o.Subresource); err != nil {
+		return nil, err
+	}
+
+	old, err := r.Get(ctx, getKey(newObjectMeta), &rest.GetOptions{ShowManagedFields: true})
 	if err != nil {
 		return nil, err // apierror context is already added
 	}
 
-	return r.update(ctx, new, old, &rest.UpdateOptions{DryRun: o.DryRun})
+	if o.Subresource == rest.SubresourceStatus {
+		new = r.updateStrategy.PrepareForStatusUpdate(ctx, new, old)
+	} else {
+		r.updateStrategy.PrepareForUpdate(ctx, new, old)
+	}
+
+	return r.update(ctx, new, old, &rest.UpdateOptions{DryRun: o.DryRun, Recursion: o.Recursion}, mutation{operation: auditpb.Operation_UPDATE, origin: o.Origin})
+
+}
 
+// validateSubresource validates the subresource of an update or apply request
+func (r *storage) validateSubresource(subresource string) error {
+	switch subresource {
+	case "":
+		return nil
+	case rest.SubresourceStatus:
+		if !r.updateStrategy.StatusEnabled() || r.statusFieldManager == nil {
+			return status.Errorf(codes.Unimplemented, "status subresource is not enabled for %s", r.newFn().GetObjectKind().GroupVersionKind().Kind)
+		}
+		return nil
+	default:
+		return status.Errorf(codes.InvalidArgument, "unsupported subresource %q", subresource)
+	}
 }
 
-func (r *storage) update(ctx context.Context, new, old runtime.Unstructured, o *rest.UpdateOptions) (runtime.Unstructured, error) {
+func (r *storage) update(ctx context.Context, new, old runtime.Unstructured, o *rest.UpdateOptions, m mutation) (runtime.Unstructured, error) {
 	newObjectMeta, err := meta.Accessor(new)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot access objectMeta err: %s", err.Error())
@@ -68,34 +95,39 @@ func (r *storage) update(ctx context.Context, new, old runtime.Unstructured, o *
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", errs)
 	}
 
-	recursion := false
-	if len(o.DryRun) == 1 && o.DryRun[0] == "recursion" {
-		recursion = true
-		o.DryRun = []string{}
-	}
-
-	rnew, rold, err := r.updateStrategy.InvokeUpdate(ctx, new, old, recursion)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "cannot prepare for update err: %s", err.Error())
+	// a dry run does not invoke the backend since the backend persists its own state
+	if !isDryRun(o.DryRun) {
+		rnew, rold, err := r.updateStrategy.InvokeUpdate(ctx, new, old, o.Recursion)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot prepare for update err: %s", err.Error())
+		}
+		new = rnew.(runtime.Unstructured)
+		old = rold.(runtime.Unstructured)
 	}
-	new = rnew.(runtime.Unstructured)
-	old = rold.(runtime.Unstructured)
 
 	// if the resourceVersion mismatch we dont allow the update to go through, since this can lead to
 	// inconsistencies
 	if newObjectMeta.GetResourceVersion() != oldObjectMeta.GetResourceVersion() {
-		return nil, status.Errorf(codes.PermissionDenied, "conflict resource version mismatch %s/%s", newObjectMeta.GetResourceVersion(), oldObjectMeta.GetResourceVersion())
+		return nil, status.Errorf(codes.Aborted, "conflict resource version mismatch %s/%s", newObjectMeta.GetResourceVersion(), oldObjectMeta.GetResourceVersion())
 	}
 
 	if newObjectMeta.GetDeletionTimestamp() != nil && len(newObjectMeta.GetFinalizers()) == 0 {
-
-		if err := r.storage.Delete(store.ToKey(newObjectMeta.GetName())); err != nil {
-			return nil, status.Errorf(codes.Internal, "cannot delete object err: %s", err.Error())
+		if isDryRun(o.DryRun) {
+			return new, nil
+		}
+		if err := r.write(ctx, resourcepb.Watch_DELETED, new, old, m, func() error {
+			return r.index.Transaction(func(txn boltstore.Txn) error {
+				if err := r.checkResourceVersion(txn, getKey(newObjectMeta), old); err != nil {
+					return err
+				}
+				if err := txn.Delete(store.KeyFromNSN(getKey(newObjectMeta))); err != nil {
+					return status.Errorf(codes.Internal, "cannot delete object err: %s", err.Error())
+				}
+				return nil
+			})
+		}); err != nil {
+			return nil, err
 		}
-		r.notifyWatcher(ctx, watch.Event{
-			Type:   resourcepb.Watch_DELETED,
-			Object: new,
-		})
 		// deleted
 		return new, nil
 	}
@@ -109,8 +141,6 @@ func (r *storage) update(ctx context.Context, new, old runtime.Unstructured, o *
 	if apiequality.Semantic.DeepEqual(copiednew, copiedold) {
 		return new, nil
 	}
-	UpdateResourceVersion(newObjectMeta, oldObjectMeta)
-
 	// there is a change in the object, check if the spec is equal or not
 	specEqual, err := isSpecEqual(old, new)
 	if err != nil {
@@ -121,18 +151,23 @@ func (r *storage) update(ctx context.Context, new, old runtime.Unstructured, o *
 		UpdateGeneration(newObjectMeta, object.GetGeneration(old))
 	}
 
-	if len(o.DryRun) > 0 {
+	if isDryRun(o.DryRun) {
 		return new, nil
 	}
 
-	if err = r.storage.Update(store.ToKey(newObjectMeta.GetName()), new); err != nil {
-		return nil, status.Errorf(codes.Internal, "cannot update object in store, err: %s", err.Error())
+	if err := r.write(ctx, resourcepb.Watch_MODIFIED, new, old, m, func() error {
+		return r.index.Transaction(func(txn boltstore.Txn) error {
+			if err := r.checkResourceVersion(txn, getKey(newObjectMeta), old); err != nil {
+				return err
+			}
+			if err := txn.Apply(store.KeyFromNSN(getKey(newObjectMeta)), new); err != nil {
+				return status.Errorf(codes.Internal, "cannot update object in store, err: %s", err.Error())
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, err
 	}
-
-	r.notifyWatcher(ctx, watch.Event{
-		Type:   resourcepb.Watch_MODIFIED,
-		Object: new,
-	})
 	return new, nil
 }
 
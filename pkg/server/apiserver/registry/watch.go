@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/henderiw/logger/log"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
@@ -32,10 +34,22 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// bookmarkInterval is the interval at which bookmark events are send
+// to watchers that allow bookmarks
+var bookmarkInterval = time.Minute
+
 func (r *storage) Watch(ctx context.Context, opts ...rest.ListOption) (watch.Interface, error) {
 	o := rest.ListOptions{}
 	o.ApplyOptions(opts)
 
+	// validate the resourceVersion upfront, such that the client gets a proper error
+	// and can relist when the resourceVersion is no longer available
+	if o.ResourceVersion != "" {
+		if _, err := r.history.since(o.ResourceVersion); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 
 	log := log.FromContext(ctx)
@@ -46,6 +60,7 @@ func (r *storage) Watch(ctx context.Context, opts ...rest.ListOption) (watch.Int
 		resultChan:     make(chan watch.Event),
 		watcherManager: r.watcherManager,
 		obj:            r.newFn(),
+		options:        o,
 	}
 
 	go w.listAndWatch(ctx, r, o)
@@ -61,11 +76,14 @@ type watcher struct {
 	resultChan     chan watch.Event
 	watcherManager watchermanager.WatcherManager
 	obj            runtime.Unstructured
+	options        rest.ListOptions
 
 	// protection against concurrent access
 	m             sync.Mutex
-	eventCallback func(eventType resourcepb.Watch_EventType, obj runtime.Unstructured) bool
+	eventCallback func(event watch.Event) bool
 	done          bool
+	// revision of the last event seen by the watcher
+	revision uint64
 }
 
 var _ watch.Interface = &watcher{}
@@ -85,11 +103,35 @@ func (r *watcher) ResultChan() <-chan watch.Event {
 
 // Implement the watcchermanafer.Watcher interface
 // OnChange is the callback called when a object changes.
-func (r *watcher) OnChange(eventType resourcepb.Watch_EventType, obj runtime.Unstructured) bool {
+func (r *watcher) OnChange(event watch.Event) bool {
 	r.m.Lock()
 	defer r.m.Unlock()
 
-	return r.eventCallback(eventType, obj)
+	return r.eventCallback(event)
+}
+
+// matches returns true if the object belongs to the namespace the watcher selected
+func (r *watcher) matches(obj runtime.Unstructured) bool {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	return r.options.MatchesNamespace(accessor.GetNamespace())
+}
+
+// seen returns true if the event was already processed by the watcher, this happens when
+// an event is part of the replayed history as well as the backlog.
+// Otherwise the revision of the watcher is updated; the caller holds the lock.
+func (r *watcher) seen(event watch.Event) bool {
+	revision, err := strconv.ParseUint(event.ResourceVersion, 10, 64)
+	if err != nil {
+		return false
+	}
+	if revision <= r.revision {
+		return true
+	}
+	r.revision = revision
+	return false
 }
 
 func (r *watcher) listAndWatch(ctx context.Context, s *storage, options rest.ListOptions) {
@@ -111,7 +153,8 @@ func (r *watcher) listAndWatch(ctx context.Context, s *storage, options rest.Lis
 
 // innerListAndWatch provides the callback handler
 // 1. add a callback handler to receive any event we get while collecting the list of existing resources
-// 2.
+// 2. list the existing resources or replay the history when resuming from a resourceVersion
+// 3. flush the backlog and move into streaming mode
 func (r *watcher) innerListAndWatch(ctx context.Context, s *storage, options rest.ListOptions) error {
 	log := log.FromContext(ctx)
 
@@ -122,14 +165,11 @@ func (r *watcher) innerListAndWatch(ctx context.Context, s *storage, options res
 	// Make sure we hold the lock when setting the eventCallback, as it
 	// will be read by other goroutines when events happen.
 	r.m.Lock()
-	r.eventCallback = func(eventType resourcepb.Watch_EventType, obj runtime.Unstructured) bool {
+	r.eventCallback = func(event watch.Event) bool {
 		if r.done {
 			return false
 		}
-		backlog = append(backlog, watch.Event{
-			Type:   eventType,
-			Object: obj,
-		})
+		backlog = append(backlog, event)
 		return true
 	}
 	r.m.Unlock()
@@ -141,10 +181,30 @@ func (r *watcher) innerListAndWatch(ctx context.Context, s *storage, options res
 		return err
 	}
 
-	// options.Watch means watch only no listing
-	if !options.Watch {
+	switch {
+	case options.ResourceVersion != "":
+		// resume the watch by replaying the events after the resourceVersion
+		log.Debug("resuming watch", "resourceVersion", options.ResourceVersion)
+		events, err := s.history.since(options.ResourceVersion)
+		if err != nil {
+			r.setDone()
+			return err
+		}
+		r.m.Lock()
+		r.seen(watch.Event{ResourceVersion: options.ResourceVersion})
+		for _, ev := range events {
+			if !r.seen(ev) && r.matches(ev.Object) {
+				r.sendWatchEvent(ctx, ev)
+			}
+		}
+		r.m.Unlock()
+	case !options.Watch:
+		// options.Watch means watch only no listing
 		log.Debug("starting list watch")
-		obj, err := s.List(ctx, &options)
+		listOptions := options
+		listOptions.Limit = 0
+		listOptions.Continue = ""
+		obj, err := s.List(ctx, &listOptions)
 		if err != nil {
 			r.setDone()
 			return err
@@ -165,10 +225,16 @@ func (r *watcher) innerListAndWatch(ctx context.Context, s *storage, options res
 			log.Debug("listwatch", "obj", obj)
 			r.sendWatchEvent(ctx, ev)
 		}
+		r.m.Lock()
+		r.seen(watch.Event{ResourceVersion: list.GetResourceVersion()})
+		r.m.Unlock()
 
 		log.Debug("finished list watch")
-	} else {
+	default:
 		log.Debug("watch only, no list")
+		r.m.Lock()
+		r.seen(watch.Event{ResourceVersion: s.history.resourceVersion()})
+		r.m.Unlock()
 	}
 
 	// Repeatedly flush the backlog until we catch up
@@ -183,41 +249,87 @@ func (r *watcher) innerListAndWatch(ctx context.Context, s *storage, options res
 		}
 		log.Debug("flushing backlog", "chunk length", len(chunk))
 		for _, ev := range chunk {
-			r.sendWatchEvent(ctx, ev)
+			// when listing, the events of the backlog are send even when they are
+			// already reflected in the list
+			r.m.Lock()
+			seen := r.seen(ev)
+			r.m.Unlock()
+			send := options.ResourceVersion == "" || !seen
+			if send && r.matches(ev.Object) {
+				r.sendWatchEvent(ctx, ev)
+			}
 		}
 	}
 
 	r.m.Lock()
 	// Pick up anything that squeezed in
 	for _, ev := range backlog {
-		r.sendWatchEvent(ctx, ev)
+		seen := r.seen(ev)
+		if (options.ResourceVersion == "" || !seen) && r.matches(ev.Object) {
+			r.sendWatchEvent(ctx, ev)
+		}
 	}
 
 	log.Debug("moving into streaming mode")
-	r.eventCallback = func(eventType resourcepb.Watch_EventType, obj runtime.Unstructured) bool {
-		accessor, _ := meta.Accessor(obj)
-		log.Debug("eventCallBack", "eventType", eventType, "nsn", fmt.Sprintf("%s/%s", accessor.GetNamespace(), accessor.GetName()))
+	r.eventCallback = func(event watch.Event) bool {
 		if r.done {
 			return false
 		}
-		ev := watch.Event{
-			Type:   eventType,
-			Object: obj,
+		r.seen(event)
+		if !r.matches(event.Object) {
+			return true
 		}
-		r.sendWatchEvent(ctx, ev)
+		accessor, _ := meta.Accessor(event.Object)
+		log.Debug("eventCallBack", "eventType", event.Type, "nsn", fmt.Sprintf("%s/%s", accessor.GetNamespace(), accessor.GetName()))
+		r.sendWatchEvent(ctx, event)
 		return true
 	}
+	if options.AllowBookmarks {
+		// signal the client the watch is synced
+		r.sendBookmark(ctx)
+	}
 	r.m.Unlock()
 
-	select {
-	case <-ctx.Done():
-		r.setDone()
-		return ctx.Err()
+	var bookmarks <-chan time.Time
+	if options.AllowBookmarks {
+		ticker := time.NewTicker(bookmarkInterval)
+		defer ticker.Stop()
+		bookmarks = ticker.C
+	}
 
-	case err := <-errorResult:
-		r.setDone()
-		return err
+	for {
+		select {
+		case <-ctx.Done():
+			r.setDone()
+			return ctx.Err()
+
+		case err := <-errorResult:
+			r.setDone()
+			return err
+
+		case <-bookmarks:
+			r.m.Lock()
+			if !r.done {
+				r.sendBookmark(ctx)
+			}
+			r.m.Unlock()
+		}
+	}
+}
+
+// sendBookmark sends a bookmark with the revision of the last event seen by the watcher,
+// the caller holds the lock
+func (r *watcher) sendBookmark(ctx context.Context) {
+	obj := r.obj.DeepCopyObject().(runtime.Unstructured)
+	resourceVersion := strconv.FormatUint(r.revision, 10)
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetResourceVersion(resourceVersion)
 	}
+	r.sendWatchEvent(ctx, watch.Event{
+		Type:            resourcepb.Watch_BOOKMARK,
+		Object:          obj,
+		ResourceVersion: resourceVersion,
+	})
 }
 
 func (r *watcher) sendWatchEvent(ctx context.Context, event watch.Event) {
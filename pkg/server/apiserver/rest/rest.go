@@ -20,37 +20,83 @@ import (
 	"context"
 
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
 	"github.com/kform-dev/choreo/pkg/server/selector"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // ListOptions is a subset of "k8s.io/apimachinery/pkg/apis/meta/internalversio
 
 type Storage interface {
-	Get(ctx context.Context, key string, opts ...GetOption) (runtime.Unstructured, error)
+	Get(ctx context.Context, key types.NamespacedName, opts ...GetOption) (runtime.Unstructured, error)
 	List(ctx context.Context, opts ...ListOption) (runtime.Unstructured, error)
 	Apply(ctx context.Context, obj runtime.Unstructured, opts ...ApplyOption) (runtime.Unstructured, error)
 	Create(ctx context.Context, obj runtime.Unstructured, opts ...CreateOption) (runtime.Unstructured, error)
 	Update(ctx context.Context, obj runtime.Unstructured, opts ...UpdateOption) (runtime.Unstructured, error)
-	Delete(ctx context.Context, key string, opts ...DeleteOption) (runtime.Unstructured, error)
+	Delete(ctx context.Context, key types.NamespacedName, opts ...DeleteOption) (runtime.Unstructured, error)
 	Watch(ctx context.Context, opts ...ListOption) (watch.Interface, error)
 }
 
+// Indexer is implemented by a storage that maintains secondary indexes to resolve
+// list selectors without a full scan; labels and ownerReferences are always indexed
+type Indexer interface {
+	// SetIndexes declares the field paths (expressions) to be indexed for the owner, replacing
+	// the field paths the owner declared before; an empty list removes the indexes of the owner
+	SetIndexes(owner string, expressions ...string) error
+}
+
+// AuditEntry describes a mutation that is written to a storage; Before is nil for a create
+// and After is nil when the object is removed from the storage
+type AuditEntry struct {
+	Operation    auditpb.Operation
+	Origin       string
+	FieldManager string
+	Before       runtime.Unstructured
+	After        runtime.Unstructured
+}
+
+// Auditor records the mutations that are written to a storage
+type Auditor interface {
+	Audit(ctx context.Context, entry *AuditEntry)
+}
+
+// Auditable is implemented by a storage that reports its mutations to an auditor
+type Auditable interface {
+	// SetAuditor sets the auditor of the storage, a nil auditor stops the auditing
+	SetAuditor(auditor Auditor)
+}
+
 type Strategy interface {
 	CreateStrategy
 	UpdateStrategy
 	DeleteStrategy
 }
 
+// SubresourceStatus is the status subresource of an object
+const SubresourceStatus = "status"
+
 type CreateStrategy interface {
+	// NamespaceScoped returns true if the objects of the resource are namespaced
+	NamespaceScoped() bool
+	// PrepareForCreate clears the fields that cannot be set on create, e.g. the status
+	// when the status subresource is enabled
+	PrepareForCreate(ctx context.Context, obj runtime.Unstructured)
 	ValidateCreate(ctx context.Context, obj runtime.Unstructured) field.ErrorList
 	// called when async procedure is implemented by the storage layer
 	InvokeCreate(ctx context.Context, obj runtime.Object, recursion bool) (runtime.Object, error)
 }
 
 type UpdateStrategy interface {
+	// StatusEnabled indicates the status subresource is enabled
+	StatusEnabled() bool
+	// PrepareForUpdate resets the status of obj to the status of old when
+	// the status subresource is enabled
+	PrepareForUpdate(ctx context.Context, obj, old runtime.Unstructured)
+	// PrepareForStatusUpdate returns old with the status of obj
+	PrepareForStatusUpdate(ctx context.Context, obj, old runtime.Unstructured) runtime.Unstructured
 	ValidateUpdate(ctx context.Context, obj, old runtime.Unstructured) field.ErrorList
 	// called when async procedure is implemented by the storage layer
 	InvokeUpdate(ctx context.Context, obj, old runtime.Object, recursion bool) (runtime.Object, runtime.Object, error)
@@ -105,9 +151,21 @@ type ListOptions struct {
 	ShowManagedFields bool
 	Trace             string
 	Origin            string
+	// Namespace restricts the list/watch to a single namespace,
+	// an empty namespace selects all namespaces
+	Namespace string
 	// A selector based on expressions
 	Selector selector.Selector
 	Watch    bool
+	// Limit is the maximum number of items returned by a list, 0 returns all items
+	Limit int64
+	// Continue is the token returned by a previous list to retrieve the next page
+	Continue string
+	// ResourceVersion resumes a watch after the given revision of the storage,
+	// the initial list is skipped
+	ResourceVersion string
+	// AllowBookmarks requests bookmark events on a watch
+	AllowBookmarks bool
 }
 
 func (o *ListOptions) ApplyToList(lo *ListOptions) {
@@ -117,8 +175,18 @@ func (o *ListOptions) ApplyToList(lo *ListOptions) {
 	lo.ShowManagedFields = o.ShowManagedFields
 	lo.Trace = o.Trace
 	lo.Origin = o.Origin
+	lo.Namespace = o.Namespace
 	lo.Selector = o.Selector
 	lo.Watch = o.Watch
+	lo.Limit = o.Limit
+	lo.Continue = o.Continue
+	lo.ResourceVersion = o.ResourceVersion
+	lo.AllowBookmarks = o.AllowBookmarks
+}
+
+// MatchesNamespace returns true if the namespace is selected by the options
+func (o *ListOptions) MatchesNamespace(namespace string) bool {
+	return o.Namespace == "" || o.Namespace == namespace
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -141,12 +209,16 @@ type CreateOptions struct {
 	Trace  string
 	Origin string
 	DryRun []string
+	// Recursion indicates the request is issued by the backend invoked by the storage,
+	// so the backend is not invoked again
+	Recursion bool
 }
 
 func (o *CreateOptions) ApplyToCreate(lo *CreateOptions) {
 	lo.Trace = o.Trace
 	lo.Origin = o.Origin
 	lo.DryRun = o.DryRun
+	lo.Recursion = o.Recursion
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -169,12 +241,19 @@ type UpdateOptions struct {
 	Trace  string
 	Origin string
 	DryRun []string
+	// Recursion indicates the request is issued by the backend invoked by the storage,
+	// so the backend is not invoked again
+	Recursion bool
+	// Subresource restricts the update to a subresource of the object, e.g. status
+	Subresource string
 }
 
 func (o *UpdateOptions) ApplyToUpdate(lo *UpdateOptions) {
 	lo.Trace = o.Trace
 	lo.Origin = o.Origin
 	lo.DryRun = o.DryRun
+	lo.Recursion = o.Recursion
+	lo.Subresource = o.Subresource
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -197,12 +276,16 @@ type DeleteOptions struct {
 	Trace  string
 	Origin string
 	DryRun []string
+	// Recursion indicates the request is issued by the backend invoked by the storage,
+	// so the backend is not invoked again
+	Recursion bool
 }
 
 func (o *DeleteOptions) ApplyToDelete(lo *DeleteOptions) {
 	lo.Trace = o.Trace
 	lo.Origin = o.Origin
 	lo.DryRun = o.DryRun
+	lo.Recursion = o.Recursion
 }
 
 // ApplyOptions applies the given get options on these options,
@@ -227,6 +310,11 @@ type ApplyOptions struct {
 	DryRun       []string
 	FieldManager string
 	Force        bool
+	// Recursion indicates the request is issued by the backend invoked by the storage,
+	// so the backend is not invoked again
+	Recursion bool
+	// Subresource restricts the apply to a subresource of the object, e.g. status
+	Subresource string
 }
 
 func (o *ApplyOptions) ApplyToApply(lo *ApplyOptions) {
@@ -235,6 +323,8 @@ func (o *ApplyOptions) ApplyToApply(lo *ApplyOptions) {
 	lo.DryRun = o.DryRun
 	lo.FieldManager = o.FieldManager
 	lo.Force = o.Force
+	lo.Recursion = o.Recursion
+	lo.Subresource = o.Subresource
 }
 
 // ApplyOptions applies the given get options on these options,
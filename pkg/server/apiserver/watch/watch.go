@@ -52,4 +52,8 @@ type Event struct {
 	//  * If Type is Error: *api.Status is recommended; other types may make sense
 	//    depending on context.
 	Object runtime.Unstructured
+
+	// ResourceVersion is the revision of the storage after the event, it is
+	// used to resume a watch and is set for all events except errors
+	ResourceVersion string
 }
@@ -116,7 +116,11 @@ func (r *watcherManager) Start(ctx context.Context) {
 					obj := event.Object.DeepCopyObject().(runtime.Unstructured)
 
 					// the callback deals with filtering
-					if keepGoing := w.callback.OnChange(event.Type, obj); !keepGoing {
+					if keepGoing := w.callback.OnChange(watch.Event{
+						Type:            event.Type,
+						Object:          obj,
+						ResourceVersion: event.ResourceVersion,
+					}); !keepGoing {
 						log.Debug("stopping watcher due to !keepGoing", "key", w.key)
 						r.watchers.del(w.key)
 						r.sem.Release(1)
@@ -17,13 +17,14 @@ limitations under the License.
 package watchermanager
 
 import (
-	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
-	"k8s.io/apimachinery/pkg/runtime"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
 )
 
 type Watcher interface {
-	OnChange(eventType resourcepb.Watch_EventType, obj runtime.Unstructured) bool
+	// OnChange is called for every event of the storage, the watcher filters
+	// the events itself such that it can track the resourceVersion of the storage
+	OnChange(event watch.Event) bool
 }
 
 type watcher struct {
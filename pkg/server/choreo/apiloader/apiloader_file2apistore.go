@@ -48,6 +48,7 @@ type APILoaderFile2APIStoreAndAPI struct {
 	RepoPath     string // not relevant in commit case
 	PathInRepo   string
 	DBPath       string
+	DBBackend    string
 }
 
 func (r *APILoaderFile2APIStoreAndAPI) LoadFromCommit(ctx context.Context, commit *object.Commit) error {
@@ -88,6 +89,7 @@ func (r *APILoaderFile2APIStoreAndAPI) loadAPIs(ctx context.Context, reader pkgi
 		InternalGVKs: r.InternalGVKs,
 		PathInRepo:   r.PathInRepo,
 		DBPath:       r.DBPath,
+		DBBackend:    r.DBBackend,
 	}
 	var errm error
 	datastore.List(func(k store.Key, rn *yaml.RNode) {
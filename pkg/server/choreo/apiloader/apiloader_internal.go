@@ -25,6 +25,7 @@ import (
 	"github.com/henderiw/store"
 	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
 	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/registry"
 	"github.com/kform-dev/choreo/pkg/server/choreo/crdloader"
 	"github.com/kform-dev/kform/pkg/pkgio"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -35,6 +36,7 @@ type APILoaderInternal struct {
 	APIStore   *api.APIStore
 	Cfg        *genericclioptions.ChoreoConfig
 	DBPath     string
+	DBBackend  string
 	PathInRepo string
 }
 
@@ -82,7 +84,11 @@ func (r *APILoaderInternal) loadAPIs(ctx context.Context, reader pkgio.Reader[*y
 			}
 			// TODO handle internal apis
 
-			resctx, err := crdloader.LoadCRD(ctx, r.PathInRepo, r.DBPath, crd, backends, choreoAPI)
+			resctx, err := crdloader.LoadCRD(ctx, &registry.StorageConfig{
+				Backend:    registry.Backend(r.DBBackend),
+				PathInRepo: r.PathInRepo,
+				DBPath:     r.DBPath,
+			}, crd, backends, choreoAPI)
 			if err != nil {
 				errm = errors.Join(errm, err)
 				return
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/registry"
 	"github.com/kform-dev/choreo/pkg/server/choreo/crdloader"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -50,6 +51,7 @@ type APIStoreLoader struct {
 	InternalGVKs sets.Set[schema.GroupVersionKind]
 	PathInRepo   string
 	DBPath       string
+	DBBackend    string
 }
 
 func (r *APIStoreLoader) Load(ctx context.Context, u *unstructured.Unstructured) error {
@@ -58,7 +60,11 @@ func (r *APIStoreLoader) Load(ctx context.Context, u *unstructured.Unstructured)
 		return err
 	}
 
-	resctx, err := crdloader.LoadCRD(ctx, r.PathInRepo, r.DBPath, crd, nil, false)
+	resctx, err := crdloader.LoadCRD(ctx, &registry.StorageConfig{
+		Backend:    registry.Backend(r.DBBackend),
+		PathInRepo: r.PathInRepo,
+		DBPath:     r.DBPath,
+	}, crd, nil, false)
 	if err != nil {
 		return err
 	}
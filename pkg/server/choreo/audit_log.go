@@ -0,0 +1,432 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/henderiw/logger/log"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
+	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const auditLogFileName = "audit.log"
+
+// AuditRetentionPolicy defines when the audit log is rotated and
+// how many rotated files are retained.
+type AuditRetentionPolicy struct {
+	// MaxSize defines the size in bytes after which the audit log is rotated, 0 means no rotation
+	MaxSize int64
+	// MaxFiles defines the maximum amount of rotated audit log files that are retained
+	MaxFiles int
+}
+
+func NewAuditRetentionPolicy(flags *genericclioptions.ServerFlags) *AuditRetentionPolicy {
+	policy := &AuditRetentionPolicy{}
+	if flags == nil {
+		return policy
+	}
+	if flags.AuditMaxSize != nil {
+		policy.MaxSize = int64(*flags.AuditMaxSize) * 1024 * 1024
+	}
+	if flags.AuditMaxFiles != nil {
+		policy.MaxFiles = *flags.AuditMaxFiles
+	}
+	return policy
+}
+
+func NewAuditLog() *AuditLog {
+	return &AuditLog{}
+}
+
+// AuditLog is an append-only log of the mutations of the resources. The entries
+// are stored as json lines in audit.log, which is rotated to audit.log.1 .. audit.log.<MaxFiles>
+type AuditLog struct {
+	m sync.Mutex
+	// path is the directory where the audit log is persisted
+	// when empty the entries are discarded
+	path   string
+	policy *AuditRetentionPolicy
+	file   *os.File
+	size   int64
+}
+
+// auditRecord is the on-disk representation of an audit entry
+type auditRecord struct {
+	Time                  time.Time       `json:"time"`
+	Branch                string          `json:"branch,omitempty"`
+	APIVersion            string          `json:"apiVersion"`
+	Kind                  string          `json:"kind"`
+	Namespace             string          `json:"namespace,omitempty"`
+	Name                  string          `json:"name"`
+	Operation             string          `json:"operation"`
+	Origin                string          `json:"origin,omitempty"`
+	FieldManager          string          `json:"fieldManager,omitempty"`
+	ResourceVersionBefore string          `json:"resourceVersionBefore,omitempty"`
+	ResourceVersionAfter  string          `json:"resourceVersionAfter,omitempty"`
+	Patch                 json.RawMessage `json:"patch,omitempty"`
+}
+
+func newAuditRecord(entry *auditpb.Entry) *auditRecord {
+	return &auditRecord{
+		Time:                  entry.Time.AsTime(),
+		Branch:                entry.Branch,
+		APIVersion:            entry.ApiVersion,
+		Kind:                  entry.Kind,
+		Namespace:             entry.Namespace,
+		Name:                  entry.Name,
+		Operation:             entry.Operation.String(),
+		Origin:                entry.Origin,
+		FieldManager:          entry.FieldManager,
+		ResourceVersionBefore: entry.ResourceVersionBefore,
+		ResourceVersionAfter:  entry.ResourceVersionAfter,
+		Patch:                 entry.Patch,
+	}
+}
+
+func (r *auditRecord) entry() *auditpb.Entry {
+	return &auditpb.Entry{
+		Time:                  timestamppb.New(r.Time),
+		Branch:                r.Branch,
+		ApiVersion:            r.APIVersion,
+		Kind:                  r.Kind,
+		Namespace:             r.Namespace,
+		Name:                  r.Name,
+		Operation:             auditpb.Operation(auditpb.Operation_value[r.Operation]),
+		Origin:                r.Origin,
+		FieldManager:          r.FieldManager,
+		ResourceVersionBefore: r.ResourceVersionBefore,
+		ResourceVersionAfter:  r.ResourceVersionAfter,
+		Patch:                 r.Patch,
+	}
+}
+
+// NewAuditEntry returns an audit entry for the operation; before is nil for a create
+// and after is nil for a delete. The patch is the json merge patch from before to after
+// without the managedFields.
+func NewAuditEntry(branch string, op auditpb.Operation, origin, fieldManager string, before, after *unstructured.Unstructured) (*auditpb.Entry, error) {
+	entry := &auditpb.Entry{
+		Time:         timestamppb.Now(),
+		Branch:       branch,
+		Operation:    op,
+		Origin:       origin,
+		FieldManager: fieldManager,
+	}
+	obj := after
+	if obj == nil {
+		obj = before
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("audit entry requires an object")
+	}
+	entry.ApiVersion = obj.GetAPIVersion()
+	entry.Kind = obj.GetKind()
+	entry.Namespace = obj.GetNamespace()
+	entry.Name = obj.GetName()
+	if before != nil {
+		entry.ResourceVersionBefore = before.GetResourceVersion()
+	}
+	if after == nil {
+		// the object is gone, the patch would only null all fields
+		return entry, nil
+	}
+	entry.ResourceVersionAfter = after.GetResourceVersion()
+
+	orig := []byte("{}")
+	if before != nil {
+		b, err := marshalAuditObject(before)
+		if err != nil {
+			return nil, err
+		}
+		orig = b
+	}
+	modified, err := marshalAuditObject(after)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreateMergePatch(orig, modified)
+	if err != nil {
+		return nil, err
+	}
+	entry.Patch = patch
+	return entry, nil
+}
+
+func marshalAuditObject(u *unstructured.Unstructured) ([]byte, error) {
+	u = u.DeepCopy()
+	u.SetManagedFields(nil)
+	return json.Marshal(u.Object)
+}
+
+// Init (re)initializes the audit log in path with the retention policy
+func (r *AuditLog) Init(path string, policy *AuditRetentionPolicy) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if err := r.close(); err != nil {
+		return err
+	}
+	r.path = path
+	r.policy = policy
+	if r.policy == nil {
+		r.policy = &AuditRetentionPolicy{}
+	}
+	if r.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(r.path, 0755); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+func (r *AuditLog) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.close()
+}
+
+func (r *AuditLog) close() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	r.size = 0
+	return err
+}
+
+func (r *AuditLog) open() error {
+	f, err := os.OpenFile(filepath.Join(r.path, auditLogFileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.file = f
+	r.size = fi.Size()
+	return nil
+}
+
+// Record appends the entry to the audit log
+func (r *AuditLog) Record(entry *auditpb.Entry) error {
+	b, err := json.Marshal(newAuditRecord(entry))
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	if r.policy.MaxSize > 0 && r.size > 0 && r.size+int64(len(b)) > r.policy.MaxSize {
+		if err := r.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := r.file.Write(b)
+	r.size += int64(n)
+	return err
+}
+
+// Auditor returns the auditor that records the mutations of the storage of the resource
+// context in the branch; internal resources are recorded with their external apiVersion
+func (r *AuditLog) Auditor(branch string, rctx *api.ResourceContext) rest.Auditor {
+	return &storageAuditor{
+		auditLog: r,
+		branch:   branch,
+		rctx:     rctx,
+	}
+}
+
+type storageAuditor struct {
+	auditLog *AuditLog
+	branch   string
+	rctx     *api.ResourceContext
+}
+
+// Audit records the mutation in the audit log; failing to record the mutation
+// does not fail the write to the storage
+func (r *storageAuditor) Audit(ctx context.Context, entry *rest.AuditEntry) {
+	log := log.FromContext(ctx)
+	auditEntry, err := NewAuditEntry(r.branch, entry.Operation, entry.Origin, entry.FieldManager, r.toExternal(entry.Before), r.toExternal(entry.After))
+	if err != nil {
+		log.Error("cannot create audit entry", "error", err)
+		return
+	}
+	if err := r.auditLog.Record(auditEntry); err != nil {
+		log.Error("cannot record audit entry", "error", err)
+	}
+}
+
+func (r *storageAuditor) toExternal(obj runtime.Unstructured) *unstructured.Unstructured {
+	if obj == nil {
+		return nil
+	}
+	u := (&unstructured.Unstructured{Object: obj.UnstructuredContent()}).DeepCopy()
+	if r.rctx.Internal != nil {
+		u.SetAPIVersion(schema.GroupVersion{Group: r.rctx.External.Group, Version: r.rctx.External.Version}.String())
+	}
+	return u
+}
+
+// setAuditors records the mutations of the storages of the branch in the audit log. The storages
+// of the shared gvks are shared by all branches and are not bound to the branch.
+func setAuditors(branchCtx *BranchCtx, auditLog *AuditLog, shared sets.Set[schema.GroupVersionKind]) {
+	branchCtx.APIStore.List(func(_ store.Key, rctx *api.ResourceContext) {
+		if shared.Has(rctx.ExternalGVK()) {
+			return
+		}
+		if auditable, ok := rctx.Storage.(rest.Auditable); ok {
+			auditable.SetAuditor(auditLog.Auditor(branchCtx.Branch, rctx))
+		}
+	})
+}
+
+// rotate shifts audit.log.<i> to audit.log.<i+1>, drops the files beyond MaxFiles
+// and starts a new audit.log
+func (r *AuditLog) rotate() error {
+	if err := r.close(); err != nil {
+		return err
+	}
+	if err := os.Remove(r.fileName(r.policy.MaxFiles)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	for i := r.policy.MaxFiles - 1; i >= 0; i-- {
+		if err := os.Rename(r.fileName(i), r.fileName(i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	// MaxFiles 0 retains no rotated files
+	if err := os.Remove(r.fileName(0)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return r.open()
+}
+
+// fileName returns the name of the audit log file with index i, 0 being the active file
+func (r *AuditLog) fileName(i int) string {
+	if i == 0 {
+		return filepath.Join(r.path, auditLogFileName)
+	}
+	return filepath.Join(r.path, fmt.Sprintf("%s.%d", auditLogFileName, i))
+}
+
+// List returns the entries matching the options of the request, oldest first
+func (r *AuditLog) List(req *auditpb.List_Request) (*auditpb.List_Response, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	opts := req.GetOptions()
+	if opts == nil {
+		opts = &auditpb.List_Options{}
+	}
+	entries := []*auditpb.Entry{}
+	if r.path == "" {
+		return &auditpb.List_Response{Entries: entries}, nil
+	}
+	for i := r.policy.MaxFiles; i >= 0; i-- {
+		if err := readAuditFile(r.fileName(i), func(entry *auditpb.Entry) {
+			if matchAuditEntry(opts, entry) {
+				entries = append(entries, entry)
+			}
+		}); err != nil {
+			return &auditpb.List_Response{}, status.Errorf(codes.Internal, "cannot read audit log, err: %s", err.Error())
+		}
+	}
+	if opts.Limit > 0 && int64(len(entries)) > opts.Limit {
+		entries = entries[int64(len(entries))-opts.Limit:]
+	}
+	return &auditpb.List_Response{Entries: entries}, nil
+}
+
+func readAuditFile(fileName string, fn func(entry *auditpb.Entry)) error {
+	f, err := os.Open(fileName)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		record := &auditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			// a partially written line is skipped
+			continue
+		}
+		fn(record.entry())
+	}
+	return scanner.Err()
+}
+
+func matchAuditEntry(opts *auditpb.List_Options, entry *auditpb.Entry) bool {
+	if opts.Branch != "" && opts.Branch != entry.Branch {
+		return false
+	}
+	if opts.Group != "" || opts.Kind != "" {
+		gv, _ := schema.ParseGroupVersion(entry.ApiVersion)
+		if opts.Group != "" && opts.Group != gv.Group {
+			return false
+		}
+		if opts.Kind != "" && opts.Kind != entry.Kind {
+			return false
+		}
+	}
+	if opts.Namespace != "" && opts.Namespace != entry.Namespace {
+		return false
+	}
+	if opts.Name != "" && opts.Name != entry.Name {
+		return false
+	}
+	if opts.Origin != "" && opts.Origin != entry.Origin {
+		return false
+	}
+	if opts.Operation != auditpb.Operation_UNKNOWN && opts.Operation != entry.Operation {
+		return false
+	}
+	if opts.Since != nil && entry.Time.AsTime().Before(opts.Since.AsTime()) {
+		return false
+	}
+	return true
+}
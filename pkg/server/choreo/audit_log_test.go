@@ -0,0 +1,162 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func testAuditObject(name, rv string, data map[string]any) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1alpha1",
+		"kind":       "Dummy",
+		"metadata": map[string]any{
+			"name":            name,
+			"namespace":       "default",
+			"resourceVersion": rv,
+			"managedFields":   []any{map[string]any{"manager": "test"}},
+		},
+		"spec": data,
+	}}
+	return u
+}
+
+func TestNewAuditEntry(t *testing.T) {
+	cases := map[string]struct {
+		op            auditpb.Operation
+		before        *unstructured.Unstructured
+		after         *unstructured.Unstructured
+		expectedPatch string
+	}{
+		"Create": {
+			op:            auditpb.Operation_CREATE,
+			after:         testAuditObject("a", "1", map[string]any{"x": "1"}),
+			expectedPatch: `{"apiVersion":"example.com/v1alpha1","kind":"Dummy","metadata":{"name":"a","namespace":"default","resourceVersion":"1"},"spec":{"x":"1"}}`,
+		},
+		"Update": {
+			op:            auditpb.Operation_UPDATE,
+			before:        testAuditObject("a", "1", map[string]any{"x": "1", "y": "1"}),
+			after:         testAuditObject("a", "2", map[string]any{"x": "2"}),
+			expectedPatch: `{"metadata":{"resourceVersion":"2"},"spec":{"x":"2","y":null}}`,
+		},
+		"Delete": {
+			op:     auditpb.Operation_DELETE,
+			before: testAuditObject("a", "2", map[string]any{"x": "2"}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			entry, err := NewAuditEntry("main", tc.op, "choreoctl", "inputfileloader", tc.before, tc.after)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if entry.Name != "a" || entry.Kind != "Dummy" || entry.Branch != "main" {
+				t.Errorf("unexpected entry identity: %v", entry)
+			}
+			if string(entry.Patch) != tc.expectedPatch {
+				t.Errorf("want patch %s, got %s", tc.expectedPatch, string(entry.Patch))
+			}
+		})
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	cases := map[string]struct {
+		policy          *AuditRetentionPolicy
+		records         int
+		opts            *auditpb.List_Options
+		expectedNames   []string
+		expectedRotated int
+	}{
+		"NoRotation": {
+			policy:        &AuditRetentionPolicy{},
+			records:       5,
+			opts:          &auditpb.List_Options{},
+			expectedNames: []string{"o0", "o1", "o2", "o3", "o4"},
+		},
+		"Filter": {
+			policy:        &AuditRetentionPolicy{},
+			records:       5,
+			opts:          &auditpb.List_Options{Origin: "r1"},
+			expectedNames: []string{"o1", "o3"},
+		},
+		"Limit": {
+			policy:        &AuditRetentionPolicy{},
+			records:       5,
+			opts:          &auditpb.List_Options{Limit: 2},
+			expectedNames: []string{"o3", "o4"},
+		},
+		"Rotation": {
+			// every record exceeds the max size, so each record ends up in its own file
+			policy:          &AuditRetentionPolicy{MaxSize: 1, MaxFiles: 2},
+			records:         5,
+			opts:            &auditpb.List_Options{},
+			expectedNames:   []string{"o2", "o3", "o4"},
+			expectedRotated: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			auditLog := NewAuditLog()
+			if err := auditLog.Init(dir, tc.policy); err != nil {
+				t.Fatalf("unexpected init error: %v", err)
+			}
+			defer auditLog.Close()
+			for i := 0; i < tc.records; i++ {
+				entry, err := NewAuditEntry("main", auditpb.Operation_APPLY, fmt.Sprintf("r%d", i%2), "", nil,
+					testAuditObject(fmt.Sprintf("o%d", i), "1", nil))
+				if err != nil {
+					t.Fatalf("unexpected entry error: %v", err)
+				}
+				if err := auditLog.Record(entry); err != nil {
+					t.Fatalf("unexpected record error: %v", err)
+				}
+			}
+			// simulate a restart
+			if err := auditLog.Init(dir, tc.policy); err != nil {
+				t.Fatalf("unexpected init error: %v", err)
+			}
+			rsp, err := auditLog.List(&auditpb.List_Request{Options: tc.opts})
+			if err != nil {
+				t.Fatalf("unexpected list error: %v", err)
+			}
+			names := []string{}
+			for _, entry := range rsp.Entries {
+				names = append(names, entry.Name)
+			}
+			if fmt.Sprint(names) != fmt.Sprint(tc.expectedNames) {
+				t.Errorf("want entries %v, got %v", tc.expectedNames, names)
+			}
+			rotated, _ := filepath.Glob(filepath.Join(dir, auditLogFileName+".*"))
+			if len(rotated) != tc.expectedRotated {
+				t.Errorf("want %d rotated files, got %d", tc.expectedRotated, len(rotated))
+			}
+			if _, err := os.Stat(filepath.Join(dir, auditLogFileName)); err != nil {
+				t.Errorf("expected an active audit log: %v", err)
+			}
+		})
+	}
+}
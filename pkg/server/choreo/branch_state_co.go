@@ -35,6 +35,10 @@ func (r *CheckedOut) Activate(ctx context.Context, branchCtx *BranchCtx) error {
 	if branchCtx.APIStore != nil {
 		branchCtx.APIStore.Start(ctx)
 	}
+	// the garbage collector deletes the dependents of owners deleted in the background
+	if branchCtx.GarbageCollector != nil {
+		branchCtx.GarbageCollector.Start(ctx)
+	}
 	return nil
 }
 
@@ -43,5 +47,8 @@ func (r *CheckedOut) DeActivate(_ context.Context, branchCtx *BranchCtx) error {
 	if branchCtx.APIStore != nil {
 		branchCtx.APIStore.Stop()
 	}
+	if branchCtx.GarbageCollector != nil {
+		branchCtx.GarbageCollector.Stop()
+	}
 	return nil
 }
@@ -36,11 +36,18 @@ func (r *NotCheckedOut) String() string { return "NotCheckedOut" }
 func (r *NotCheckedOut) Activate(ctx context.Context, branchCtx *BranchCtx) error {
 	// this starts the watchermanager goroutine for the watch to work
 	branchCtx.APIStore.Start(ctx)
+	// the runner of the branch can delete owners as well
+	if branchCtx.GarbageCollector != nil {
+		branchCtx.GarbageCollector.Start(ctx)
+	}
 	return nil
 }
 
 func (r *NotCheckedOut) DeActivate(_ context.Context, branchCtx *BranchCtx) error {
 	// this stops the watchermanager goroutine
 	branchCtx.APIStore.Stop()
+	if branchCtx.GarbageCollector != nil {
+		branchCtx.GarbageCollector.Stop()
+	}
 	return nil
 }
@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
 	"github.com/henderiw/store/memory"
@@ -29,6 +31,7 @@ import (
 	"github.com/kform-dev/choreo/pkg/proto/branchpb"
 	"github.com/kform-dev/choreo/pkg/repository"
 	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/garbagecollector"
 )
 
 func NewBranchStore(choreo *choreo) *BranchStore {
@@ -46,9 +49,22 @@ type BranchStore struct {
 }
 
 type BranchCtx struct {
-	State    State
-	Branch   string
-	APIStore *api.APIStore
+	State            State
+	Branch           string
+	APIStore         *api.APIStore
+	GarbageCollector garbagecollector.GarbageCollector
+	// Runner runs the reconcilers of the branch, every branch has its own runner
+	Runner Runner
+}
+
+// GetCommit returns the commit the resources of the branch are read from; nil when the resources
+// are read from the storage, which is the case for the checked out branch and for branches that
+// are loaded by their runner
+func (r *BranchCtx) GetCommit(repo repository.Repository) (*object.Commit, error) {
+	if r.State.String() == "CheckedOut" || (r.Runner != nil && r.Runner.IsBranchLoaded()) {
+		return nil, nil
+	}
+	return repo.GetBranchCommit(r.Branch)
 }
 
 func (r *BranchStore) Update(ctx context.Context, branches []*branchpb.BranchObject) error {
@@ -81,6 +97,8 @@ func (r *BranchStore) update(ctx context.Context, branch string, newState State)
 			return nil
 		}
 		oldState = branchCtx.State
+		// the runner of the old state is replaced, since the branch is loaded differently
+		branchCtx.Runner.Close()
 	}
 
 	// import the internal apis for storage purpose
@@ -88,9 +106,15 @@ func (r *BranchStore) update(ctx context.Context, branch string, newState State)
 	apiStore.Import(r.choreo.status.Get().RootChoreoInstance.GetInternalAPIStore())
 
 	newBranchCtx := &BranchCtx{
-		State:    newState,
-		Branch:   branch,
-		APIStore: apiStore,
+		State:            newState,
+		Branch:           branch,
+		APIStore:         apiStore,
+		GarbageCollector: garbagecollector.New(apiStore),
+		Runner:           NewRunner(r.choreo),
+	}
+	if newState.String() == "CheckedOut" {
+		// the internal apis are shared by the branches, their mutations are recorded for the checked out branch
+		setAuditors(newBranchCtx, r.choreo.AuditLog(), nil)
 	}
 	if err := r.store.Apply(key, newBranchCtx); err != nil {
 		return err
@@ -115,6 +139,7 @@ func (r *BranchStore) Delete(ctx context.Context, branchSet repository.BranchSet
 	r.store.List(func(k store.Key, branchCtx *BranchCtx) {
 		branch := k.Name
 		if _, exists := branchSet.Get(branch); !exists {
+			branchCtx.Runner.Close()
 			if err := r.handleTransition(ctx, branchCtx, branchCtx.State, nil); err != nil {
 				errm = errors.Join(errm, err)
 				return
@@ -147,6 +172,25 @@ func (r *BranchStore) handleTransition(ctx context.Context, branchCtx *BranchCtx
 	return nil
 }
 
+// Close stops the runners of all branches
+func (r *BranchStore) Close() {
+	r.store.List(func(k store.Key, branchCtx *BranchCtx) {
+		branchCtx.Runner.Close()
+	})
+}
+
+// List returns the branch contexts sorted by branch
+func (r *BranchStore) List() []*BranchCtx {
+	bctxs := []*BranchCtx{}
+	r.store.List(func(k store.Key, branchCtx *BranchCtx) {
+		bctxs = append(bctxs, branchCtx)
+	})
+	sort.Slice(bctxs, func(i, j int) bool {
+		return bctxs[i].Branch < bctxs[j].Branch
+	})
+	return bctxs
+}
+
 func (r *BranchStore) GetStore() store.Storer[*BranchCtx] {
 	return r.store
 }
@@ -46,8 +46,8 @@ type Choreo interface {
 	Start(ctx context.Context)
 	GetRootChoreoInstance() instance.ChoreoInstance
 	GetBranchStore() *BranchStore
-	Runner() Runner
 	SnapshotManager() *SnapshotManager
+	AuditLog() *AuditLog
 	// updates resource (yaml) in the input directory
 	Store(obj runtime.Unstructured) error
 	// remove resource (yaml) from the input directory
@@ -66,16 +66,16 @@ func New(cfg *genericclioptions.ChoreoConfig) Choreo {
 	}
 	r.status.Set(Initializing())
 	r.branchStore = NewBranchStore(r)
-	r.runner = NewRunner(r)
 	r.snapshotMgr = NewSnapshotManager()
+	r.auditLog = NewAuditLog()
 	return r
 }
 
 type choreo struct {
 	status      *Status
 	branchStore *BranchStore
-	runner      Runner
 	snapshotMgr *SnapshotManager
+	auditLog    *AuditLog
 	cfg         *genericclioptions.ChoreoConfig
 
 	client resourceclient.Client
@@ -105,6 +105,8 @@ func (r *choreo) Apply(ctx context.Context, req *choreopb.Apply_Request) (*chore
 			return &choreopb.Apply_Response{}, status.Errorf(codes.InvalidArgument, "err: %s", err.Error())
 		}
 		r.status.Set(Success(rootChoreoInstance, req.ChoreoContext))
+		r.initSnapshots(ctx, rootChoreoInstance)
+		r.initAuditLog(ctx, rootChoreoInstance)
 		return &choreopb.Apply_Response{}, nil
 
 	} else {
@@ -113,8 +115,8 @@ func (r *choreo) Apply(ctx context.Context, req *choreopb.Apply_Request) (*chore
 			return &choreopb.Apply_Response{}, nil
 		}
 
-		// stop the runner if it was active - safe call
-		r.Runner().Stop()
+		// stop the runners of the branches if they were active - safe call
+		r.branchStore.Close()
 		// ...
 		rootChoreoInstance := r.status.Get().RootChoreoInstance
 		if rootChoreoInstance != nil {
@@ -162,10 +164,36 @@ func (r *choreo) Apply(ctx context.Context, req *choreopb.Apply_Request) (*chore
 		}
 		r.branchStore = NewBranchStore(r)
 		r.status.Set(Success(rootChoreoInstance, req.ChoreoContext))
+		r.initSnapshots(ctx, rootChoreoInstance)
+		r.initAuditLog(ctx, rootChoreoInstance)
 		return &choreopb.Apply_Response{}, nil
 	}
 }
 
+// initSnapshots restores the snapshots persisted in the temp dir of the root choreo instance
+func (r *choreo) initSnapshots(ctx context.Context, rootChoreoInstance instance.ChoreoInstance) {
+	log := log.FromContext(ctx)
+	if err := r.snapshotMgr.Init(
+		filepath.Join(rootChoreoInstance.GetTempPath(), "snapshots"),
+		NewSnapshotRetentionPolicy(r.cfg.ServerFlags),
+	); err != nil {
+		// snapshots that cannot be restored should not block the server
+		log.Error("snapshot init failed", "err", err)
+	}
+}
+
+// initAuditLog opens the audit log in the temp dir of the root choreo instance
+func (r *choreo) initAuditLog(ctx context.Context, rootChoreoInstance instance.ChoreoInstance) {
+	log := log.FromContext(ctx)
+	if err := r.auditLog.Init(
+		filepath.Join(rootChoreoInstance.GetTempPath(), "audit"),
+		NewAuditRetentionPolicy(r.cfg.ServerFlags),
+	); err != nil {
+		// an audit log that cannot be opened should not block the server
+		log.Error("audit log init failed", "err", err)
+	}
+}
+
 func (r *choreo) GetBranchStore() *BranchStore {
 	return r.branchStore
 }
@@ -174,14 +202,14 @@ func (r *choreo) GetRootChoreoInstance() instance.ChoreoInstance {
 	return r.status.Get().RootChoreoInstance
 }
 
-func (r *choreo) Runner() Runner {
-	return r.runner
-}
-
 func (r *choreo) SnapshotManager() *SnapshotManager {
 	return r.snapshotMgr
 }
 
+func (r *choreo) AuditLog() *AuditLog {
+	return r.auditLog
+}
+
 func (r *choreo) Start(ctx context.Context) {
 	log := log.FromContext(ctx)
 	var err error
@@ -236,7 +264,7 @@ func (r *choreo) updateBranches(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		r.Runner().Start(ctx, bctx)
+		bctx.Runner.Start(ctx, bctx)
 	}
 	return nil
 }
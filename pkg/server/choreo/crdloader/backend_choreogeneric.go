@@ -29,6 +29,7 @@ import (
 	genericbe "github.com/kuidio/kuid/pkg/backend/generic"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func NewChoreoGenericBackendstorage(
@@ -111,7 +112,7 @@ func (r *kuidgenericbe) applyEntry(ctx context.Context, obj backend.EntryObject)
 	newu.SetAPIVersion(obj.GetChoreoAPIVersion())
 	if _, err := r.entryStorage.Apply(ctx, newu, &rest.ApplyOptions{
 		FieldManager: "backend",
-		DryRun:       []string{"recursion"},
+		Recursion:    true,
 	}); err != nil {
 		log.Error("cannot apply entry", "error", err)
 		return err
@@ -121,8 +122,8 @@ func (r *kuidgenericbe) applyEntry(ctx context.Context, obj backend.EntryObject)
 
 func (r *kuidgenericbe) DeleteEntry(ctx context.Context, obj backend.EntryObject) error {
 	log := log.FromContext(ctx)
-	if _, err := r.entryStorage.Delete(ctx, obj.GetName(), &rest.DeleteOptions{
-		DryRun: []string{"recursion"},
+	if _, err := r.entryStorage.Delete(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, &rest.DeleteOptions{
+		Recursion: true,
 	}); err != nil {
 		log.Error("cannot delete entry", "error", err)
 		return err
@@ -200,7 +201,7 @@ func (r *kuidgenericbe) applyClaim(ctx context.Context, obj backend.ClaimObject)
 	log.Debug("choreo apply claim", "obj", newu.Object)
 	if _, err := r.claimStorage.Apply(ctx, newu, &rest.ApplyOptions{
 		FieldManager: "backend",
-		DryRun:       []string{"recursion"},
+		Recursion:    true,
 	}); err != nil {
 		log.Error("choreo apply claim", "error", err)
 		return err
@@ -210,8 +211,8 @@ func (r *kuidgenericbe) applyClaim(ctx context.Context, obj backend.ClaimObject)
 
 func (r *kuidgenericbe) DeleteClaim(ctx context.Context, obj backend.ClaimObject) error {
 	log := log.FromContext(ctx)
-	if _, err := r.claimStorage.Delete(ctx, obj.GetName(), &rest.DeleteOptions{
-		DryRun: []string{"recursion"},
+	if _, err := r.claimStorage.Delete(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, &rest.DeleteOptions{
+		Recursion: true,
 	}); err != nil {
 		log.Error("cannot delete entry", "error", err)
 		return err
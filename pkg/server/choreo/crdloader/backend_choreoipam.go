@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func NewChoreoIPAMBackendstorage(
@@ -110,7 +111,7 @@ func (r *kuidbe) applyEntry(ctx context.Context, obj *ipam.IPEntry) error {
 	newu.SetAPIVersion(schema.GroupVersion{Group: ipam.GroupName, Version: "ipam"}.String())
 	if _, err := r.entryStorage.Apply(ctx, newu, &rest.ApplyOptions{
 		FieldManager: "backend",
-		DryRun:       []string{"recursion"},
+		Recursion:    true,
 	}); err != nil {
 		return err
 	}
@@ -119,8 +120,8 @@ func (r *kuidbe) applyEntry(ctx context.Context, obj *ipam.IPEntry) error {
 
 func (r *kuidbe) DeleteEntry(ctx context.Context, obj *ipam.IPEntry) error {
 	log := log.FromContext(ctx)
-	if _, err := r.entryStorage.Delete(ctx, obj.GetName(), &rest.DeleteOptions{
-		DryRun: []string{"recursion"},
+	if _, err := r.entryStorage.Delete(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, &rest.DeleteOptions{
+		Recursion: true,
 	}); err != nil {
 		log.Error("cannot delete entry", "error", err)
 		return err
@@ -193,7 +194,7 @@ func (r *kuidbe) applyClaim(ctx context.Context, obj *ipam.IPClaim) error {
 	log.Debug("choreo apply claim", "obj", newu.Object)
 	if _, err := r.claimStorage.Apply(ctx, newu, &rest.ApplyOptions{
 		FieldManager: "backend",
-		DryRun:       []string{"recursion"},
+		Recursion:    true,
 	}); err != nil {
 		log.Error("choreo apply claim", "error", err)
 		return err
@@ -203,8 +204,8 @@ func (r *kuidbe) applyClaim(ctx context.Context, obj *ipam.IPClaim) error {
 
 func (r *kuidbe) DeleteClaim(ctx context.Context, obj *ipam.IPClaim) error {
 	log := log.FromContext(ctx)
-	if _, err := r.claimStorage.Delete(ctx, obj.GetName(), &rest.DeleteOptions{
-		DryRun: []string{"recursion"},
+	if _, err := r.claimStorage.Delete(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, &rest.DeleteOptions{
+		Recursion: true,
 	}); err != nil {
 		log.Error("cannot delete entry", "error", err)
 		return err
@@ -20,6 +20,7 @@ import (
 	"errors"
 
 	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
 	"github.com/kuidio/kuid/apis/backend"
 	"github.com/kuidio/kuid/apis/backend/as"
 	asregister "github.com/kuidio/kuid/apis/backend/as/register"
@@ -119,6 +120,15 @@ func AddStorage(backends map[string]*BackendConfig, apiStore *api.APIStore) erro
 			errm = errors.Join(errm, err)
 		}
 
+		// the entries and claims are listed by their index
+		for _, storage := range []rest.Storage{entryStorage, claimStorage} {
+			if indexer, ok := storage.(rest.Indexer); ok {
+				if err := indexer.SetIndexes("backend", "spec.index"); err != nil {
+					errm = errors.Join(errm, err)
+				}
+			}
+		}
+
 		if group == ipam.GroupName {
 			backendConfig.Backend.AddStorageInterfaces(NewChoreoIPAMBackendstorage(backendConfig.IndexKind, entryStorage, claimStorage))
 		} else {
@@ -40,15 +40,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/managedfields"
 	"k8s.io/kube-openapi/pkg/spec3"
 	"k8s.io/kube-openapi/pkg/validation/spec"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
 )
 
 // loadCRD loads the storage version of the CRD. if no storage version is supplied this call will fail
-func LoadCRD(ctx context.Context, pathInRepo, dbpath string, crd *apiextensionsv1.CustomResourceDefinition, internalAPIs map[string]*BackendConfig, choreoAPI bool) (*api.ResourceContext, error) {
+func LoadCRD(ctx context.Context, storageConfig *registry.StorageConfig, crd *apiextensionsv1.CustomResourceDefinition, internalAPIs map[string]*BackendConfig, choreoAPI bool) (*api.ResourceContext, error) {
 	if internalAPIs == nil {
 		internalAPIs = map[string]*BackendConfig{}
 	}
 
-	rctx := &api.ResourceContext{}
+	rctx := &api.ResourceContext{CRD: crd}
 	var errm error
 	for _, v := range crd.Spec.Versions {
 		internal := true
@@ -141,6 +142,21 @@ func LoadCRD(ctx context.Context, pathInRepo, dbpath string, crd *apiextensionsv
 			defaulter := unstructuredDefaulter{structuralSchema: structuralSchema}
 			creator := unstructuredCreator{}
 
+			// with the status subresource the status is reset on the main resource and
+			// everything but the status is reset on the status subresource
+			var resetFields, statusResetFields map[fieldpath.APIVersion]*fieldpath.Set
+			if statusSpec != nil {
+				resetFields = map[fieldpath.APIVersion]*fieldpath.Set{
+					fieldpath.APIVersion(gv.String()): fieldpath.NewSet(fieldpath.MakePathOrDie("status")),
+				}
+				statusResetFields = map[fieldpath.APIVersion]*fieldpath.Set{
+					fieldpath.APIVersion(gv.String()): fieldpath.NewSet(
+						fieldpath.MakePathOrDie("metadata"),
+						fieldpath.MakePathOrDie("spec"),
+					),
+				}
+			}
+
 			fieldManager, err := managedfields.NewDefaultCRDFieldManager(
 				typeConverter,
 				&safeConverterWrapper{
@@ -153,13 +169,35 @@ func LoadCRD(ctx context.Context, pathInRepo, dbpath string, crd *apiextensionsv
 				creator,
 				gvk,
 				gv,
-				"",  //subresource
-				nil, // resetfields
+				"", //subresource
+				resetFields,
 			)
 			if err != nil {
 				return nil, err
 			}
 
+			var statusFieldManager *managedfields.FieldManager
+			if statusSpec != nil {
+				statusFieldManager, err = managedfields.NewDefaultCRDFieldManager(
+					typeConverter,
+					&safeConverterWrapper{
+						unsafe: &crConverter{
+							converter:     &nopConverter{},
+							clusterScoped: false,
+						},
+					},
+					defaulter,
+					creator,
+					gvk,
+					gv,
+					rest.SubresourceStatus,
+					statusResetFields,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			var strategy rest.Strategy
 			var invoker options.BackendInvoker
 			if backendConfig, exists := internalAPIs[crd.Spec.Group]; exists {
@@ -179,6 +217,7 @@ func LoadCRD(ctx context.Context, pathInRepo, dbpath string, crd *apiextensionsv
 					structuralSchema,
 					defaulter,
 					invoker, // this is to handle the internal api resources using synchronous calls
+					statusSpec != nil,
 				)
 			} else {
 				strategy = registry.NewStrategy(
@@ -189,14 +228,14 @@ func LoadCRD(ctx context.Context, pathInRepo, dbpath string, crd *apiextensionsv
 					structuralSchema,
 					defaulter,
 					nil,
+					statusSpec != nil,
 				)
 			}
 
 			//printStructuralSchema(structuralSchema)
-			storage := registry.NewStorage(
+			storage, err := registry.NewStorage(
 				ctx,
-				pathInRepo,
-				dbpath,
+				storageConfig,
 				schema.GroupResource{Group: crd.Spec.Group, Resource: crd.Spec.Names.Plural},
 				func() runtime.Unstructured {
 					// set the expected group/version/kind in the new object as a signal to the versioning decoder
@@ -212,7 +251,11 @@ func LoadCRD(ctx context.Context, pathInRepo, dbpath string, crd *apiextensionsv
 				},
 				strategy,
 				fieldManager,
+				statusFieldManager,
 			)
+			if err != nil {
+				return nil, fmt.Errorf("cannot create storage for %s, err: %v", crd.Name, err)
+			}
 
 			rctx.Storage = storage
 			apiresource := &discoverypb.APIResource{
@@ -257,6 +300,8 @@ func LoadCRD(ctx context.Context, pathInRepo, dbpath string, crd *apiextensionsv
 	}
 	if rctx.External == nil {
 		errm = errors.Join(errm, fmt.Errorf("invalid crd %s, no external version", crd.Name))
+	} else {
+		rctx.External.StatusEnabled = rctx.StatusEnabled()
 	}
 	return rctx, errm
 
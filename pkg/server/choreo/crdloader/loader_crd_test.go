@@ -0,0 +1,194 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdloader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func testCRD(statusSubresource bool) *apiextensionsv1.CustomResourceDefinition {
+	version := apiextensionsv1.CustomResourceDefinitionVersion{
+		Name:    "v1alpha1",
+		Served:  true,
+		Storage: true,
+		Schema: &apiextensionsv1.CustomResourceValidation{
+			OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+				Type: "object",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"apiVersion": {Type: "string"},
+					"kind":       {Type: "string"},
+					"metadata":   {Type: "object"},
+					"spec": {Type: "object", Properties: map[string]apiextensionsv1.JSONSchemaProps{
+						"value": {Type: "string"},
+					}},
+					"status": {Type: "object", Properties: map[string]apiextensionsv1.JSONSchemaProps{
+						"observedGeneration": {Type: "integer"},
+						"ready":              {Type: "boolean"},
+					}},
+				},
+			},
+		},
+	}
+	if statusSubresource {
+		version.Subresources = &apiextensionsv1.CustomResourceSubresources{
+			Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+		}
+	}
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "dummies.example.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   "dummies",
+				Singular: "dummy",
+				Kind:     "Dummy",
+				ListKind: "DummyList",
+			},
+			Scope:    apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{version},
+		},
+	}
+}
+
+func testObject(spec, status map[string]any) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1alpha1",
+		"kind":       "Dummy",
+		"metadata": map[string]any{
+			"name":      "a",
+			"namespace": "default",
+		},
+	}}
+	if spec != nil {
+		u.Object["spec"] = spec
+	}
+	if status != nil {
+		u.Object["status"] = status
+	}
+	return u
+}
+
+func TestStatusSubresource(t *testing.T) {
+	ctx := context.Background()
+	rctx, err := LoadCRD(ctx, nil, testCRD(true), nil, false)
+	if err != nil {
+		t.Fatalf("unexpected load error: %v", err)
+	}
+	if !rctx.External.StatusEnabled {
+		t.Errorf("expected the status subresource to be discoverable")
+	}
+	storage := rctx.Storage
+	key := types.NamespacedName{Namespace: "default", Name: "a"}
+
+	get := func() *unstructured.Unstructured {
+		obj, err := storage.Get(ctx, key, &rest.GetOptions{ShowManagedFields: true})
+		if err != nil {
+			t.Fatalf("unexpected get error: %v", err)
+		}
+		return &unstructured.Unstructured{Object: runtime.DeepCopyJSON(obj.UnstructuredContent())}
+	}
+	apply := func(u *unstructured.Unstructured, fieldManager, subresource string) {
+		if _, err := storage.Apply(ctx, u, &rest.ApplyOptions{FieldManager: fieldManager, Subresource: subresource}); err != nil {
+			t.Fatalf("unexpected apply error: %v", err)
+		}
+	}
+
+	// the status is ignored on create
+	apply(testObject(map[string]any{"value": "a"}, map[string]any{"ready": true}), "input", "")
+	u := get()
+	if _, ok := u.Object["status"]; ok {
+		t.Errorf("expected no status after create, got %v", u.Object["status"])
+	}
+	generation := u.GetGeneration()
+
+	// the status subresource only updates the status
+	apply(testObject(map[string]any{"value": "b"}, map[string]any{"ready": true, "observedGeneration": int64(1)}), "reconciler", rest.SubresourceStatus)
+	u = get()
+	if ready, _, _ := unstructured.NestedBool(u.Object, "status", "ready"); !ready {
+		t.Errorf("expected status ready after status apply, got %v", u.Object["status"])
+	}
+	if value, _, _ := unstructured.NestedString(u.Object, "spec", "value"); value != "a" {
+		t.Errorf("expected spec to be unchanged by status apply, got %s", value)
+	}
+	if u.GetGeneration() != generation {
+		t.Errorf("expected generation %d after status apply, got %d", generation, u.GetGeneration())
+	}
+
+	// the main resource does not clobber the status and bumps the generation on a spec change
+	apply(testObject(map[string]any{"value": "c"}, map[string]any{"ready": false}), "input", "")
+	u = get()
+	if ready, _, _ := unstructured.NestedBool(u.Object, "status", "ready"); !ready {
+		t.Errorf("expected status to be retained after apply, got %v", u.Object["status"])
+	}
+	if u.GetGeneration() != generation+1 {
+		t.Errorf("expected generation %d after spec change, got %d", generation+1, u.GetGeneration())
+	}
+
+	// an apply of the main resource by the status owner does not remove its status
+	apply(testObject(nil, nil), "reconciler", "")
+	u = get()
+	if ready, _, _ := unstructured.NestedBool(u.Object, "status", "ready"); !ready {
+		t.Errorf("expected status to be retained after apply by the status owner, got %v", u.Object["status"])
+	}
+
+	// the status is updated with the resourceVersion of the object
+	u.Object["status"] = map[string]any{"ready": false}
+	u.Object["spec"] = map[string]any{"value": "d"}
+	if _, err := storage.Update(ctx, u, &rest.UpdateOptions{Subresource: rest.SubresourceStatus}); err != nil {
+		t.Fatalf("unexpected status update error: %v", err)
+	}
+	u = get()
+	if ready, _, _ := unstructured.NestedBool(u.Object, "status", "ready"); ready {
+		t.Errorf("expected status not ready after status update, got %v", u.Object["status"])
+	}
+	if value, _, _ := unstructured.NestedString(u.Object, "spec", "value"); value != "c" {
+		t.Errorf("expected spec to be unchanged by status update, got %s", value)
+	}
+}
+
+func TestStatusSubresourceDisabled(t *testing.T) {
+	ctx := context.Background()
+	rctx, err := LoadCRD(ctx, nil, testCRD(false), nil, false)
+	if err != nil {
+		t.Fatalf("unexpected load error: %v", err)
+	}
+	if rctx.External.StatusEnabled {
+		t.Errorf("expected no discoverable status subresource")
+	}
+	storage := rctx.Storage
+
+	obj, err := storage.Apply(ctx, testObject(map[string]any{"value": "a"}, map[string]any{"ready": true}), &rest.ApplyOptions{FieldManager: "input"})
+	if err != nil {
+		t.Fatalf("unexpected apply error: %v", err)
+	}
+	if ready, _, _ := unstructured.NestedBool(obj.UnstructuredContent(), "status", "ready"); !ready {
+		t.Errorf("expected the status to be applied with the resource")
+	}
+	_, err = storage.Apply(ctx, testObject(nil, map[string]any{"ready": false}), &rest.ApplyOptions{FieldManager: "reconciler", Subresource: rest.SubresourceStatus})
+	if !grpcerrors.IsUnimplemented(err) {
+		t.Errorf("expected unimplemented error, got %v", err)
+	}
+}
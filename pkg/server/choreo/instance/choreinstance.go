@@ -40,6 +40,7 @@ type ChoreoInstance interface {
 	GetTempPath() string
 	GetPathInRepo() string
 	GetDBPath() string
+	GetDBBackend() string
 	GetConfig() *genericclioptions.ChoreoConfig
 	GetInternalAPIStore() *api.APIStore // provides the internal apistore, only relevant for rootInstance
 	GetCommit() *object.Commit
@@ -0,0 +1,119 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
+)
+
+const branchCommitFile = ".commit"
+
+// NewBranchChoreoInstance returns a root choreo instance for a branch that is not checked out.
+// The files of the branch commit are exported to the temp dir of the parent, such that the
+// branch is loaded and stored independently of the checked out branch.
+func NewBranchChoreoInstance(ctx context.Context, parent ChoreoInstance, branch string, commit *object.Commit) (ChoreoInstance, error) {
+	// the branch name is escaped, such that every branch gets its own directory
+	r := &RootChoreoInstance{
+		cfg:          parent.GetConfig(),
+		repo:         parent.GetRepo(),
+		repoPath:     filepath.Join(parent.GetTempPath(), "branches", url.PathEscape(branch)),
+		pathInRepo:   parent.GetPathInRepo(),
+		commit:       commit,
+		branch:       branch,
+		children:     []ChoreoInstance{},
+		schemastore:  parent.SchemaStore(),
+		schemaloader: parent.SchemaLoader(),
+	}
+	if err := exportCommit(r.repoPath, r.pathInRepo, r.GetDBPath(), commit); err != nil {
+		return r, err
+	}
+	r.tempPath = filepath.Join(r.GetPath(), ".choreo")
+	if err := EnsureDir(r.tempPath); err != nil {
+		return r, err
+	}
+
+	r.apiStoreInternal = api.NewAPIStore()
+	if err := r.LoadInternalAPIs(); err != nil {
+		return r, err
+	}
+	r.apiclient = resourceclient.NewAPIStorageClient(r.apiStoreInternal)
+	return r, nil
+}
+
+// exportCommit writes the files of the commit in pathInRepo to path; the export is
+// kept when it is from the same commit, such that the stored resources are retained
+func exportCommit(path, pathInRepo, dbPath string, commit *object.Commit) error {
+	commitFile := filepath.Join(path, branchCommitFile)
+	if b, err := os.ReadFile(commitFile); err == nil && string(b) == commit.Hash.String() {
+		return nil
+	}
+	// the database of the previous export might still be open
+	if err := boltstore.Close(dbPath); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+	if err := EnsureDir(path); err != nil {
+		return err
+	}
+	prefix := ""
+	if pathInRepo != "." && pathInRepo != "" {
+		prefix = strings.TrimSuffix(pathInRepo, "/") + "/"
+	}
+	files, err := commit.Files()
+	if err != nil {
+		return err
+	}
+	if err := files.ForEach(func(f *object.File) error {
+		if !strings.HasPrefix(f.Name, prefix) {
+			return nil
+		}
+		return exportFile(filepath.Join(path, f.Name), f)
+	}); err != nil {
+		return err
+	}
+	return os.WriteFile(commitFile, []byte(commit.Hash.String()), 0644)
+}
+
+func exportFile(path string, f *object.File) error {
+	if err := EnsureDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+	reader, err := f.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(file, reader)
+	return err
+}
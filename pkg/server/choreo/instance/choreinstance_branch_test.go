@@ -0,0 +1,203 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
+	"github.com/kform-dev/choreo/pkg/util/testhelper"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// testCommit commits the files to the repo and returns the commit
+func testCommit(t *testing.T, repo *git.Repository, files map[string]string) *object.Commit {
+	t.Helper()
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("worktree failed: %v", err)
+	}
+	for name, data := range files {
+		path := filepath.Join(wt.Filesystem.Root(), name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("mkdir failed: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+		if _, err := wt.Add(name); err != nil {
+			t.Fatalf("add failed: %v", err)
+		}
+	}
+	hash, err := wt.Commit("test", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		t.Fatalf("commit object failed: %v", err)
+	}
+	return commit
+}
+
+func TestExportCommit(t *testing.T) {
+	repo, err := git.PlainInit(t.TempDir(), false)
+	if err != nil {
+		t.Fatalf("init failed: %v", err)
+	}
+	commit1 := testCommit(t, repo, map[string]string{
+		"project/in/a.yaml":   "a",
+		"other/b.yaml":        "b",
+		"project/reconc.star": "r",
+	})
+	commit2 := testCommit(t, repo, map[string]string{
+		"project/in/a.yaml": "a2",
+	})
+
+	cases := map[string]struct {
+		commits  []*object.Commit
+		expected map[string]string // file -> data, empty data means the file does not exist
+	}{
+		"Export": {
+			commits: []*object.Commit{commit1},
+			expected: map[string]string{
+				"project/in/a.yaml":   "a",
+				"project/reconc.star": "r",
+				"other/b.yaml":        "",
+			},
+		},
+		"SameCommit": {
+			// the stored resources of the first export are retained
+			commits: []*object.Commit{commit1, commit1},
+			expected: map[string]string{
+				"project/in/a.yaml":   "a",
+				"project/stored.yaml": "stored",
+				"project/reconc.star": "r",
+			},
+		},
+		"OtherCommit": {
+			commits: []*object.Commit{commit1, commit2},
+			expected: map[string]string{
+				"project/in/a.yaml":   "a2",
+				"project/stored.yaml": "",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "branches", "dev")
+			for i, commit := range tc.commits {
+				if err := exportCommit(path, "project", filepath.Join(path, "project", "db"), commit); err != nil {
+					t.Fatalf("export failed: %v", err)
+				}
+				if i == 0 {
+					// a resource stored by the runner of the branch
+					if err := os.WriteFile(filepath.Join(path, "project", "stored.yaml"), []byte("stored"), 0644); err != nil {
+						t.Fatalf("write failed: %v", err)
+					}
+				}
+			}
+			for file, data := range tc.expected {
+				b, err := os.ReadFile(filepath.Join(path, file))
+				if data == "" {
+					if !os.IsNotExist(err) {
+						t.Errorf("want %s not exported, got err %v", file, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("read %s failed: %v", file, err)
+					continue
+				}
+				if string(b) != data {
+					t.Errorf("want %s data %q, got %q", file, data, string(b))
+				}
+			}
+		})
+	}
+}
+
+func TestBranchDestroy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "branches", "dev")
+	if err := EnsureDir(path); err != nil {
+		t.Fatalf("ensure dir failed: %v", err)
+	}
+	r := &RootChoreoInstance{
+		cfg:        genericclioptions.NewChoreoConfig(),
+		repoPath:   path,
+		pathInRepo: ".",
+		branch:     "dev",
+	}
+	if err := r.Destroy(); err != nil {
+		t.Fatalf("destroy failed: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("want the export of the branch removed, got err %v", err)
+	}
+}
+
+func TestExportCommitClosesDB(t *testing.T) {
+	repo, err := git.PlainInit(t.TempDir(), false)
+	if err != nil {
+		t.Fatalf("init failed: %v", err)
+	}
+	commit1 := testCommit(t, repo, map[string]string{"project/in/a.yaml": "a"})
+	commit2 := testCommit(t, repo, map[string]string{"project/in/a.yaml": "a2"})
+
+	path := filepath.Join(t.TempDir(), "branches", "dev")
+	dbPath := filepath.Join(path, "project", "db")
+	t.Cleanup(func() { _ = boltstore.Close(dbPath) })
+	newStore := func() store.UnstructuredStore {
+		s, err := boltstore.NewStore(&boltstore.Config{
+			GroupResource: schema.GroupResource{Group: "example.com", Resource: "dummies"},
+			RootPath:      dbPath,
+			NewFunc:       func() runtime.Unstructured { return &unstructured.Unstructured{} },
+		})
+		if err != nil {
+			t.Fatalf("cannot create bolt store: %v", err)
+		}
+		return s
+	}
+
+	if err := exportCommit(path, "project", dbPath, commit1); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	k := store.KeyFromNSN(types.NamespacedName{Namespace: "a", Name: "site"})
+	if err := newStore().Create(k, testhelper.NewObject("a", "site")); err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+	if err := exportCommit(path, "project", dbPath, commit2); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	// the database of the new export does not have the resources of the old export
+	if n := newStore().Len(); n != 0 {
+		t.Errorf("want an empty database after a new export, got %d objects", n)
+	}
+}
@@ -126,6 +126,10 @@ func (r *ChildChoreoInstance) GetDBPath() string {
 	return filepath.Join(r.repo.GetPath(), r.pathInRepo, *r.cfg.ServerFlags.DBPath)
 }
 
+func (r *ChildChoreoInstance) GetDBBackend() string {
+	return *r.cfg.ServerFlags.DBBackend
+}
+
 func (r *ChildChoreoInstance) GetConfig() *genericclioptions.ChoreoConfig {
 	return r.cfg
 }
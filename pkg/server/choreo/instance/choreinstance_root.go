@@ -19,6 +19,7 @@ package instance
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/kform-dev/choreo/pkg/repository/git"
 	"github.com/kform-dev/choreo/pkg/repository/repogit"
 	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/boltstore"
 	"github.com/kform-dev/choreo/pkg/server/choreo/apiloader"
 	"github.com/sdcio/config-diff/schemaloader"
 	schemaconfig "github.com/sdcio/schema-server/pkg/config"
@@ -65,6 +67,7 @@ func NewRootChoreoInstance(ctx context.Context, config *Config) (ChoreoInstance,
 		}
 	}
 	r.repo = config.Repo
+	r.repoPath = config.Repo.GetPath()
 	r.pathInRepo = config.PathInRepo
 	r.commit = config.Commit
 
@@ -127,8 +130,10 @@ func getRepoFromPath(ctx context.Context, path string) (repository.Repository, s
 type RootChoreoInstance struct {
 	cfg        *genericclioptions.ChoreoConfig
 	repo       repository.Repository
+	repoPath   string // the path the files are read from, for a branch instance this is the export of the branch commit
 	pathInRepo string
 	commit     *object.Commit
+	branch     string // the branch of a branch instance, empty for the instance of the checked out branch
 	tempPath   string
 	apiclient  resourceclient.Client // apiclient is the client which allows to get access to the local db -> used for commit based api loading
 	children   []ChoreoInstance
@@ -143,6 +148,13 @@ type RootChoreoInstance struct {
 
 func (r *RootChoreoInstance) Destroy() error {
 	log := log.FromContext(context.Background())
+	if r.branch != "" {
+		// a branch instance only owns the export of the branch commit
+		if err := boltstore.Close(r.GetDBPath()); err != nil {
+			return err
+		}
+		return os.RemoveAll(r.repoPath)
+	}
 	if err := r.repo.StashBranch(DummyBranch); err != nil {
 		log.Error("stash branch failed", "err", err)
 		return err
@@ -182,6 +194,7 @@ func (r *RootChoreoInstance) LoadInternalAPIs() error {
 		APIStore:   r.apiStoreInternal,
 		Cfg:        r.cfg,
 		DBPath:     r.GetDBPath(),
+		DBBackend:  r.GetDBBackend(),
 		PathInRepo: r.GetPathInRepo(),
 	}
 	return loader.Load(context.Background())
@@ -200,11 +213,11 @@ func (r *RootChoreoInstance) GetName() string {
 }
 
 func (r *RootChoreoInstance) GetPath() string {
-	return filepath.Join(r.repo.GetPath(), r.pathInRepo)
+	return filepath.Join(r.repoPath, r.pathInRepo)
 }
 
 func (r *RootChoreoInstance) GetRepoPath() string {
-	return r.repo.GetPath()
+	return r.repoPath
 }
 
 func (r *RootChoreoInstance) GetPathInRepo() string {
@@ -216,7 +229,11 @@ func (r *RootChoreoInstance) GetTempPath() string {
 }
 
 func (r *RootChoreoInstance) GetDBPath() string {
-	return filepath.Join(r.repo.GetPath(), r.pathInRepo, *r.cfg.ServerFlags.DBPath)
+	return filepath.Join(r.repoPath, r.pathInRepo, *r.cfg.ServerFlags.DBPath)
+}
+
+func (r *RootChoreoInstance) GetDBBackend() string {
+	return *r.cfg.ServerFlags.DBBackend
 }
 
 func (r *RootChoreoInstance) GetConfig() *genericclioptions.ChoreoConfig {
@@ -227,7 +244,7 @@ func (r *RootChoreoInstance) GetInternalAPIStore() *api.APIStore {
 	return r.apiStoreInternal
 }
 
-func (r *RootChoreoInstance) GetCommit() *object.Commit { return nil }
+func (r *RootChoreoInstance) GetCommit() *object.Commit { return r.commit }
 
 func (r *RootChoreoInstance) GetAPIClient() resourceclient.Client { return r.apiclient }
 
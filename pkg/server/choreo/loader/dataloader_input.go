@@ -18,8 +18,12 @@ package loader
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"path/filepath"
+	"sort"
+	"strconv"
 
 	"github.com/henderiw/store"
 	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
@@ -38,7 +42,19 @@ import (
 )
 
 func (r *DataLoader) loadInput(ctx context.Context) error {
-	loader := &InputLoader{
+	loader := r.newInputLoader()
+	if err := loader.Load(ctx, r.getInputReader(r.RepoPth, r.PathInRepo, r.Cfg)); err != nil {
+		return err
+	}
+	if err := loader.Clean(ctx); err != nil {
+		return err
+	}
+	return nil
+
+}
+
+func (r *DataLoader) newInputLoader() *InputLoader {
+	return &InputLoader{
 		Client:   r.Client,
 		Branch:   r.Branch,
 		NewInput: sets.New[corev1.ObjectReference](),
@@ -47,14 +63,69 @@ func (r *DataLoader) loadInput(ctx context.Context) error {
 		InternalAPISet: r.InternalAPISet,
 		Annotation:     r.Annotation,
 	}
-	if err := loader.Load(ctx, r.getInputReader(r.RepoPth, r.PathInRepo, r.Cfg)); err != nil {
-		return err
+}
+
+// InputObject is an object of the input with the file it is read from
+type InputObject struct {
+	// Path is the path of the file relative to the input path
+	Path   string
+	Object *unstructured.Unstructured
+	// Hash is the hash of the yaml document of the object
+	Hash string
+}
+
+// ReadInput reads the objects of the input without loading them, sorted by path
+func (r *DataLoader) ReadInput(ctx context.Context) ([]*InputObject, error) {
+	reader := r.getInputReader(r.RepoPth, r.PathInRepo, r.Cfg)
+	if reader == nil {
+		// reader nil, mean the path does not exist, whcich is ok
+		return []*InputObject{}, nil
 	}
-	if err := loader.Clean(ctx); err != nil {
-		return err
+	datastore, err := reader.Read(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return nil
 
+	var errm error
+	objects := []*InputObject{}
+	// the index of the object in the file is the namespace of the key
+	indexes := map[*InputObject]int{}
+	datastore.List(func(k store.Key, rn *yaml.RNode) {
+		doc := rn.MustString()
+		object := map[string]any{}
+		if err := syaml.Unmarshal([]byte(doc), &object); err != nil {
+			errm = errors.Join(errm, err)
+			return
+		}
+		hash := sha256.Sum256([]byte(doc))
+		inputObject := &InputObject{
+			Path:   k.Name,
+			Object: &unstructured.Unstructured{Object: object},
+			Hash:   hex.EncodeToString(hash[:]),
+		}
+		objects = append(objects, inputObject)
+		indexes[inputObject], _ = strconv.Atoi(k.Namespace)
+	})
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].Path != objects[j].Path {
+			return objects[i].Path < objects[j].Path
+		}
+		return indexes[objects[i]] < indexes[objects[j]]
+	})
+	return objects, errm
+}
+
+// ApplyInput loads the given input objects; unlike Load it does not remove the
+// objects that are no longer part of the input
+func (r *DataLoader) ApplyInput(ctx context.Context, objects []*unstructured.Unstructured) error {
+	loader := r.newInputLoader()
+	var errm error
+	for _, obj := range objects {
+		if err := loader.apply(ctx, obj.DeepCopy()); err != nil {
+			errm = errors.Join(errm, err)
+		}
+	}
+	return errm
 }
 
 func (r *DataLoader) getInputReader(repoPath, pathInRepo string, cfg *genericclioptions.ChoreoConfig) pkgio.Reader[*yaml.RNode] {
@@ -89,31 +160,12 @@ func (r *InputLoader) Load(ctx context.Context, reader pkgio.Reader[*yaml.RNode]
 
 	var errm error
 	datastore.List(func(k store.Key, rn *yaml.RNode) {
-		a := rn.GetAnnotations()
-		if len(a) == 0 {
-			a = map[string]string{}
-		}
-		a[choreov1alpha1.ChoreoLoaderOriginKey] = r.Annotation
-		rn.SetAnnotations(a)
-
 		object := map[string]any{}
 		if err := syaml.Unmarshal([]byte(rn.MustString()), &object); err != nil {
 			errm = errors.Join(errm, err)
 			return
 		}
-		obj := &unstructured.Unstructured{Object: object}
-
-		r.NewInput.Insert(corev1.ObjectReference{
-			APIVersion: obj.GetAPIVersion(),
-			Kind:       obj.GetKind(),
-			Namespace:  obj.GetNamespace(),
-			Name:       obj.GetName(),
-		})
-		if err := r.Client.Apply(ctx, obj, &resourceclient.ApplyOptions{
-			FieldManager: ManagedFieldManagerInput,
-			Origin:       ManagedFieldManagerInput,
-			Branch:       r.Branch,
-		}); err != nil {
+		if err := r.apply(ctx, &unstructured.Unstructured{Object: object}); err != nil {
 			errm = errors.Join(errm, err)
 			return
 		}
@@ -121,6 +173,33 @@ func (r *InputLoader) Load(ctx context.Context, reader pkgio.Reader[*yaml.RNode]
 	return errm
 }
 
+func (r *InputLoader) apply(ctx context.Context, obj *unstructured.Unstructured) error {
+	setAnnotations(obj, map[string]string{
+		choreov1alpha1.ChoreoLoaderOriginKey: r.Annotation,
+	})
+
+	ref := corev1.ObjectReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+	if err := r.Client.Apply(ctx, obj, &resourceclient.ApplyOptions{
+		FieldManager: ManagedFieldManagerInput,
+		Origin:       ManagedFieldManagerInput,
+		Branch:       r.Branch,
+	}); err != nil {
+		// the object is retained in case it is stored already
+		r.NewInput.Insert(ref)
+		return err
+	}
+	// the applied object has the namespace it is stored in, namespaced objects
+	// without a namespace in the input are stored in the default namespace
+	ref.Namespace = obj.GetNamespace()
+	r.NewInput.Insert(ref)
+	return nil
+}
+
 func (r *InputLoader) Clean(ctx context.Context) error {
 	var errm error
 	for _, gvk := range r.GVKs {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/henderiw/logger/log"
+	"github.com/henderiw/store"
 	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
 	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
 	"github.com/kform-dev/choreo/pkg/controller/collector"
@@ -32,6 +33,7 @@ import (
 	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
 	"github.com/kform-dev/choreo/pkg/server/choreo/apiloader"
 	"github.com/kform-dev/choreo/pkg/server/choreo/instance"
 	"github.com/kform-dev/choreo/pkg/server/choreo/loader"
@@ -42,14 +44,23 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 )
 
 type Runner interface {
 	//AddResourceClientAndContext(ctx context.Context, client resourceclient.Client)
 	Start(ctx context.Context, bctx *BranchCtx) (*runnerpb.Start_Response, error)
 	Stop()
-	RunOnce(ctx context.Context, bctx *BranchCtx, stream runnerpb.Runner_OnceServer) error
+	RunOnce(ctx context.Context, bctx *BranchCtx, opts *runnerpb.Once_Options, stream runnerpb.Runner_OnceServer) error
 	Load(ctx context.Context, bctx *BranchCtx) error
+	Restore(ctx context.Context, bctx *BranchCtx, id string) error
+	Deps(ctx context.Context, bctx *BranchCtx) (*runnerpb.Deps_Response, error)
+	Status() RunnerStatus
+	// IsBranchLoaded returns true when the runner loaded a branch that is not checked out,
+	// the resources of the branch are stored independently of the checked out branch
+	IsBranchLoaded() bool
+	// Close stops the runner and removes the resources of the branch it loaded
+	Close()
 }
 
 func NewRunner(choreo Choreo) Runner {
@@ -76,23 +87,35 @@ type run struct {
 	//runResultCh        chan *runnerpb.Once_Response
 	//collector          collector.Collector
 	//informerfactory    informers.InformerFactory
-	oncerspChan chan *runnerpb.Once_Response
+	oncersp *onceStream
+	// input is the digest of the input that is loaded
+	input *inputDigest
+	// instance is the choreo instance of a branch that is not checked out,
+	// nil when the runner works on the checked out branch
+	instance instance.ChoreoInstance
 }
 
 func (r *run) Start(ctx context.Context, bctx *BranchCtx) (*runnerpb.Start_Response, error) {
-	if r.getStatus() == RunnerStatus_Running {
-		return &runnerpb.Start_Response{}, nil
-	}
-	if r.getStatus() == RunnerStatus_Once {
+	// we use the server context to cancel/handle the status of the server
+	// since the ctx we get is from the client
+	runctx, cancel := context.WithCancel(r.choreo.GetContext())
+	// claim the runner of the branch before loading, a stop while loading
+	// cancels the runctx
+	if current, ok := r.setStatusAndCancelIfStopped(RunnerStatus_Running, cancel); !ok {
+		cancel()
+		if current == RunnerStatus_Running {
+			return &runnerpb.Start_Response{}, nil
+		}
 		return &runnerpb.Start_Response{},
-			status.Errorf(codes.InvalidArgument, "runner is already running, status %s", r.status.String())
+			status.Errorf(codes.InvalidArgument, "runner of branch %s is already running, status %s", bctx.Branch, current.String())
 	}
 
 	if err := r.Load(ctx, bctx); err != nil {
+		r.Stop()
 		return &runnerpb.Start_Response{}, err
 	}
 
-	rootChoreoInstance := r.choreo.GetRootChoreoInstance()
+	rootChoreoInstance := r.getInstance()
 	reconcilers := []*choreov1alpha1.Reconciler{}
 	libraries := []*choreov1alpha1.Library{}
 	for _, childChoreoInstance := range rootChoreoInstance.GetChildren() {
@@ -104,11 +127,6 @@ func (r *run) Start(ctx context.Context, bctx *BranchCtx) (*runnerpb.Start_Respo
 	reconcilers = append(reconcilers, rootChoreoInstance.GetReconcilers()...)
 	libraries = append(libraries, rootChoreoInstance.GetLibraries()...)
 
-	// we use the server context to cancel/handle the status of the server
-	// since the ctx we get is from the client
-	runctx, cancel := context.WithCancel(r.choreo.GetContext())
-	r.setStatusAndCancel(RunnerStatus_Running, cancel)
-
 	go func() {
 		defer r.Stop()
 		select {
@@ -116,32 +134,52 @@ func (r *run) Start(ctx context.Context, bctx *BranchCtx) (*runnerpb.Start_Respo
 			return
 		default:
 			// use runctx since the ctx is from the cmd and it will be cancelled upon completion
-			r.runReconciler(runctx, "root", bctx, reconcilers, libraries, false) // false -> run continuously, not once
+			r.runReconciler(runctx, "root", bctx, reconcilers, libraries, false, nil) // false -> run continuously, not once
 		}
 	}()
 	return &runnerpb.Start_Response{}, nil
 }
 
 func (r *run) Stop() {
-	if r.getCancel() != nil {
-		r.cancel() // Cancel the context, which triggers stopping in the StartContinuous loop
+	if cancel := r.getCancel(); cancel != nil {
+		cancel() // Cancel the context, which triggers stopping in the StartContinuous loop
 	}
 	r.setStatusAndCancel(RunnerStatus_Stopped, nil)
 	// don't nilify the other resources, since they will be reinitialized
 }
 
-func (r *run) runOnce(ctx context.Context, stream runnerpb.Runner_OnceServer) error {
+func (r *run) Status() RunnerStatus {
+	return r.getStatus()
+}
+
+func (r *run) IsBranchLoaded() bool {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return r.instance != nil
+}
+
+func (r *run) Close() {
+	r.Stop()
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.instance != nil {
+		if err := r.instance.Destroy(); err != nil {
+			log.FromContext(context.Background()).Error("destroy branch instance failed", "err", err)
+		}
+		r.instance = nil
+	}
+	r.input = nil
+}
+
+func (r *run) runOnce(ctx context.Context, oncersp *onceStream, stream runnerpb.Runner_OnceServer) error {
 	log := log.FromContext(ctx)
 	for {
 		select {
 		case <-ctx.Done():
+			// the channel is closed by RunOnce, which owns the senders
 			log.Debug("grpc watch stopped, stopping storage watch")
-			close(r.oncerspChan)
 			return nil
-		case rsp, ok := <-r.oncerspChan:
-			if !ok {
-				log.Debug("response channel closed, stopping once runner")
-			}
+		case rsp := <-oncersp.ch:
 			if err := stream.Send(rsp); err != nil {
 				p, _ := peer.FromContext(stream.Context())
 				addr := "unknown"
@@ -154,63 +192,126 @@ func (r *run) runOnce(ctx context.Context, stream runnerpb.Runner_OnceServer) er
 	}
 }
 
-func (r *run) RunOnce(ctx context.Context, bctx *BranchCtx, stream runnerpb.Runner_OnceServer) error {
+func (r *run) RunOnce(ctx context.Context, bctx *BranchCtx, opts *runnerpb.Once_Options, stream runnerpb.Runner_OnceServer) error {
 	log := log.FromContext(ctx)
+	// claim the runner of the branch before the response stream is initialized,
+	// since the stream is used by the active run
+	if current, ok := r.setStatusAndCancelIfStopped(RunnerStatus_Once, nil); !ok {
+		return status.Errorf(codes.FailedPrecondition, "runner of branch %s is already running, status %s", bctx.Branch, current.String())
+	}
 	ctx, cancel := context.WithCancel(stream.Context())
 	defer cancel()
 
-	r.oncerspChan = make(chan *runnerpb.Once_Response)
+	oncersp := newOnceStream(ctx)
+	r.setOnceStream(oncersp)
 
-	go r.runOnce(ctx, stream)
+	// the stream is passed since a subsequent run of the branch replaces it
+	go r.runOnce(ctx, oncersp, stream)
 
-	r.once(ctx, bctx)
+	r.once(ctx, bctx, opts)
 	<-ctx.Done()
+	// senders that are still active (e.g. a late trace of the collector) return
+	// since the ctx is done, after which the channel can be closed safely
+	oncersp.close()
 	log.Debug("grpc runOnce goroutine stopped")
 	return nil
 }
 
-func (r *run) onceResponseCompleted() {
-	if r.oncerspChan == nil {
+func (r *run) setOnceStream(oncersp *onceStream) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.oncersp = oncersp
+}
+
+func (r *run) sendOnceResponse(rsp *runnerpb.Once_Response) {
+	r.m.RLock()
+	oncersp := r.oncersp
+	r.m.RUnlock()
+	if oncersp == nil {
 		return
 	}
-	r.oncerspChan <- &runnerpb.Once_Response{
-		Type: runnerpb.Once_COMPLETED,
-	}
+	oncersp.send(rsp)
+}
 
+func (r *run) onceResponseCompleted() {
+	r.sendOnceResponse(&runnerpb.Once_Response{
+		Type: runnerpb.Once_COMPLETED,
+	})
 }
 
 func (r *run) onceResponseProgressUpdate(msg string) {
-	if r.oncerspChan == nil {
-		return
-	}
-	r.oncerspChan <- &runnerpb.Once_Response{
+	r.sendOnceResponse(&runnerpb.Once_Response{
 		Type: runnerpb.Once_PROGRESS_UPDATE,
 		Data: &runnerpb.Once_Response_ProgressUpdate{
 			ProgressUpdate: &runnerpb.Once_ProgressUpdate{Message: msg},
 		},
-	}
+	})
 }
 
 func (r *run) onceResponseError(msg string) {
-	if r.oncerspChan == nil {
-		return
-	}
-	r.oncerspChan <- &runnerpb.Once_Response{
+	r.sendOnceResponse(&runnerpb.Once_Response{
 		Type: runnerpb.Once_ERROR,
 		Data: &runnerpb.Once_Response_Error{
 			Error: &runnerpb.Once_Error{Message: msg},
 		},
-	}
+	})
 }
 
 func (r *run) onceResponseRunResult(rsp *runnerpb.Once_Response_RunResponse) {
-	if r.oncerspChan == nil {
-		return
-	}
-	r.oncerspChan <- &runnerpb.Once_Response{
+	r.sendOnceResponse(&runnerpb.Once_Response{
 		Type: runnerpb.Once_RUN_RESPONSE,
 		Data: rsp,
+	})
+}
+
+func (r *run) onceResponseTrace(result *runnerpb.ReconcileResult) {
+	r.sendOnceResponse(&runnerpb.Once_Response{
+		Type: runnerpb.Once_TRACE,
+		Data: &runnerpb.Once_Response_Trace{
+			Trace: result,
+		},
+	})
+}
+
+// onceStream hands the responses of a run once to the goroutine that streams
+// them to the client. A send returns when the client is gone, and the channel
+// is only closed once all the senders returned.
+type onceStream struct {
+	m      sync.RWMutex
+	ctx    context.Context
+	ch     chan *runnerpb.Once_Response
+	closed bool
+}
+
+func newOnceStream(ctx context.Context) *onceStream {
+	return &onceStream{
+		ctx: ctx,
+		ch:  make(chan *runnerpb.Once_Response),
+	}
+}
+
+func (r *onceStream) send(rsp *runnerpb.Once_Response) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	if r.closed {
+		return
+	}
+	select {
+	case <-r.ctx.Done():
+	case r.ch <- rsp:
+	}
+}
+
+// close must only be called after the ctx is done, since it waits for
+// the active senders to return
+func (r *onceStream) close() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.closed {
+		return
 	}
+	r.closed = true
+	close(r.ch)
 }
 
 /*
@@ -222,29 +323,40 @@ func (r *run) onceResponseSDCResult(rsp *runnerpb.Once_Response_SdcResponse) {
 }
 */
 
-func (r *run) once(ctx context.Context, bctx *BranchCtx) {
+func (r *run) once(ctx context.Context, bctx *BranchCtx, opts *runnerpb.Once_Options) {
 	log := log.FromContext(ctx)
-	if r.getStatus() != RunnerStatus_Stopped {
-		r.onceResponseError(fmt.Sprintf("runner is already running, status %s", r.status.String()))
-		return
-	}
-	r.onceResponseProgressUpdate("loading ...")
-	if err := r.Load(ctx, bctx); err != nil {
-		log.Error("loading failed", "err", err)
-		r.onceResponseError(err.Error())
-		return
-	}
-	r.onceResponseProgressUpdate("loading done")
-
-	// we use the server context to cancel/handle the status of the server
-	// since the ctx we get is from the client
-	_, cancel := context.WithCancel(r.choreo.GetContext())
+	// the run is cancelled by the client closing the stream or by stopping the runner of the branch
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	r.setStatusAndCancel(RunnerStatus_Once, cancel)
-
 	defer r.Stop()
 
+	var affected sets.Set[string] // nil means all reconcilers
+	if opts.GetIncremental() {
+		var reason string
+		var err error
+		affected, reason, err = r.loadIncremental(ctx, bctx)
+		if err != nil {
+			log.Error("incremental loading failed", "err", err)
+			r.onceResponseError(err.Error())
+			return
+		}
+		if reason != "" {
+			r.onceResponseProgressUpdate(fmt.Sprintf("full load required: %s", reason))
+		}
+	}
+	if affected == nil {
+		r.onceResponseProgressUpdate("loading ...")
+		if err := r.Load(ctx, bctx); err != nil {
+			log.Error("loading failed", "err", err)
+			r.onceResponseError(err.Error())
+			return
+		}
+		r.onceResponseProgressUpdate("loading done")
+	}
+
 	r.onceResponseProgressUpdate("running reconcilers ...")
-	rsp, err := r.runReconcilers(ctx, bctx, true) // run once
+	rsp, err := r.runReconcilers(ctx, bctx, true, opts, affected) // run once
 	if err != nil {
 		r.onceResponseError(err.Error())
 		return
@@ -253,22 +365,28 @@ func (r *run) once(ctx context.Context, bctx *BranchCtx) {
 
 	if r.choreo.GetConfig().ServerFlags.SDC != nil && *r.choreo.GetConfig().ServerFlags.SDC {
 		r.onceResponseProgressUpdate("running config validator ...")
-		configValidator := NewConfigValidator(r.choreo)
+		configValidator := NewConfigValidator(r.choreo, r.getInstance())
 		if err := configValidator.runConfigValidation(ctx, bctx); err != nil {
 			r.onceResponseError(err.Error())
 			return
 		}
 	}
 
-	r.createSnapshot(ctx, bctx, rsp)
+	if err := r.createSnapshot(ctx, bctx, rsp); err != nil {
+		log.Error("snapshot creation failed", "err", err)
+	}
 	r.onceResponseCompleted()
 }
 
 // loads upstream refs, apis, reconcilers, data and garbage collect
 func (r *run) Load(ctx context.Context, branchCtx *BranchCtx) error {
 	log := log.FromContext(ctx)
-	// we only work with checkout branch
-	rootChoreoInstance := r.choreo.GetRootChoreoInstance()
+	// the loaded input is only known when the load succeeds
+	r.setInput(nil)
+	if err := r.initInstance(ctx, branchCtx); err != nil {
+		return err
+	}
+	rootChoreoInstance := r.getInstance()
 
 	if r.choreo.GetConfig().ServerFlags.SDC != nil && *r.choreo.GetConfig().ServerFlags.SDC {
 		if err := r.loadSchemas(ctx, branchCtx, rootChoreoInstance); err != nil {
@@ -300,6 +418,9 @@ func (r *run) Load(ctx context.Context, branchCtx *BranchCtx) error {
 	if err := r.loadReconcilers(ctx, branchCtx, rootChoreoInstance, reconcilers); err != nil {
 		return err
 	}
+	if err := r.checkReconcilerCycles(ctx); err != nil {
+		return err
+	}
 
 	// load and update the global apis
 	for _, childChoreoInstance := range rootChoreoInstance.GetChildren() {
@@ -309,17 +430,12 @@ func (r *run) Load(ctx context.Context, branchCtx *BranchCtx) error {
 		}
 	}
 	branchCtx.APIStore.Import(rootChoreoInstance.GetAPIs())
+	setReconcilerIndexes(ctx, branchCtx, r.getReconcilerRunners())
+	setAuditors(branchCtx, r.choreo.AuditLog(), rootChoreoInstance.GetInternalAPIStore().GetExternalGVKSet())
 
 	if err := r.choreo.GetBranchStore().UpdateBranchCtx(branchCtx); err != nil {
 		return err
 	}
-	apiResources := branchCtx.APIStore.GetAPIResources()
-	/*
-		for _, apiResource := range apiResources {
-			fmt.Println("apiResource", apiResource.Kind)
-		}
-	*/
-
 	for _, childChoreoInstance := range rootChoreoInstance.GetChildren() {
 		if childChoreoInstance.IsRootInstance() {
 			// load the data
@@ -332,8 +448,28 @@ func (r *run) Load(ctx context.Context, branchCtx *BranchCtx) error {
 		return err
 	}
 
-	// run the garbage collection to cleanup childObjects for root Objects that got deleted
-	// since the reconcilers dont run all the time this is needed
+	if err := r.collectGarbage(ctx, branchCtx); err != nil {
+		return err
+	}
+	// record the loaded input, such that a subsequent run can be incremental
+	digest, _, err := r.buildInputDigest(ctx, branchCtx)
+	if err != nil {
+		log.Warn("cannot build input digest", "err", err)
+	}
+	r.setInput(digest)
+	return nil
+}
+
+// collectGarbage cleans up the childObjects for root Objects that got deleted
+// since the reconcilers dont run all the time this is needed
+func (r *run) collectGarbage(ctx context.Context, branchCtx *BranchCtx) error {
+	log := log.FromContext(ctx)
+	apiResources := branchCtx.APIStore.GetAPIResources()
+	/*
+		for _, apiResource := range apiResources {
+			fmt.Println("apiResource", apiResource.Kind)
+		}
+	*/
 	inv := inventory.Inventory{}
 	if err := inv.Build(ctx, r.choreo.GetClient(), apiResources, &inventory.BuildOptions{
 		ShowManagedField: true,
@@ -360,7 +496,6 @@ func (r *run) Load(ctx context.Context, branchCtx *BranchCtx) error {
 		}
 	}
 	return errm
-
 }
 
 func (r *run) loadSchemas(ctx context.Context, _ *BranchCtx, choreoInstance instance.ChoreoInstance) error {
@@ -371,7 +506,7 @@ func (r *run) loadSchemas(ctx context.Context, _ *BranchCtx, choreoInstance inst
 		//Branch:     branchCtx.Branch,
 		RepoPath:   choreoInstance.GetRepoPath(),
 		PathInRepo: choreoInstance.GetPathInRepo(),
-		TempDir:    r.choreo.GetRootChoreoInstance().GetTempPath(), // this is the temppath of the rootInstance
+		TempDir:    r.getInstance().GetTempPath(), // this is the temppath of the instance of the branch
 	}
 	// this loads schema to the schemastore
 	if err := schemaloader.Load(ctx); err != nil {
@@ -388,7 +523,7 @@ func (r *run) loadUpstreamRefs(ctx context.Context, branchCtx *BranchCtx, choreo
 		Branch:     branchCtx.Branch,
 		RepoPath:   choreoInstance.GetRepoPath(),
 		PathInRepo: choreoInstance.GetPathInRepo(),
-		TempDir:    r.choreo.GetRootChoreoInstance().GetTempPath(), // this is the temppath of the rootInstance
+		TempDir:    r.getInstance().GetTempPath(), // this is the temppath of the instance of the branch
 		ProgressFn: r.onceResponseProgressUpdate,
 	}
 	// this loads additional choreoinstances
@@ -412,7 +547,7 @@ func (r *run) loadAPIs(ctx context.Context, branchCtx *BranchCtx, choreoInstance
 		apiStore = choreoInstance.GetAPIs()
 	}
 
-	rootChoreoInstance := r.choreo.GetRootChoreoInstance()
+	rootChoreoInstance := r.getInstance()
 	loader := &apiloader.APILoaderFile2APIStoreAndAPI{
 		Cfg:      r.choreo.GetConfig(),
 		Client:   r.choreo.GetClient(),
@@ -423,6 +558,7 @@ func (r *run) loadAPIs(ctx context.Context, branchCtx *BranchCtx, choreoInstance
 		RepoPath:     choreoInstance.GetRepoPath(),
 		PathInRepo:   choreoInstance.GetPathInRepo(),
 		DBPath:       rootChoreoInstance.GetDBPath(),
+		DBBackend:    rootChoreoInstance.GetDBBackend(),
 	}
 	// TBD if we need to use the commit loader or not
 	if err := loader.Load(ctx); err != nil {
@@ -487,7 +623,7 @@ func (r *run) loadReconcilers(ctx context.Context, branchCtx *BranchCtx, choreoI
 }
 
 func (r *run) loadData(ctx context.Context, branchCtx *BranchCtx, choreoInstance instance.ChoreoInstance, gvks []schema.GroupVersionKind) error {
-	rootChoreoInstance := r.choreo.GetRootChoreoInstance()
+	rootChoreoInstance := r.getInstance()
 
 	annotation := choreov1alpha1.FileLoaderAnnotation.String()
 	if upstreamRef := choreoInstance.GetUpstreamRef(); upstreamRef != nil {
@@ -529,7 +665,9 @@ func HasAPIResource(apiResources []*discoverypb.APIResource, gvk schema.GroupVer
 	return false
 }
 
-func (r *run) runReconcilers(ctx context.Context, branchCtx *BranchCtx, once bool) (*runnerpb.Once_Response_RunResponse, error) {
+// runReconcilers runs the reconcilers per runner, when affected is not nil only the
+// affected reconcilers run and runners without affected reconcilers are skipped
+func (r *run) runReconcilers(ctx context.Context, branchCtx *BranchCtx, once bool, opts *runnerpb.Once_Options, affected sets.Set[string]) (*runnerpb.Once_Response_RunResponse, error) {
 	rsp := &runnerpb.Once_Response_RunResponse{
 		RunResponse: &runnerpb.Once_RunResponse{
 			Success: true,
@@ -537,7 +675,7 @@ func (r *run) runReconcilers(ctx context.Context, branchCtx *BranchCtx, once boo
 		},
 	}
 
-	rootChoreoInstance := r.choreo.GetRootChoreoInstance()
+	rootChoreoInstance := r.getInstance()
 	rootLibraries := []*choreov1alpha1.Library{}
 	for _, childChoreoInstance := range rootChoreoInstance.GetChildren() {
 		// gather the libraries - libraries are used for the child choreo instance runner
@@ -549,16 +687,17 @@ func (r *run) runReconcilers(ctx context.Context, branchCtx *BranchCtx, once boo
 		libraries = append(libraries, childChoreoInstance.GetLibraries()...)
 		rootLibraries = append(rootLibraries, libraries...)
 		// only run the reconciler when there are reconcilers
-		if len(childChoreoInstance.GetReconcilers()) > 0 {
+		if reconcilers := filterReconcilers(childChoreoInstance.GetReconcilers(), affected); len(reconcilers) > 0 {
 			r.onceResponseProgressUpdate(fmt.Sprintf("running child reconciler %s", childChoreoInstance.GetUpstreamRef().GetName()))
 			upstreamRefName := childChoreoInstance.GetUpstreamRef().GetName()
 			runrsp, err := r.runReconciler(
 				ctx,
 				upstreamRefName,
 				branchCtx,
-				childChoreoInstance.GetReconcilers(),
+				reconcilers,
 				libraries,
 				once,
+				opts,
 			) // run once
 			if err != nil {
 				rsp.RunResponse.Success = false
@@ -572,15 +711,16 @@ func (r *run) runReconcilers(ctx context.Context, branchCtx *BranchCtx, once boo
 		}
 	}
 	rootLibraries = append(rootLibraries, rootChoreoInstance.GetLibraries()...)
-	if len(rootChoreoInstance.GetReconcilers()) > 0 {
+	if reconcilers := filterReconcilers(rootChoreoInstance.GetReconcilers(), affected); len(reconcilers) > 0 {
 		r.onceResponseProgressUpdate(fmt.Sprintf("running root reconciler %s", rootChoreoInstance.GetName()))
 		runrsp, err := r.runReconciler(
 			ctx,
 			"root",
 			branchCtx,
-			rootChoreoInstance.GetReconcilers(),
+			reconcilers,
 			rootLibraries,
 			once,
+			opts,
 		) // run once
 		if err != nil {
 			rsp.RunResponse.Success = false
@@ -596,7 +736,8 @@ func (r *run) runReconcilers(ctx context.Context, branchCtx *BranchCtx, once boo
 
 }
 
-func (r *run) runReconciler(ctx context.Context, ref string, branchCtx *BranchCtx, reconcilers []*choreov1alpha1.Reconciler, libraries []*choreov1alpha1.Library, once bool) (*runnerpb.Once_RunResult, error) {
+func (r *run) runReconciler(ctx context.Context, ref string, branchCtx *BranchCtx, reconcilers []*choreov1alpha1.Reconciler, libraries []*choreov1alpha1.Library, once bool, opts *runnerpb.Once_Options) (*runnerpb.Once_RunResult, error) {
+	reconcilers = r.setReconcilerDefaults(reconcilers)
 	reconcilerGVKs := sets.New[schema.GroupVersionKind]()
 	for _, reconciler := range reconcilers {
 		reconcilerGVKs.Insert(reconciler.GetGVKs().UnsortedList()...)
@@ -604,24 +745,41 @@ func (r *run) runReconciler(ctx context.Context, ref string, branchCtx *BranchCt
 
 	reconcilerResultCh := make(chan *runnerpb.ReconcileResult)
 	runResultCh := make(chan *runnerpb.Once_RunResult)
-	collector := collector.New(reconcilerResultCh, runResultCh)
+	collectorOpts := &collector.Options{}
+	if once {
+		collectorOpts = r.getCollectorOptions(opts)
+	}
+	collector := collector.New(reconcilerResultCh, runResultCh, collectorOpts)
 	informerfactory := informers.NewInformerFactory(r.choreo.GetClient(), reconcilerGVKs, branchCtx.Branch)
+	client := r.choreo.GetClient()
+	if once {
+		// the objects written by the reconcilers are tracked to detect oscillations
+		client = collector.Client(client)
+	}
 
+	// the reconcilers are stopped when the run finishes, this also stops
+	// the subprocesses of the external reconcilers
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	reconcilerfactory, err := reconciler.NewReconcilerFactory(
 		ctx,
-		r.choreo.GetClient(),
+		client,
 		informerfactory,
 		reconcilers,
 		libraries,
 		reconcilerResultCh,
 		branchCtx.Branch,
+		&reconciler.FactoryOptions{
+			// waves are only used when running once, since they delay the reconcilers
+			Waves:  once && ptr.Deref(r.choreo.GetConfig().ServerFlags.ReconcilerWaves, false),
+			Holder: collector,
+			Trace:  once && opts.GetTrace(),
+		},
 	)
 
 	if err != nil {
 		return nil, err
 	}
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
 	var wg sync.WaitGroup
 
 	wg.Add(1)
@@ -652,8 +810,83 @@ func (r *run) runReconciler(ctx context.Context, ref string, branchCtx *BranchCt
 
 	case <-ctx.Done():
 		wg.Wait()
-		return nil, nil
+		return nil, status.Errorf(codes.Canceled, "reconciler runner %s of branch %s cancelled", ref, branchCtx.Branch)
+	}
+}
+
+// getCollectorOptions returns the convergence limits of the run once, the server
+// defaults apply when the request does not specify them
+func (r *run) getCollectorOptions(opts *runnerpb.Once_Options) *collector.Options {
+	serverFlags := r.choreo.GetConfig().ServerFlags
+	o := &collector.Options{
+		MaxIterations: ptr.Deref(serverFlags.OnceMaxIterations, 0),
+		Deadline:      ptr.Deref(serverFlags.OnceDeadline, 0),
+	}
+	if opts.GetMaxIterations() > 0 {
+		o.MaxIterations = opts.GetMaxIterations()
+	}
+	if opts.GetDeadline().AsDuration() > 0 {
+		o.Deadline = opts.GetDeadline().AsDuration()
+	}
+	if opts.GetTrace() {
+		o.TraceFn = r.onceResponseTrace
+	}
+	return o
+}
+
+// reconcilerIndexOwner owns the indexes declared by the reconcilers
+const reconcilerIndexOwner = "reconcilers"
+
+// setReconcilerIndexes declares the selector expressions of the loaded reconcilers as indexes
+// on the storage of the for resource, since the watch event handlers list the for resources
+// with these expressions on every event of the watched resources. The indexes of the
+// reconcilers that are no longer loaded are removed.
+func setReconcilerIndexes(ctx context.Context, branchCtx *BranchCtx, runners []reconcilerRunner) {
+	log := log.FromContext(ctx)
+	expressions := map[schema.GroupKind][]string{}
+	for _, runner := range runners {
+		for _, reconciler := range runner.reconcilers {
+			gk := reconciler.Spec.For.ResourceGVK.GetGVK().GroupKind()
+			if reconciler.Spec.For.Selector != nil {
+				for expression := range reconciler.Spec.For.Selector.Match {
+					expressions[gk] = append(expressions[gk], expression)
+				}
+			}
+			for _, resource := range reconciler.Spec.Watches {
+				if resource == nil || resource.Selector == nil {
+					continue
+				}
+				for _, expression := range resource.Selector.Match {
+					expressions[gk] = append(expressions[gk], expression)
+				}
+			}
+		}
 	}
+	branchCtx.APIStore.List(func(_ store.Key, rctx *api.ResourceContext) {
+		indexer, ok := rctx.Storage.(rest.Indexer)
+		if !ok {
+			return
+		}
+		gk := rctx.ExternalGVK().GroupKind()
+		if err := indexer.SetIndexes(reconcilerIndexOwner, expressions[gk]...); err != nil {
+			log.Error("cannot set reconciler indexes", "gk", gk.String(), "error", err)
+		}
+	})
+}
+
+// setReconcilerDefaults returns a copy of the reconcilers with the server defaults applied
+func (r *run) setReconcilerDefaults(reconcilers []*choreov1alpha1.Reconciler) []*choreov1alpha1.Reconciler {
+	serverFlags := r.choreo.GetConfig().ServerFlags
+	newReconcilers := make([]*choreov1alpha1.Reconciler, 0, len(reconcilers))
+	for _, reconciler := range reconcilers {
+		reconciler = reconciler.DeepCopy()
+		reconciler.SetDefaultExecutionLimits(
+			ptr.Deref(serverFlags.ReconcilerMaxSteps, 0),
+			ptr.Deref(serverFlags.ReconcilerTimeout, 0),
+		)
+		newReconcilers = append(newReconcilers, reconciler)
+	}
+	return newReconcilers
 }
 
 func (r *run) createSnapshot(ctx context.Context, bctx *BranchCtx, rsp *runnerpb.Once_Response_RunResponse) error {
@@ -671,8 +904,9 @@ func (r *run) createSnapshot(ctx context.Context, bctx *BranchCtx, rsp *runnerpb
 	}
 	fmt.Println("create snapshot", uid)
 
-	r.choreo.SnapshotManager().Create(uid, apiResources, inv, rsp)
-
+	if err := r.choreo.SnapshotManager().Create(uid, apiResources, inv, rsp); err != nil {
+		return status.Errorf(codes.Internal, "err: %s", err.Error())
+	}
 	return nil
 }
 
@@ -694,3 +928,65 @@ func (r *run) getCancel() func() {
 	defer r.m.RUnlock()
 	return r.cancel
 }
+
+func (r *run) getInput() *inputDigest {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	return r.input
+}
+
+func (r *run) setInput(input *inputDigest) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.input = input
+}
+
+// getInstance returns the choreo instance of the branch the runner works on
+func (r *run) getInstance() instance.ChoreoInstance {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	if r.instance != nil {
+		return r.instance
+	}
+	return r.choreo.GetRootChoreoInstance()
+}
+
+// initInstance initializes the choreo instance of the branch; a branch that is not checked out
+// is loaded from its commit in a dedicated instance, such that it is reconciled independently
+// of the checked out branch
+func (r *run) initInstance(ctx context.Context, bctx *BranchCtx) error {
+	if bctx.State.String() == "CheckedOut" {
+		r.m.Lock()
+		defer r.m.Unlock()
+		r.instance = nil
+		return nil
+	}
+	rootChoreoInstance := r.choreo.GetRootChoreoInstance()
+	commit, err := rootChoreoInstance.GetRepo().GetBranchCommit(bctx.Branch)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "cannot get commit of branch %s, err: %s", bctx.Branch, err.Error())
+	}
+	branchChoreoInstance, err := instance.NewBranchChoreoInstance(ctx, rootChoreoInstance, bctx.Branch, commit)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot initialize branch %s, err: %s", bctx.Branch, err.Error())
+	}
+	// the internal apis of the branch are stored in the branch instance
+	bctx.APIStore.Import(branchChoreoInstance.GetInternalAPIStore())
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.instance = branchChoreoInstance
+	return nil
+}
+
+// setStatusAndCancelIfStopped sets the status when the runner is stopped, otherwise
+// the current status is returned
+func (r *run) setStatusAndCancelIfStopped(status RunnerStatus, cancel func()) (RunnerStatus, bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.status != RunnerStatus_Stopped {
+		return r.status, false
+	}
+	r.status = status
+	r.cancel = cancel
+	return status, true
+}
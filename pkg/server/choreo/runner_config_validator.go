@@ -25,6 +25,7 @@ import (
 	"github.com/henderiw/logger/log"
 	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/choreo/instance"
 	"github.com/kform-dev/choreo/pkg/server/choreo/loader"
 	infrav1alpha1 "github.com/kuidio/kuid/apis/infra/v1alpha1"
 	"github.com/sdcio/config-diff/schemaclient"
@@ -46,13 +47,16 @@ type NodeConfig struct {
 }
 
 type ConfigValidator struct {
-	choreo      Choreo
+	choreo Choreo
+	// instance is the choreo instance of the branch that is validated
+	instance    instance.ChoreoInstance
 	nodeConfigs map[string]*NodeConfig
 }
 
-func NewConfigValidator(choreo Choreo) *ConfigValidator {
+func NewConfigValidator(choreo Choreo, instance instance.ChoreoInstance) *ConfigValidator {
 	return &ConfigValidator{
 		choreo:      choreo,
+		instance:    instance,
 		nodeConfigs: map[string]*NodeConfig{},
 	}
 }
@@ -69,7 +73,7 @@ func (r *ConfigValidator) runConfigValidation(ctx context.Context, bctx *BranchC
 		return err
 	}
 
-	schemastore := r.choreo.GetRootChoreoInstance().SchemaStore()
+	schemastore := r.instance.SchemaStore()
 
 	var errs error
 	for node, nodeInfo := range r.nodeConfigs {
@@ -409,7 +413,7 @@ func (r *ConfigValidator) gatherConfigs(ctx context.Context, bctx *BranchCtx) er
 func (r *ConfigValidator) gatherRunningConfigs(ctx context.Context, _ *BranchCtx) error {
 	log := log.FromContext(ctx)
 	runningConfigs := map[string]any{}
-	rootChoreoInstance := r.choreo.GetRootChoreoInstance()
+	rootChoreoInstance := r.instance
 	runningconfigLoader := loader.RunningConfigLoader{
 		Cfg:            r.choreo.GetConfig(),
 		RepoPath:       rootChoreoInstance.GetRepoPath(),
@@ -0,0 +1,126 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/henderiw/logger/log"
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/controller/reconciler/graph"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+// reconcilerRunner is a set of reconcilers that run together, the reconcilers of
+// an upstream ref run separately from the root reconcilers
+type reconcilerRunner struct {
+	name        string
+	reconcilers []*choreov1alpha1.Reconciler
+}
+
+// getReconcilerRunners returns the loaded reconcilers per runner, in the order they run
+func (r *run) getReconcilerRunners() []reconcilerRunner {
+	rootChoreoInstance := r.getInstance()
+	runners := []reconcilerRunner{}
+	for _, childChoreoInstance := range rootChoreoInstance.GetChildren() {
+		if len(childChoreoInstance.GetReconcilers()) > 0 {
+			runners = append(runners, reconcilerRunner{
+				name:        childChoreoInstance.GetUpstreamRef().GetName(),
+				reconcilers: childChoreoInstance.GetReconcilers(),
+			})
+		}
+	}
+	if len(rootChoreoInstance.GetReconcilers()) > 0 {
+		runners = append(runners, reconcilerRunner{
+			name:        "root",
+			reconcilers: rootChoreoInstance.GetReconcilers(),
+		})
+	}
+	return runners
+}
+
+// checkReconcilerCycles warns about dependency cycles between the reconcilers, or
+// fails when cycles are rejected by the server
+func (r *run) checkReconcilerCycles(ctx context.Context) error {
+	log := log.FromContext(ctx)
+	reject := ptr.Deref(r.choreo.GetConfig().ServerFlags.RejectCycles, false)
+	var errm error
+	for _, runner := range r.getReconcilerRunners() {
+		err := graph.New(runner.reconcilers).CycleError()
+		if err == nil {
+			continue
+		}
+		if reject {
+			errm = errors.Join(errm, fmt.Errorf("runner %s: %v", runner.name, err))
+			continue
+		}
+		log.Warn("reconciler dependency cycles detected", "runner", runner.name, "error", err.Error())
+	}
+	return errm
+}
+
+// Deps returns the dependency graph of the reconcilers; the reconcilers are loaded when
+// the runner is stopped, otherwise the graph of the running reconcilers is returned
+func (r *run) Deps(ctx context.Context, bctx *BranchCtx) (*runnerpb.Deps_Response, error) {
+	// a stopped runner is claimed while the reconcilers are loaded, such that a run
+	// cannot start while the load is in progress
+	if _, ok := r.setStatusAndCancelIfStopped(RunnerStatus_Once, nil); ok {
+		defer r.Stop()
+		if err := r.Load(ctx, bctx); err != nil {
+			return nil, status.Errorf(codes.Internal, "load failed, err: %s", err.Error())
+		}
+	}
+
+	rsp := &runnerpb.Deps_Response{}
+	for _, runner := range r.getReconcilerRunners() {
+		g := graph.New(runner.reconcilers)
+		pbgraph := &runnerpb.Deps_Graph{ReconcilerRunner: runner.name}
+		for _, node := range g.Nodes() {
+			pbgraph.Reconcilers = append(pbgraph.Reconcilers, &runnerpb.Deps_Reconciler{
+				Name:      node.Name,
+				For:       toDepsGroupKind(node.For),
+				Owns:      toDepsGroupKinds(node.Owns),
+				Watches:   toDepsGroupKinds(node.Watches),
+				DependsOn: node.DependsOn,
+				Wave:      int32(node.Wave),
+			})
+		}
+		for _, cycle := range g.Cycles() {
+			pbgraph.Cycles = append(pbgraph.Cycles, &runnerpb.Deps_Cycle{Reconcilers: cycle})
+		}
+		rsp.Graphs = append(rsp.Graphs, pbgraph)
+	}
+	return rsp, nil
+}
+
+func toDepsGroupKind(gk schema.GroupKind) *runnerpb.Deps_GroupKind {
+	return &runnerpb.Deps_GroupKind{Group: gk.Group, Kind: gk.Kind}
+}
+
+func toDepsGroupKinds(gks []schema.GroupKind) []*runnerpb.Deps_GroupKind {
+	pbgks := make([]*runnerpb.Deps_GroupKind, 0, len(gks))
+	for _, gk := range gks {
+		pbgks = append(pbgks, toDepsGroupKind(gk))
+	}
+	return pbgks
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/henderiw/logger/log"
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/controller/reconciler/graph"
+	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
+	"github.com/kform-dev/choreo/pkg/server/choreo/loader"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+)
+
+// inputDigest records the files a run once loaded; it is kept by the runner
+// such that an incremental run once can determine what changed since
+type inputDigest struct {
+	Branch string `json:"branch"`
+	// Dev is the hash per directory of the apis, reconcilers, libraries, refs and schemas;
+	// a change in these directories requires a full load
+	Dev   map[string]string `json:"dev"`
+	Input []*inputObject    `json:"input"`
+}
+
+type inputObject struct {
+	Ref  corev1.ObjectReference `json:"ref"`
+	Path string                 `json:"path"`
+	Hash string                 `json:"hash"`
+}
+
+type inputChanges struct {
+	// changed are the objects that are new or changed since the base
+	changed []corev1.ObjectReference
+	// deleted are the objects that are no longer part of the input
+	deleted []corev1.ObjectReference
+	// files are the paths of the files with changed or deleted objects
+	files sets.Set[string]
+}
+
+func (r *inputChanges) groupKinds() sets.Set[schema.GroupKind] {
+	gks := sets.New[schema.GroupKind]()
+	for _, ref := range append(append([]corev1.ObjectReference{}, r.changed...), r.deleted...) {
+		gks.Insert(ref.GroupVersionKind().GroupKind())
+	}
+	return gks
+}
+
+// changedDevDirs returns the dev directories that differ between the digests
+func changedDevDirs(base, digest *inputDigest) []string {
+	dirs := sets.New[string]()
+	for dir, hash := range digest.Dev {
+		if base.Dev[dir] != hash {
+			dirs.Insert(dir)
+		}
+	}
+	for dir := range base.Dev {
+		if _, ok := digest.Dev[dir]; !ok {
+			dirs.Insert(dir)
+		}
+	}
+	return sets.List(dirs)
+}
+
+// diffInput returns the input objects that changed or got deleted since the base
+func diffInput(base, digest *inputDigest) *inputChanges {
+	changes := &inputChanges{
+		changed: []corev1.ObjectReference{},
+		deleted: []corev1.ObjectReference{},
+		files:   sets.New[string](),
+	}
+	baseObjects := make(map[corev1.ObjectReference]*inputObject, len(base.Input))
+	for _, object := range base.Input {
+		baseObjects[object.Ref] = object
+	}
+	objects := make(map[corev1.ObjectReference]*inputObject, len(digest.Input))
+	for _, object := range digest.Input {
+		objects[object.Ref] = object
+		if baseObject, ok := baseObjects[object.Ref]; ok && baseObject.Hash == object.Hash {
+			continue
+		}
+		changes.changed = append(changes.changed, object.Ref)
+		changes.files.Insert(object.Path)
+	}
+	for _, object := range base.Input {
+		if _, ok := objects[object.Ref]; ok {
+			continue
+		}
+		changes.deleted = append(changes.deleted, object.Ref)
+		changes.files.Insert(object.Path)
+	}
+	return changes
+}
+
+// affectedReconcilers returns the reconcilers whose for or watched resources are part of
+// the changed group kinds, and the reconcilers that depend on them
+func affectedReconcilers(reconcilers []*choreov1alpha1.Reconciler, gks sets.Set[schema.GroupKind]) sets.Set[string] {
+	affected := sets.New[string]()
+	nodes := graph.New(reconcilers).Nodes()
+	for _, node := range nodes {
+		if gks.Has(node.For) || gks.HasAny(node.Watches...) {
+			affected.Insert(node.Name)
+		}
+	}
+	// the resources of the affected reconcilers change, which affects the reconcilers
+	// that depend on them
+	for changed := true; changed; {
+		changed = false
+		for _, node := range nodes {
+			if !affected.Has(node.Name) && affected.HasAny(node.DependsOn...) {
+				affected.Insert(node.Name)
+				changed = true
+			}
+		}
+	}
+	return affected
+}
+
+// filterReconcilers returns the reconcilers in the affected set, nil affected means all reconcilers
+func filterReconcilers(reconcilers []*choreov1alpha1.Reconciler, affected sets.Set[string]) []*choreov1alpha1.Reconciler {
+	if affected == nil {
+		return reconcilers
+	}
+	filtered := []*choreov1alpha1.Reconciler{}
+	for _, reconciler := range reconcilers {
+		if affected.Has(reconciler.GetName()) {
+			filtered = append(filtered, reconciler)
+		}
+	}
+	return filtered
+}
+
+func (r *run) getInputDataLoader(bctx *BranchCtx) *loader.DataLoader {
+	rootChoreoInstance := r.getInstance()
+	return &loader.DataLoader{
+		Cfg:            r.choreo.GetConfig(),
+		Client:         r.choreo.GetClient(),
+		Branch:         bctx.Branch,
+		GVKs:           bctx.APIStore.GetExternalGVKSet().UnsortedList(),
+		RepoPth:        rootChoreoInstance.GetRepoPath(),
+		PathInRepo:     rootChoreoInstance.GetPathInRepo(),
+		InternalAPISet: rootChoreoInstance.GetInternalAPIStore().GetExternalGVKSet(),
+		Annotation:     choreov1alpha1.FileLoaderAnnotation.String(),
+	}
+}
+
+// buildInputDigest returns the digest of the input and dev files of the root choreo instance,
+// together with the input objects
+func (r *run) buildInputDigest(ctx context.Context, bctx *BranchCtx) (*inputDigest, []*loader.InputObject, error) {
+	rootChoreoInstance := r.getInstance()
+	serverFlags := r.choreo.GetConfig().ServerFlags
+	dirs := []string{
+		ptr.Deref(serverFlags.CRDPath, ""),
+		ptr.Deref(serverFlags.ReconcilerPath, ""),
+		ptr.Deref(serverFlags.LibraryPath, ""),
+		ptr.Deref(serverFlags.RefsPath, ""),
+	}
+	if ptr.Deref(serverFlags.SDC, false) {
+		dirs = append(dirs, ptr.Deref(serverFlags.SchemaPath, ""))
+	}
+	digest := &inputDigest{
+		Branch: bctx.Branch,
+		Dev:    map[string]string{},
+		Input:  []*inputObject{},
+	}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		hash, err := hashDir(filepath.Join(rootChoreoInstance.GetRepoPath(), rootChoreoInstance.GetPathInRepo(), dir))
+		if err != nil {
+			return nil, nil, err
+		}
+		digest.Dev[dir] = hash
+	}
+
+	objects, err := r.getInputDataLoader(bctx).ReadInput(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, object := range objects {
+		digest.Input = append(digest.Input, &inputObject{
+			Ref: corev1.ObjectReference{
+				APIVersion: object.Object.GetAPIVersion(),
+				Kind:       object.Object.GetKind(),
+				Namespace:  object.Object.GetNamespace(),
+				Name:       object.Object.GetName(),
+			},
+			Path: object.Path,
+			Hash: object.Hash,
+		})
+	}
+	return digest, objects, nil
+}
+
+// hashDir returns a hash of the paths and the content of the files in the directory;
+// a directory that does not exist has an empty hash
+func hashDir(dir string) (string, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return "", nil
+	}
+	paths := []string{}
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			paths = append(paths, path)
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+	sort.Strings(paths)
+	h := sha256.New()
+	for _, path := range paths {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s\x00", rel)
+		f, err := os.Open(path)
+		if err != nil {
+			return "", err
+		}
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// loadIncremental loads the input that changed since the last load and returns the
+// reconcilers affected by the changes. When the changes cannot be loaded incrementally,
+// the reason is returned and a full load is required.
+func (r *run) loadIncremental(ctx context.Context, bctx *BranchCtx) (sets.Set[string], string, error) {
+	log := log.FromContext(ctx)
+	loaded := r.getInput()
+	if loaded == nil {
+		return nil, "nothing loaded", nil
+	}
+	if bctx.State.String() != "CheckedOut" {
+		// a branch that is not checked out is loaded from its commit
+		commit, err := r.choreo.GetRootChoreoInstance().GetRepo().GetBranchCommit(bctx.Branch)
+		if err != nil {
+			return nil, fmt.Sprintf("cannot get the commit of branch %s, err: %v", bctx.Branch, err), nil
+		}
+		if loadedCommit := r.getInstance().GetCommit(); loadedCommit == nil || loadedCommit.Hash != commit.Hash {
+			return nil, fmt.Sprintf("the commit of branch %s changed", bctx.Branch), nil
+		}
+	}
+	if loaded.Branch != bctx.Branch {
+		return nil, fmt.Sprintf("the loaded input is from branch %s", loaded.Branch), nil
+	}
+	digest, objects, err := r.buildInputDigest(ctx, bctx)
+	if err != nil {
+		return nil, fmt.Sprintf("cannot read the input, err: %v", err), nil
+	}
+	if dirs := changedDevDirs(loaded, digest); len(dirs) > 0 {
+		return nil, fmt.Sprintf("%s changed", strings.Join(dirs, ", ")), nil
+	}
+
+	// the input is diffed against the loaded input, which reflects the objects in the store
+	changes := diffInput(loaded, digest)
+	r.onceResponseProgressUpdate(fmt.Sprintf("incremental load: %d changed files, %d changed objects, %d deleted objects",
+		changes.files.Len(), len(changes.changed), len(changes.deleted)))
+
+	changed := sets.New(changes.changed...)
+	applyObjects := []*unstructured.Unstructured{}
+	for _, object := range objects {
+		if changed.Has(corev1.ObjectReference{
+			APIVersion: object.Object.GetAPIVersion(),
+			Kind:       object.Object.GetKind(),
+			Namespace:  object.Object.GetNamespace(),
+			Name:       object.Object.GetName(),
+		}) {
+			applyObjects = append(applyObjects, object.Object)
+		}
+	}
+	var errm error
+	if err := r.getInputDataLoader(bctx).ApplyInput(ctx, applyObjects); err != nil {
+		errm = errors.Join(errm, err)
+	}
+	for _, ref := range changes.deleted {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(ref.GroupVersionKind())
+		u.SetName(ref.Name)
+		u.SetNamespace(ref.Namespace)
+		log.Info("delete input resource", "apiVersion", ref.APIVersion, "kind", ref.Kind, "name", ref.Name)
+		if err := r.choreo.GetClient().Delete(ctx, u, &resourceclient.DeleteOptions{
+			Branch: bctx.Branch,
+		}); err != nil && !grpcerrors.IsNotFound(err) {
+			errm = errors.Join(errm, err)
+		}
+	}
+	if errm != nil {
+		return nil, "", errm
+	}
+	if len(changes.deleted) > 0 {
+		if err := r.collectGarbage(ctx, bctx); err != nil {
+			return nil, "", err
+		}
+	}
+	r.setInput(digest)
+
+	gks := changes.groupKinds()
+	affected := sets.New[string]()
+	for _, runner := range r.getReconcilerRunners() {
+		affected.Insert(affectedReconcilers(runner.reconcilers, gks).UnsortedList()...)
+	}
+	return affected, "", nil
+}
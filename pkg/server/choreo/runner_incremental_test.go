@@ -0,0 +1,113 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/util/testhelper"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func testInputObject(kind, name, path, hash string) *inputObject {
+	return &inputObject{
+		Ref:  corev1.ObjectReference{APIVersion: "example.com/v1alpha1", Kind: kind, Name: name},
+		Path: path,
+		Hash: hash,
+	}
+}
+
+func TestIncremental(t *testing.T) {
+	reconcilers := []*choreov1alpha1.Reconciler{
+		testhelper.NewReconciler("interface", "Interface", []string{"IPClaim"}, nil),
+		testhelper.NewReconciler("ipclaim", "IPClaim", []string{"IPEntry"}, nil),
+		testhelper.NewReconciler("config", "Device", nil, []string{"IPEntry", "Interface"}),
+		testhelper.NewReconciler("node", "Node", nil, nil),
+	}
+	base := &inputDigest{
+		Input: []*inputObject{
+			testInputObject("Interface", "a", "in/interfaces.yaml", "1"),
+			testInputObject("Interface", "b", "in/interfaces.yaml", "2"),
+			testInputObject("Node", "a", "in/nodes.yaml", "3"),
+		},
+	}
+	cases := map[string]struct {
+		input            []*inputObject
+		expectedChanged  []string
+		expectedDeleted  []string
+		expectedFiles    []string
+		expectedAffected []string
+	}{
+		"NoChange": {
+			input:            base.Input,
+			expectedChanged:  []string{},
+			expectedDeleted:  []string{},
+			expectedFiles:    []string{},
+			expectedAffected: []string{},
+		},
+		"ChangedWithDependents": {
+			input: []*inputObject{
+				testInputObject("Interface", "a", "in/interfaces.yaml", "1"),
+				testInputObject("Interface", "b", "in/interfaces.yaml", "4"),
+				testInputObject("Node", "a", "in/nodes.yaml", "3"),
+			},
+			expectedChanged:  []string{"b"},
+			expectedDeleted:  []string{},
+			expectedFiles:    []string{"in/interfaces.yaml"},
+			expectedAffected: []string{"config", "interface", "ipclaim"},
+		},
+		"NewAndDeleted": {
+			input: []*inputObject{
+				testInputObject("Interface", "a", "in/interfaces.yaml", "1"),
+				testInputObject("Interface", "b", "in/interfaces.yaml", "2"),
+				testInputObject("Device", "a", "in/devices.yaml", "5"),
+			},
+			expectedChanged:  []string{"a"},
+			expectedDeleted:  []string{"a"},
+			expectedFiles:    []string{"in/devices.yaml", "in/nodes.yaml"},
+			expectedAffected: []string{"config", "node"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changes := diffInput(base, &inputDigest{Input: tc.input})
+			names := func(refs []corev1.ObjectReference) []string {
+				names := []string{}
+				for _, ref := range refs {
+					names = append(names, ref.Name)
+				}
+				return names
+			}
+			if diff := cmp.Diff(tc.expectedChanged, names(changes.changed)); diff != "" {
+				t.Errorf("unexpected changed objects (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedDeleted, names(changes.deleted)); diff != "" {
+				t.Errorf("unexpected deleted objects (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedFiles, sets.List(changes.files)); diff != "" {
+				t.Errorf("unexpected files (-want +got):\n%s", diff)
+			}
+			affected := affectedReconcilers(reconcilers, changes.groupKinds())
+			if diff := cmp.Diff(tc.expectedAffected, sets.List(affected)); diff != "" {
+				t.Errorf("unexpected affected reconcilers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
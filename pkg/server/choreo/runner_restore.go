@@ -0,0 +1,256 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/henderiw/logger/log"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/util/inventory"
+	"github.com/kform-dev/choreo/pkg/util/object"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Restore rewrites the resources of the branch to match the inventory of the snapshot.
+// Resources added since the snapshot are deleted, removed resources are re-created and
+// changed resources are reverted. The internal choreo apis (reconcilers, libraries, ...)
+// are not restored since they are reloaded from the repo on every run.
+func (r *run) Restore(ctx context.Context, bctx *BranchCtx, id string) error {
+	log := log.FromContext(ctx)
+	// block the runner while the branch is restored
+	if current, ok := r.setStatusAndCancelIfStopped(RunnerStatus_Once, nil); !ok {
+		return status.Errorf(codes.FailedPrecondition, "runner is running, status %s", current.String())
+	}
+	defer r.Stop()
+	snapshotInv, err := r.choreo.SnapshotManager().getInventory(id)
+	if err != nil {
+		return err
+	}
+
+	// the restored resources no longer match the loaded input
+	r.setInput(nil)
+
+	inv := inventory.Inventory{}
+	if err := inv.Build(ctx, r.choreo.GetClient(), bctx.APIStore.GetAPIResources(), &inventory.BuildOptions{
+		ShowManagedField: true,
+		Branch:           bctx.Branch,
+		ShowChoreoAPIs:   false,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "err: %s", err.Error())
+	}
+	// the resources are matched by reference without uid, since a re-created resource gets a new uid
+	current := getResources(inv)
+	snapshot := getResources(snapshotInv)
+
+	var errm error
+	// delete the resources that were added since the snapshot
+	for _, ref := range getSortedRefs(current) {
+		if _, ok := snapshot[ref]; ok {
+			continue
+		}
+		u := current[ref].DeepCopy()
+		log.Info("restore delete resource", "apiVersion", u.GetAPIVersion(), "kind", u.GetKind(), "name", u.GetName())
+		if len(u.GetFinalizers()) != 0 {
+			// the runner is blocked, so no reconciler removes the finalizers; without
+			// removing them the delete only sets the deletionTimestamp
+			u.SetFinalizers(nil)
+			if err := r.choreo.GetClient().Update(ctx, u, &resourceclient.UpdateOptions{
+				Branch: bctx.Branch,
+			}); err != nil {
+				errm = errors.Join(errm, fmt.Errorf("remove finalizers %s failed: %v", getRefString(ref), err))
+				continue
+			}
+			if u.GetDeletionTimestamp() != nil {
+				// the update without finalizers deleted the resource
+				continue
+			}
+		}
+		if err := r.choreo.GetClient().Delete(ctx, u, &resourceclient.DeleteOptions{
+			Branch: bctx.Branch,
+		}); err != nil {
+			errm = errors.Join(errm, fmt.Errorf("delete %s failed: %v", getRefString(ref), err))
+		}
+	}
+
+	// re-create the removed resources and revert the changed resources; the owners are restored
+	// before their dependents, such that the owner references of the dependents get the uid of a
+	// re-created owner; otherwise the garbage collector deletes the dependents of the gone owner
+	uids := map[types.UID]types.UID{}
+	statusEnabled := getStatusEnabled(bctx.APIStore)
+	for _, ref := range getRestoreOrder(snapshot) {
+		u := snapshot[ref].DeepCopy()
+		setOwnerUIDs(u, uids)
+		if cur, ok := current[ref]; ok {
+			// the resourceVersion needs to match the current resource to allow the update,
+			// the uid and generation are maintained by the server
+			u.SetUID(cur.GetUID())
+			u.SetResourceVersion(cur.GetResourceVersion())
+			u.SetGeneration(cur.GetGeneration())
+			if err := r.choreo.GetClient().Update(ctx, u, &resourceclient.UpdateOptions{
+				Branch: bctx.Branch,
+			}); err != nil {
+				errm = errors.Join(errm, fmt.Errorf("update %s failed: %v", getRefString(ref), err))
+				continue
+			}
+		} else {
+			log.Info("restore create resource", "apiVersion", u.GetAPIVersion(), "kind", u.GetKind(), "name", u.GetName())
+			u = getRestoreObject(u)
+			if err := r.choreo.GetClient().Create(ctx, u, &resourceclient.CreateOptions{
+				Branch: bctx.Branch,
+			}); err != nil {
+				errm = errors.Join(errm, fmt.Errorf("create %s failed: %v", getRefString(ref), err))
+				continue
+			}
+		}
+		uids[snapshot[ref].GetUID()] = u.GetUID()
+		if statusEnabled.Has(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).GroupKind()) {
+			if err := r.restoreStatus(ctx, bctx, u, snapshot[ref]); err != nil {
+				errm = errors.Join(errm, fmt.Errorf("update status %s failed: %v", getRefString(ref), err))
+			}
+		}
+	}
+	return errm
+}
+
+// restoreStatus reverts the status of the restored resource to the status of the snapshot; with
+// the status subresource a create drops the status and an update retains the current status
+func (r *run) restoreStatus(ctx context.Context, bctx *BranchCtx, u, snapshot *unstructured.Unstructured) error {
+	u = u.DeepCopy()
+	if status, ok := snapshot.Object["status"]; ok {
+		u.Object["status"] = runtime.DeepCopyJSONValue(status)
+	} else {
+		delete(u.Object, "status")
+	}
+	return r.choreo.GetClient().UpdateStatus(ctx, u, &resourceclient.UpdateOptions{
+		Branch: bctx.Branch,
+	})
+}
+
+// getStatusEnabled returns the resources of the branch with the status subresource enabled
+func getStatusEnabled(apiStore *api.APIStore) sets.Set[schema.GroupKind] {
+	gks := sets.New[schema.GroupKind]()
+	apiStore.List(func(_ store.Key, rctx *api.ResourceContext) {
+		if rctx.StatusEnabled() {
+			gks.Insert(rctx.GV())
+		}
+	})
+	return gks
+}
+
+// getRestoreObject returns the resource of the snapshot without the metadata
+// that is set by the server, such that it can be re-created
+func getRestoreObject(u *unstructured.Unstructured) *unstructured.Unstructured {
+	u = u.DeepCopy()
+	u.SetUID("")
+	u.SetResourceVersion("")
+	u.SetGeneration(0)
+	u.SetCreationTimestamp(metav1.Time{})
+	u.SetDeletionTimestamp(nil)
+	u.SetDeletionGracePeriodSeconds(nil)
+	u.SetManagedFields(nil)
+	return u
+}
+
+// getResources returns the resources of the inventory by their reference without uid; the choreo
+// apis and the owners that are referenced but not present in the inventory are skipped
+func getResources(inv inventory.Inventory) map[corev1.ObjectReference]*unstructured.Unstructured {
+	resources := make(map[corev1.ObjectReference]*unstructured.Unstructured, len(inv))
+	for ref, node := range inv {
+		if node.Resource == nil || node.ChoreoAPI {
+			continue
+		}
+		ref.UID = ""
+		resources[ref] = node.Resource
+	}
+	return resources
+}
+
+// getSortedRefs returns the references of the resources in a stable order
+func getSortedRefs(resources map[corev1.ObjectReference]*unstructured.Unstructured) []corev1.ObjectReference {
+	refs := make([]corev1.ObjectReference, 0, len(resources))
+	for ref := range resources {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return getRefString(refs[i]) < getRefString(refs[j])
+	})
+	return refs
+}
+
+// getRestoreOrder returns the references of the resources with the owners before their
+// dependents and in a stable order otherwise. Since owner references don't carry a namespace,
+// the owner is looked up in the namespace of the resource first and as a cluster scoped
+// resource otherwise, like the inventory links the owners.
+func getRestoreOrder(resources map[corev1.ObjectReference]*unstructured.Unstructured) []corev1.ObjectReference {
+	refs := make([]corev1.ObjectReference, 0, len(resources))
+	visited := sets.New[corev1.ObjectReference]()
+	var visit func(ref corev1.ObjectReference)
+	visit = func(ref corev1.ObjectReference) {
+		if visited.Has(ref) {
+			return
+		}
+		visited.Insert(ref)
+		u := resources[ref]
+		for _, ownerRef := range u.GetOwnerReferences() {
+			ownerRef.UID = ""
+			for _, namespace := range []string{u.GetNamespace(), ""} {
+				ownerObjRef := object.GetObjectRefFromOwnerRef(namespace, ownerRef)
+				if _, ok := resources[ownerObjRef]; ok {
+					visit(ownerObjRef)
+					break
+				}
+			}
+		}
+		refs = append(refs, ref)
+	}
+	for _, ref := range getSortedRefs(resources) {
+		visit(ref)
+	}
+	return refs
+}
+
+// setOwnerUIDs replaces the uids of the owner references with the uid of the restored owner
+func setOwnerUIDs(u *unstructured.Unstructured, uids map[types.UID]types.UID) {
+	ownerRefs := u.GetOwnerReferences()
+	if len(ownerRefs) == 0 {
+		return
+	}
+	for i, ownerRef := range ownerRefs {
+		if uid, ok := uids[ownerRef.UID]; ok {
+			ownerRefs[i].UID = uid
+		}
+	}
+	u.SetOwnerReferences(ownerRefs)
+}
+
+func getRefString(ref corev1.ObjectReference) string {
+	return fmt.Sprintf("%s.%s", schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).GroupKind().String(), ref.Name)
+}
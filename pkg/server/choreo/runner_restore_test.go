@@ -0,0 +1,325 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kform-dev/choreo/pkg/client/go/resourceclient"
+	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/garbagecollector"
+	"github.com/kform-dev/choreo/pkg/server/choreo/crdloader"
+	"github.com/kform-dev/choreo/pkg/util/inventory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetRestoreObject(t *testing.T) {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("example.com/v1alpha1")
+	u.SetKind("Dummy")
+	u.SetNamespace("default")
+	u.SetName("a")
+	u.SetLabels(map[string]string{"app": "a"})
+	u.SetFinalizers([]string{"example.com/finalizer"})
+	unstructured.SetNestedField(u.Object, "x", "spec", "index")
+
+	expected := u.DeepCopy()
+
+	now := metav1.Now()
+	u.SetUID("1234")
+	u.SetResourceVersion("3")
+	u.SetGeneration(2)
+	u.SetCreationTimestamp(now)
+	u.SetDeletionTimestamp(&now)
+	u.SetDeletionGracePeriodSeconds(ptr.To[int64](0))
+	u.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "inputfileloader", Operation: metav1.ManagedFieldsOperationApply}})
+
+	got := getRestoreObject(u)
+	if diff := cmp.Diff(expected.Object, got.Object); diff != "" {
+		t.Errorf("-want, +got:\n%s", diff)
+	}
+	if u.GetUID() != "1234" {
+		t.Errorf("want the snapshot resource untouched")
+	}
+}
+
+func TestRestoreRunning(t *testing.T) {
+	cases := map[string]struct {
+		status RunnerStatus
+	}{
+		"Running": {status: RunnerStatus_Running},
+		"Once":    {status: RunnerStatus_Once},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &run{status: tc.status}
+			err := r.Restore(context.Background(), &BranchCtx{Branch: "main"}, "snapshot")
+			if status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("want code %s, got %v", codes.FailedPrecondition, err)
+			}
+			if r.Status() != tc.status {
+				t.Errorf("want status %s, got %s", tc.status, r.Status())
+			}
+		})
+	}
+}
+
+// testRestoreChoreo provides the client and the snapshots to the restore
+type testRestoreChoreo struct {
+	Choreo
+	client      resourceclient.Client
+	snapshotMgr *SnapshotManager
+}
+
+func (r *testRestoreChoreo) GetClient() resourceclient.Client  { return r.client }
+func (r *testRestoreChoreo) SnapshotManager() *SnapshotManager { return r.snapshotMgr }
+
+func testRestoreCRD(kind, plural string) *apiextensionsv1.CustomResourceDefinition {
+	object := apiextensionsv1.JSONSchemaProps{Type: "object", XPreserveUnknownFields: ptr.To(true)}
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: plural + ".example.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   plural,
+				Kind:     kind,
+				ListKind: kind + "List",
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+				Name:    "v1alpha1",
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"apiVersion": {Type: "string"},
+							"kind":       {Type: "string"},
+							"metadata":   {Type: "object"},
+							"spec":       object,
+							"status":     object,
+						},
+					},
+				},
+				Subresources: &apiextensionsv1.CustomResourceSubresources{
+					Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+				},
+			}},
+		},
+	}
+}
+
+func testRestoreObject(kind, name string, owner *unstructured.Unstructured) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1alpha1",
+		"kind":       kind,
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": "default",
+		},
+		"spec": map[string]any{"value": "a"},
+	}}
+	if owner != nil {
+		u.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: owner.GetAPIVersion(),
+			Kind:       owner.GetKind(),
+			Name:       owner.GetName(),
+			UID:        owner.GetUID(),
+		}})
+	}
+	return u
+}
+
+// newTestRestoreRunner returns a runner that restores the topologies and the nodes they own
+// in storages with the garbage collector running
+func newTestRestoreRunner(t *testing.T, ctx context.Context) (*run, *BranchCtx, resourceclient.Client) {
+	t.Helper()
+	apiStore := api.NewAPIStore()
+	for kind, plural := range map[string]string{"Topology": "topologies", "Node": "nodes"} {
+		rctx, err := crdloader.LoadCRD(ctx, nil, testRestoreCRD(kind, plural), nil, false)
+		if err != nil {
+			t.Fatalf("load crd failed: %v", err)
+		}
+		if err := apiStore.Apply(rctx.GV(), rctx); err != nil {
+			t.Fatalf("apply api failed: %v", err)
+		}
+	}
+	gc := garbagecollector.New(apiStore)
+	gc.Start(ctx)
+	t.Cleanup(gc.Stop)
+
+	client := resourceclient.NewAPIStorageClient(apiStore)
+	r := &run{choreo: &testRestoreChoreo{client: client, snapshotMgr: NewSnapshotManager()}}
+	return r, &BranchCtx{Branch: "main", APIStore: apiStore, GarbageCollector: gc}, client
+}
+
+// createSnapshot stores the inventory of the branch as snapshot
+func createSnapshot(t *testing.T, ctx context.Context, r *run, bctx *BranchCtx, client resourceclient.Client, id string) {
+	t.Helper()
+	inv := inventory.Inventory{}
+	if err := inv.Build(ctx, client, bctx.APIStore.GetAPIResources(), &inventory.BuildOptions{
+		ShowManagedField: true,
+		Branch:           bctx.Branch,
+	}); err != nil {
+		t.Fatalf("build inventory failed: %v", err)
+	}
+	if err := r.choreo.SnapshotManager().Create(id, nil, inv, nil); err != nil {
+		t.Fatalf("create snapshot failed: %v", err)
+	}
+}
+
+func getTestObject(t *testing.T, ctx context.Context, client resourceclient.Client, kind, name string) (*unstructured.Unstructured, error) {
+	t.Helper()
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("example.com/v1alpha1")
+	u.SetKind(kind)
+	err := client.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, u, &resourceclient.GetOptions{ShowManagedFields: true})
+	return u, err
+}
+
+func TestRestore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, bctx, client := newTestRestoreRunner(t, ctx)
+	create := func(u *unstructured.Unstructured) *unstructured.Unstructured {
+		if err := client.Create(ctx, u, &resourceclient.CreateOptions{Branch: bctx.Branch}); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+		return u
+	}
+	topo := create(testRestoreObject("Topology", "topo", nil))
+	node := create(testRestoreObject("Node", "node", topo))
+	changed := create(testRestoreObject("Topology", "changed", nil))
+	createSnapshot(t, ctx, r, bctx, client, "snapshot")
+
+	// the owner and its dependent are deleted, a resource is changed and another one is added
+	for _, u := range []*unstructured.Unstructured{node, topo} {
+		if err := client.Delete(ctx, u, &resourceclient.DeleteOptions{Branch: bctx.Branch}); err != nil {
+			t.Fatalf("delete failed: %v", err)
+		}
+	}
+	// the internal client shares the objects with the storage
+	changed = changed.DeepCopy()
+	if err := unstructured.SetNestedField(changed.Object, "b", "spec", "value"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Update(ctx, changed, &resourceclient.UpdateOptions{Branch: bctx.Branch}); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	create(testRestoreObject("Topology", "added", nil))
+
+	if err := r.Restore(ctx, bctx, "snapshot"); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if r.Status() != RunnerStatus_Stopped {
+		t.Errorf("want status %s, got %s", RunnerStatus_Stopped, r.Status())
+	}
+
+	// the garbage collector deletes the dependents of the deleted owner
+	if err := bctx.GarbageCollector.DeleteDependents(ctx, garbagecollector.OwnerFromObject(schema.GroupKind{Group: "example.com", Kind: "Topology"}, topo)); err != nil {
+		t.Fatalf("delete dependents failed: %v", err)
+	}
+
+	restoredTopo, err := getTestObject(t, ctx, client, "Topology", "topo")
+	if err != nil {
+		t.Fatalf("want the owner re-created, got %v", err)
+	}
+	restoredNode, err := getTestObject(t, ctx, client, "Node", "node")
+	if err != nil {
+		t.Fatalf("want the dependent re-created and retained, got %v", err)
+	}
+	if refs := restoredNode.GetOwnerReferences(); len(refs) != 1 || refs[0].UID != restoredTopo.GetUID() {
+		t.Errorf("want the owner reference with uid %s, got %v", restoredTopo.GetUID(), refs)
+	}
+	restoredChanged, err := getTestObject(t, ctx, client, "Topology", "changed")
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if value, _, _ := unstructured.NestedString(restoredChanged.Object, "spec", "value"); value != "a" {
+		t.Errorf("want the changed resource reverted, got spec.value %q", value)
+	}
+	if _, err := getTestObject(t, ctx, client, "Topology", "added"); status.Code(err) != codes.NotFound {
+		t.Errorf("want the added resource deleted, got %v", err)
+	}
+}
+
+func TestRestoreStatus(t *testing.T) {
+	cases := map[string]struct {
+		// changeFn changes the resource after the snapshot
+		changeFn func(ctx context.Context, client resourceclient.Client, u *unstructured.Unstructured) error
+	}{
+		"Removed": {
+			changeFn: func(ctx context.Context, client resourceclient.Client, u *unstructured.Unstructured) error {
+				return client.Delete(ctx, u, &resourceclient.DeleteOptions{Branch: "main"})
+			},
+		},
+		"Changed": {
+			changeFn: func(ctx context.Context, client resourceclient.Client, u *unstructured.Unstructured) error {
+				u = u.DeepCopy()
+				if err := unstructured.SetNestedField(u.Object, false, "status", "ready"); err != nil {
+					return err
+				}
+				return client.UpdateStatus(ctx, u, &resourceclient.UpdateOptions{Branch: "main"})
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			r, bctx, client := newTestRestoreRunner(t, ctx)
+			topo := testRestoreObject("Topology", "topo", nil)
+			if err := client.Create(ctx, topo, &resourceclient.CreateOptions{Branch: bctx.Branch}); err != nil {
+				t.Fatalf("create failed: %v", err)
+			}
+			topo = topo.DeepCopy()
+			if err := unstructured.SetNestedField(topo.Object, true, "status", "ready"); err != nil {
+				t.Fatal(err)
+			}
+			if err := client.UpdateStatus(ctx, topo, &resourceclient.UpdateOptions{Branch: bctx.Branch}); err != nil {
+				t.Fatalf("update status failed: %v", err)
+			}
+			createSnapshot(t, ctx, r, bctx, client, "snapshot")
+
+			if err := tc.changeFn(ctx, client, topo); err != nil {
+				t.Fatalf("change failed: %v", err)
+			}
+			if err := r.Restore(ctx, bctx, "snapshot"); err != nil {
+				t.Fatalf("restore failed: %v", err)
+			}
+			restored, err := getTestObject(t, ctx, client, "Topology", "topo")
+			if err != nil {
+				t.Fatalf("get failed: %v", err)
+			}
+			if ready, _, _ := unstructured.NestedBool(restored.Object, "status", "ready"); !ready {
+				t.Errorf("want the status of the snapshot, got %v", restored.Object["status"])
+			}
+		})
+	}
+}
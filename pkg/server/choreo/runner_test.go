@@ -0,0 +1,102 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"github.com/kform-dev/choreo/pkg/server/choreo/instance"
+)
+
+func TestOnceStream(t *testing.T) {
+	cases := map[string]struct {
+		receive bool
+	}{
+		"Received": {
+			receive: true,
+		},
+		"ClientGone": {
+			receive: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			oncersp := newOnceStream(ctx)
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				oncersp.send(&runnerpb.Once_Response{Type: runnerpb.Once_COMPLETED})
+			}()
+			if tc.receive {
+				rsp := <-oncersp.ch
+				if rsp.Type != runnerpb.Once_COMPLETED {
+					t.Errorf("want %s, got %s", runnerpb.Once_COMPLETED, rsp.Type)
+				}
+			}
+			cancel()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatalf("send did not return after the ctx is done")
+			}
+			oncersp.close()
+			// a late sender must not panic on the closed channel
+			oncersp.send(&runnerpb.Once_Response{Type: runnerpb.Once_TRACE})
+		})
+	}
+}
+
+type testBranchInstance struct {
+	instance.ChoreoInstance
+	destroyed bool
+}
+
+func (r *testBranchInstance) Destroy() error {
+	r.destroyed = true
+	return nil
+}
+
+func TestRunnerClose(t *testing.T) {
+	branchInstance := &testBranchInstance{}
+	r := &run{
+		status:   RunnerStatus_Running,
+		instance: branchInstance,
+		input:    &inputDigest{Branch: "dev"},
+	}
+	if !r.IsBranchLoaded() {
+		t.Fatalf("want branch loaded")
+	}
+	r.Close()
+	if !branchInstance.destroyed {
+		t.Errorf("want the branch instance destroyed")
+	}
+	if r.IsBranchLoaded() {
+		t.Errorf("want branch not loaded after close")
+	}
+	if r.Status() != RunnerStatus_Stopped {
+		t.Errorf("want status %s, got %s", RunnerStatus_Stopped, r.Status())
+	}
+	if r.getInput() != nil {
+		t.Errorf("want no loaded input after close")
+	}
+}
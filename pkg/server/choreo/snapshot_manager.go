@@ -18,10 +18,13 @@ package choreo
 
 import (
 	"encoding/json"
+	"errors"
+	"os"
 	"sync"
 	"time"
 
 	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
 	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/kform-dev/choreo/pkg/proto/snapshotpb"
@@ -47,6 +50,10 @@ type SnapshotManager struct {
 	head      *SnapshotNode
 	tail      *SnapshotNode
 	snapshots map[string]*SnapshotNode
+	// path is the directory where the snapshots are persisted
+	// when empty the snapshots are only kept in memory
+	path   string
+	policy *SnapshotRetentionPolicy
 }
 
 type SnapshotNode struct {
@@ -58,10 +65,63 @@ type SnapshotNode struct {
 type Snapshot struct {
 	ID           string
 	CreatedAt    time.Time
+	Tag          string
 	APIResources []*discoverypb.APIResource
-	//Input
-	Inventory   inventory.Inventory
-	RunResponse *runnerpb.Once_Response_RunResponse
+	Inventory    inventory.Inventory
+	RunResponse  *runnerpb.Once_Response_RunResponse
+}
+
+// SnapshotRetentionPolicy defines which snapshots are pruned.
+// The latest snapshot is always retained.
+type SnapshotRetentionPolicy struct {
+	// MaxCount defines the maximum amount of snapshots that are retained, 0 means unlimited
+	MaxCount int
+	// MaxAge defines the maximum age of the snapshots that are retained, 0 means unlimited
+	MaxAge time.Duration
+	// KeepTagged retains tagged snapshots irrespective of the MaxCount and MaxAge
+	KeepTagged bool
+}
+
+func NewSnapshotRetentionPolicy(flags *genericclioptions.ServerFlags) *SnapshotRetentionPolicy {
+	policy := &SnapshotRetentionPolicy{}
+	if flags == nil {
+		return policy
+	}
+	if flags.SnapshotMaxCount != nil {
+		policy.MaxCount = *flags.SnapshotMaxCount
+	}
+	if flags.SnapshotMaxAge != nil {
+		policy.MaxAge = *flags.SnapshotMaxAge
+	}
+	if flags.SnapshotKeepTagged != nil {
+		policy.KeepTagged = *flags.SnapshotKeepTagged
+	}
+	return policy
+}
+
+// Init (re)initializes the snapshot manager with the snapshots persisted in path
+// and prunes them according to the retention policy.
+func (r *SnapshotManager) Init(path string, policy *SnapshotRetentionPolicy) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.head = nil
+	r.tail = nil
+	r.snapshots = map[string]*SnapshotNode{}
+	r.path = path
+	r.policy = policy
+
+	if r.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(r.path, 0755); err != nil {
+		return err
+	}
+	snapshots, errm := readSnapshots(r.path)
+	for _, snapshot := range snapshots {
+		r.add(snapshot)
+	}
+	return errors.Join(errm, r.prune())
 }
 
 func (r *SnapshotManager) getLatest() (*SnapshotNode, bool) {
@@ -74,32 +134,43 @@ func (r *SnapshotManager) getLatest() (*SnapshotNode, bool) {
 	return r.tail, true
 }
 
-func (r *SnapshotManager) getPrevious(id string) (*SnapshotNode, bool) {
+func (r *SnapshotManager) getInventory(id string) (inventory.Inventory, error) {
 	r.m.RLock()
 	defer r.m.RUnlock()
 
 	snapshotNode, found := r.snapshots[id]
 	if !found {
-		return nil, false
+		return nil, status.Errorf(codes.NotFound, "snapshot %s not found", id)
 	}
-	if snapshotNode.prev == nil {
-		return nil, false
-	}
-	return snapshotNode.prev, true
+	return snapshotNode.snapshot.Inventory, nil
 }
 
-func (r *SnapshotManager) Create(id string, apiResources []*discoverypb.APIResource, inventory inventory.Inventory, rsp *runnerpb.Once_Response_RunResponse) {
+func (r *SnapshotManager) Create(id string, apiResources []*discoverypb.APIResource, inventory inventory.Inventory, rsp *runnerpb.Once_Response_RunResponse) error {
 	r.m.Lock()
 	defer r.m.Unlock()
 
+	snapshot := &Snapshot{
+		ID:           id,
+		CreatedAt:    time.Now(),
+		APIResources: apiResources,
+		Inventory:    inventory,
+		RunResponse:  rsp,
+	}
+	r.add(snapshot)
+
+	var errm error
+	if r.path != "" {
+		if err := writeSnapshot(r.path, snapshot); err != nil {
+			errm = errors.Join(errm, err)
+		}
+	}
+	return errors.Join(errm, r.prune())
+}
+
+// add appends the snapshot at the tail of the list, the lock should be held by the caller
+func (r *SnapshotManager) add(snapshot *Snapshot) {
 	node := &SnapshotNode{
-		snapshot: &Snapshot{
-			ID:           id,
-			CreatedAt:    time.Now(),
-			APIResources: apiResources,
-			Inventory:    inventory,
-			RunResponse:  rsp,
-		},
+		snapshot: snapshot,
 	}
 
 	if r.head == nil {
@@ -120,10 +191,18 @@ func (r *SnapshotManager) Delete(req *snapshotpb.Delete_Request) (*snapshotpb.De
 	r.m.Lock()
 	defer r.m.Unlock()
 
-	node, ok := r.snapshots[req.Id]
+	if err := r.delete(req.Id); err != nil {
+		return &snapshotpb.Delete_Response{}, status.Errorf(codes.Internal, "cannot delete snapshot err: %s", err.Error())
+	}
+	return &snapshotpb.Delete_Response{}, nil
+}
+
+// delete removes the snapshot from the list and from disk, the lock should be held by the caller
+func (r *SnapshotManager) delete(id string) error {
+	node, ok := r.snapshots[id]
 	if !ok {
 		// id does not exists
-		return &snapshotpb.Delete_Response{}, nil
+		return nil
 	}
 
 	// Update pointers in the linked list
@@ -141,8 +220,68 @@ func (r *SnapshotManager) Delete(req *snapshotpb.Delete_Request) (*snapshotpb.De
 	}
 
 	// Remove from lookup map
-	delete(r.snapshots, req.Id)
-	return &snapshotpb.Delete_Response{}, nil
+	delete(r.snapshots, id)
+
+	if r.path == "" {
+		return nil
+	}
+	return removeSnapshot(r.path, id)
+}
+
+// prune deletes the snapshots that violate the retention policy, starting
+// with the oldest ones. The lock should be held by the caller.
+func (r *SnapshotManager) prune() error {
+	if r.policy == nil {
+		return nil
+	}
+	var errm error
+	if r.policy.MaxAge > 0 {
+		now := time.Now()
+		for node := r.head; node != nil; {
+			next := node.next
+			if r.isPrunable(node) && now.Sub(node.snapshot.CreatedAt) > r.policy.MaxAge {
+				errm = errors.Join(errm, r.delete(node.snapshot.ID))
+			}
+			node = next
+		}
+	}
+	if r.policy.MaxCount > 0 {
+		for node := r.head; node != nil && len(r.snapshots) > r.policy.MaxCount; {
+			next := node.next
+			if r.isPrunable(node) {
+				errm = errors.Join(errm, r.delete(node.snapshot.ID))
+			}
+			node = next
+		}
+	}
+	return errm
+}
+
+func (r *SnapshotManager) isPrunable(node *SnapshotNode) bool {
+	if node == r.tail {
+		return false
+	}
+	if r.policy.KeepTagged && node.snapshot.Tag != "" {
+		return false
+	}
+	return true
+}
+
+func (r *SnapshotManager) Tag(req *snapshotpb.Tag_Request) (*snapshotpb.Tag_Response, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	snapshotNode, found := r.snapshots[req.Id]
+	if !found {
+		return &snapshotpb.Tag_Response{}, status.Error(codes.NotFound, "id not found")
+	}
+	snapshotNode.snapshot.Tag = req.Tag
+	if r.path != "" {
+		if err := writeSnapshot(r.path, snapshotNode.snapshot); err != nil {
+			return &snapshotpb.Tag_Response{}, status.Errorf(codes.Internal, "cannot persist snapshot err: %s", err.Error())
+		}
+	}
+	return &snapshotpb.Tag_Response{}, nil
 }
 
 func (r *SnapshotManager) Get(req *snapshotpb.Get_Request) (*snapshotpb.Get_Response, error) {
@@ -154,16 +293,7 @@ func (r *SnapshotManager) Get(req *snapshotpb.Get_Request) (*snapshotpb.Get_Resp
 		return &snapshotpb.Get_Response{}, status.Error(codes.NotFound, "id not found")
 	}
 
-	u := &unstructured.Unstructured{}
-	u.SetAPIVersion(choreov1alpha1.SchemeGroupVersion.Identifier())
-	u.SetKind(choreov1alpha1.SnapshotListKind)
-	u.SetKind(choreov1alpha1.SnapshotKind)
-	u.SetUID(types.UID(snapshotNode.snapshot.ID))
-	u.SetCreationTimestamp(metav1.NewTime(snapshotNode.snapshot.CreatedAt))
-	u.SetName(snapshotNode.snapshot.ID)
-	u.SetResourceVersion("0")
-
-	b, err := json.Marshal(u)
+	b, err := json.Marshal(buildSnapshotObject(snapshotNode.snapshot))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "cannot marshal err: %s", err.Error())
 	}
@@ -187,15 +317,7 @@ func (r *SnapshotManager) List(req *snapshotpb.List_Request) (*snapshotpb.List_R
 
 	current := r.head
 	for current != nil {
-		u := &unstructured.Unstructured{}
-		u.SetAPIVersion(choreov1alpha1.SchemeGroupVersion.Identifier())
-		u.SetKind(choreov1alpha1.SnapshotKind)
-		u.SetUID(types.UID(current.snapshot.ID))
-		u.SetCreationTimestamp(metav1.NewTime(current.snapshot.CreatedAt))
-		u.SetName(current.snapshot.ID)
-		u.SetResourceVersion("0")
-
-		object.AppendItem(v, u)
+		object.AppendItem(v, buildSnapshotObject(current.snapshot))
 		current = current.next // continue
 	}
 
@@ -209,23 +331,37 @@ func (r *SnapshotManager) List(req *snapshotpb.List_Request) (*snapshotpb.List_R
 	}, nil
 }
 
+func buildSnapshotObject(snapshot *Snapshot) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(choreov1alpha1.SchemeGroupVersion.Identifier())
+	u.SetKind(choreov1alpha1.SnapshotKind)
+	u.SetUID(types.UID(snapshot.ID))
+	u.SetCreationTimestamp(metav1.NewTime(snapshot.CreatedAt))
+	u.SetName(snapshot.ID)
+	u.SetResourceVersion("0")
+	if snapshot.Tag != "" {
+		u.SetLabels(map[string]string{
+			choreov1alpha1.ChoreoSnapshotTagKey: snapshot.Tag,
+		})
+	}
+	return u
+}
+
 func (r *SnapshotManager) Diff(req *snapshotpb.Diff_Request) (*snapshotpb.Diff_Response, error) {
-	latestSnapshot, found := r.getLatest()
-	if !found {
-		return &snapshotpb.Diff_Response{}, status.Errorf(codes.NotFound, "latest snapshot not available")
+	opts := req.Options
+	if opts == nil {
+		opts = &snapshotpb.Diff_Options{}
 	}
-	latestInventory := latestSnapshot.snapshot.Inventory
-	previousSnapshotNode, found := r.getPrevious(latestSnapshot.snapshot.ID)
-	previousInventory := inventory.Inventory{} // create an empty inventory
-	if found {
-		previousInventory = previousSnapshotNode.snapshot.Inventory
+	fromInventory, toInventory, err := r.getDiffInventories(opts.From, opts.To)
+	if err != nil {
+		return &snapshotpb.Diff_Response{}, err
 	}
 
 	diff := choreov1alpha1.BuildDiff(metav1.ObjectMeta{
 		Name:      "diff",
 		Namespace: "default",
 	}, nil, nil)
-	if err := latestInventory.Diff(previousInventory, diff, req.Options); err != nil {
+	if err := toInventory.Diff(fromInventory, diff, opts); err != nil {
 		return &snapshotpb.Diff_Response{}, err
 	}
 
@@ -239,6 +375,49 @@ func (r *SnapshotManager) Diff(req *snapshotpb.Diff_Request) (*snapshotpb.Diff_R
 	}, nil
 }
 
+// getDiffInventories resolves the inventories of the from and to snapshots.
+// When to is not specified the latest snapshot is used, when from is not specified
+// the predecessor of the to snapshot is used or the empty inventory if there is none.
+func (r *SnapshotManager) getDiffInventories(from, to string) (inventory.Inventory, inventory.Inventory, error) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	var toNode *SnapshotNode
+	toInventory := inventory.Inventory{} // create an empty inventory
+	switch to {
+	case "":
+		if r.tail == nil {
+			return nil, nil, status.Errorf(codes.NotFound, "latest snapshot not available")
+		}
+		toNode = r.tail
+		toInventory = toNode.snapshot.Inventory
+	case choreov1alpha1.SnapshotEmptyID:
+	default:
+		node, found := r.snapshots[to]
+		if !found {
+			return nil, nil, status.Errorf(codes.NotFound, "snapshot %s not found", to)
+		}
+		toNode = node
+		toInventory = toNode.snapshot.Inventory
+	}
+
+	fromInventory := inventory.Inventory{} // create an empty inventory
+	switch from {
+	case "":
+		if toNode != nil && toNode.prev != nil {
+			fromInventory = toNode.prev.snapshot.Inventory
+		}
+	case choreov1alpha1.SnapshotEmptyID:
+	default:
+		node, found := r.snapshots[from]
+		if !found {
+			return nil, nil, status.Errorf(codes.NotFound, "snapshot %s not found", from)
+		}
+		fromInventory = node.snapshot.Inventory
+	}
+	return fromInventory, toInventory, nil
+}
+
 func (r *SnapshotManager) Result(_ *snapshotpb.Result_Request) (*snapshotpb.Result_Response, error) {
 	latestSnapshot, found := r.getLatest()
 	if !found {
@@ -0,0 +1,232 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"github.com/kform-dev/choreo/pkg/proto/snapshotpb"
+	"github.com/kform-dev/choreo/pkg/util/inventory"
+)
+
+func testInventory(t *testing.T, names ...string) inventory.Inventory {
+	items := []string{}
+	for _, name := range names {
+		items = append(items, fmt.Sprintf(`{"resource":{"apiVersion":"example.com/v1alpha1","kind":"Dummy","metadata":{"name":%q,"namespace":"default"}}}`, name))
+	}
+	inv := inventory.Inventory{}
+	if err := inv.UnmarshalJSON([]byte("[" + strings.Join(items, ",") + "]")); err != nil {
+		t.Fatalf("cannot build inventory: %v", err)
+	}
+	return inv
+}
+
+func TestSnapshotManagerPersistence(t *testing.T) {
+	dir := t.TempDir()
+
+	mgr := NewSnapshotManager()
+	if err := mgr.Init(dir, &SnapshotRetentionPolicy{}); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	rsp := &runnerpb.Once_Response_RunResponse{
+		RunResponse: &runnerpb.Once_RunResponse{Success: true},
+	}
+	if err := mgr.Create("a", nil, testInventory(t, "x", "y"), rsp); err != nil {
+		t.Fatalf("unexpected create error: %v", err)
+	}
+	if _, err := mgr.Tag(&snapshotpb.Tag_Request{Id: "a", Tag: "v1"}); err != nil {
+		t.Fatalf("unexpected tag error: %v", err)
+	}
+
+	// simulate a restart
+	restored := NewSnapshotManager()
+	if err := restored.Init(dir, &SnapshotRetentionPolicy{}); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	node, ok := restored.getLatest()
+	if !ok {
+		t.Fatalf("expected a restored snapshot")
+	}
+	if node.snapshot.ID != "a" || node.snapshot.Tag != "v1" {
+		t.Errorf("expected snapshot a with tag v1, got %s with tag %s", node.snapshot.ID, node.snapshot.Tag)
+	}
+	if len(node.snapshot.Inventory) != 2 {
+		t.Errorf("expected 2 inventory entries, got %d", len(node.snapshot.Inventory))
+	}
+	if node.snapshot.Inventory.GetResource("example.com/v1alpha1", "Dummy", "x", "default") == nil {
+		t.Errorf("expected resource x in the restored inventory")
+	}
+	if !node.snapshot.RunResponse.RunResponse.Success {
+		t.Errorf("expected the run response to be restored")
+	}
+}
+
+func TestSnapshotManagerPrune(t *testing.T) {
+	cases := map[string]struct {
+		policy   *SnapshotRetentionPolicy
+		tagged   map[string]string
+		age      time.Duration
+		expected []string
+	}{
+		"Unlimited": {
+			policy:   &SnapshotRetentionPolicy{},
+			expected: []string{"s0", "s1", "s2", "s3"},
+		},
+		"MaxCount": {
+			policy:   &SnapshotRetentionPolicy{MaxCount: 2},
+			expected: []string{"s2", "s3"},
+		},
+		"MaxCountKeepTagged": {
+			policy:   &SnapshotRetentionPolicy{MaxCount: 2, KeepTagged: true},
+			tagged:   map[string]string{"s0": "keep"},
+			expected: []string{"s0", "s3"},
+		},
+		"MaxCountIgnoreTagged": {
+			policy:   &SnapshotRetentionPolicy{MaxCount: 2, KeepTagged: false},
+			tagged:   map[string]string{"s0": "keep"},
+			expected: []string{"s2", "s3"},
+		},
+		"MaxAgeKeepsLatest": {
+			policy:   &SnapshotRetentionPolicy{MaxAge: time.Hour},
+			age:      2 * time.Hour,
+			expected: []string{"s3"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			mgr := NewSnapshotManager()
+			if err := mgr.Init(dir, &SnapshotRetentionPolicy{}); err != nil {
+				t.Fatalf("unexpected init error: %v", err)
+			}
+			for i := 0; i < 4; i++ {
+				id := fmt.Sprintf("s%d", i)
+				snapshot := &Snapshot{
+					ID:        id,
+					CreatedAt: time.Now().Add(-tc.age).Add(time.Duration(i) * time.Second),
+					Tag:       tc.tagged[id],
+					Inventory: inventory.Inventory{},
+				}
+				if err := writeSnapshot(dir, snapshot); err != nil {
+					t.Fatalf("unexpected write error: %v", err)
+				}
+			}
+			if err := mgr.Init(dir, tc.policy); err != nil {
+				t.Fatalf("unexpected init error: %v", err)
+			}
+
+			got := []string{}
+			for node := mgr.head; node != nil; node = node.next {
+				got = append(got, node.snapshot.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.expected) {
+				t.Errorf("expected snapshots %v, got %v", tc.expected, got)
+			}
+			// the pruned snapshots should be removed from disk as well
+			snapshots, err := readSnapshots(dir)
+			if err != nil {
+				t.Fatalf("unexpected read error: %v", err)
+			}
+			if len(snapshots) != len(tc.expected) {
+				t.Errorf("expected %d snapshots on disk, got %d", len(tc.expected), len(snapshots))
+			}
+		})
+	}
+}
+
+func TestSnapshotManagerDiff(t *testing.T) {
+	mgr := NewSnapshotManager()
+	if err := mgr.Init(t.TempDir(), &SnapshotRetentionPolicy{}); err != nil {
+		t.Fatalf("unexpected init error: %v", err)
+	}
+	for _, snapshot := range []struct {
+		id    string
+		names []string
+	}{{id: "a", names: []string{"x"}}, {id: "b", names: []string{"x", "y"}}} {
+		if err := mgr.Create(snapshot.id, nil, testInventory(t, snapshot.names...), &runnerpb.Once_Response_RunResponse{}); err != nil {
+			t.Fatalf("unexpected create error: %v", err)
+		}
+	}
+
+	cases := map[string]struct {
+		from        string
+		to          string
+		expectedErr bool
+		expected    int
+	}{
+		"Default": {
+			expected: 1,
+		},
+		"FromEmpty": {
+			from:     choreov1alpha1.SnapshotEmptyID,
+			expected: 2,
+		},
+		"FirstSnapshotAgainstEmpty": {
+			to:       "a",
+			expected: 1,
+		},
+		"Reverse": {
+			from:     "b",
+			to:       "a",
+			expected: 1,
+		},
+		"Same": {
+			from:     "a",
+			to:       "a",
+			expected: 0,
+		},
+		"NotFound": {
+			from:        "c",
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rsp, err := mgr.Diff(&snapshotpb.Diff_Request{
+				Options: &snapshotpb.Diff_Options{From: tc.from, To: tc.to},
+			})
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected diff error: %v", err)
+			}
+			diff := &choreov1alpha1.Diff{}
+			if err := json.Unmarshal(rsp.Object, diff); err != nil {
+				t.Fatalf("cannot unmarshal diff: %v", err)
+			}
+			changes := 0
+			for _, item := range diff.Status.Items {
+				if item.Status != choreov1alpha1.DiffitemStatus_Equal {
+					changes++
+				}
+			}
+			if changes != tc.expected {
+				t.Errorf("expected %d changes, got %d", tc.expected, changes)
+			}
+		})
+	}
+}
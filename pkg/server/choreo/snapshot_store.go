@@ -0,0 +1,166 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package choreo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"github.com/kform-dev/choreo/pkg/util/inventory"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const snapshotFileExt = ".json"
+
+// snapshotRecord is the on-disk representation of a snapshot
+type snapshotRecord struct {
+	ID           string              `json:"id"`
+	CreatedAt    time.Time           `json:"createdAt"`
+	Tag          string              `json:"tag,omitempty"`
+	APIResources []json.RawMessage   `json:"apiResources,omitempty"`
+	Inventory    inventory.Inventory `json:"inventory,omitempty"`
+	RunResponse  json.RawMessage     `json:"runResponse,omitempty"`
+}
+
+func snapshotFileName(dir, id string) string {
+	return filepath.Join(dir, id+snapshotFileExt)
+}
+
+// writeSnapshot persists the snapshot in the directory; the file is written to
+// a temporary file first to avoid leaving a partial snapshot behind on a crash
+func writeSnapshot(dir string, snapshot *Snapshot) error {
+	record := &snapshotRecord{
+		ID:           snapshot.ID,
+		CreatedAt:    snapshot.CreatedAt,
+		Tag:          snapshot.Tag,
+		APIResources: make([]json.RawMessage, 0, len(snapshot.APIResources)),
+		Inventory:    snapshot.Inventory,
+	}
+	for _, apiResource := range snapshot.APIResources {
+		b, err := protojson.Marshal(apiResource)
+		if err != nil {
+			return err
+		}
+		record.APIResources = append(record.APIResources, b)
+	}
+	if snapshot.RunResponse != nil && snapshot.RunResponse.RunResponse != nil {
+		b, err := protojson.Marshal(snapshot.RunResponse.RunResponse)
+		if err != nil {
+			return err
+		}
+		record.RunResponse = b
+	}
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(dir, fmt.Sprintf(".%s-", snapshot.ID))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(b); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), snapshotFileName(dir, snapshot.ID))
+}
+
+func readSnapshot(fileName string) (*Snapshot, error) {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	record := &snapshotRecord{}
+	if err := json.Unmarshal(b, record); err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{
+		ID:           record.ID,
+		CreatedAt:    record.CreatedAt,
+		Tag:          record.Tag,
+		APIResources: make([]*discoverypb.APIResource, 0, len(record.APIResources)),
+		Inventory:    record.Inventory,
+		RunResponse: &runnerpb.Once_Response_RunResponse{
+			RunResponse: &runnerpb.Once_RunResponse{},
+		},
+	}
+	if snapshot.Inventory == nil {
+		snapshot.Inventory = inventory.Inventory{}
+	}
+	for _, b := range record.APIResources {
+		apiResource := &discoverypb.APIResource{}
+		if err := protojson.Unmarshal(b, apiResource); err != nil {
+			return nil, err
+		}
+		snapshot.APIResources = append(snapshot.APIResources, apiResource)
+	}
+	if len(record.RunResponse) != 0 {
+		if err := protojson.Unmarshal(record.RunResponse, snapshot.RunResponse.RunResponse); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// readSnapshots returns the snapshots stored in the directory sorted by creation time.
+// Snapshots that cannot be read are skipped and reported in the returned error.
+func readSnapshots(dir string) ([]*Snapshot, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var errm error
+	snapshots := []*Snapshot{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || filepath.Ext(entry.Name()) != snapshotFileExt {
+			continue
+		}
+		snapshot, err := readSnapshot(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			errm = errors.Join(errm, fmt.Errorf("cannot read snapshot %s, err: %v", entry.Name(), err))
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.Before(snapshots[j].CreatedAt)
+	})
+	return snapshots, errm
+}
+
+func removeSnapshot(dir, id string) error {
+	if err := os.Remove(snapshotFileName(dir, id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
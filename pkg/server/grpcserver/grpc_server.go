@@ -24,6 +24,7 @@ import (
 
 	"github.com/henderiw/logger/log"
 	"github.com/kform-dev/choreo/pkg/cli/genericclioptions"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
 	"github.com/kform-dev/choreo/pkg/proto/branchpb"
 	"github.com/kform-dev/choreo/pkg/proto/choreopb"
 	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
@@ -31,6 +32,7 @@ import (
 	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
 	"github.com/kform-dev/choreo/pkg/proto/snapshotpb"
 	choreoserver "github.com/kform-dev/choreo/pkg/server/choreo"
+	"github.com/kform-dev/choreo/pkg/server/grpcserver/services/audit"
 	"github.com/kform-dev/choreo/pkg/server/grpcserver/services/branch"
 	"github.com/kform-dev/choreo/pkg/server/grpcserver/services/choreo"
 	"github.com/kform-dev/choreo/pkg/server/grpcserver/services/discovery"
@@ -125,6 +127,10 @@ func (r *GRPCServer) Run(ctx context.Context) error {
 	snapshortServer := snapshot.New(r.choreo)
 	snapshotpb.RegisterSnapshotServer(r.server, snapshortServer)
 
+	// Register the audit service
+	auditServer := audit.New(r.choreo)
+	auditpb.RegisterAuditServer(r.server, auditServer)
+
 	go func() {
 		if err := r.server.Serve(l); err != nil {
 			log.Error("grpc server serve", "error", err)
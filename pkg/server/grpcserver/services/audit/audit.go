@@ -0,0 +1,39 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
+	"github.com/kform-dev/choreo/pkg/server/choreo"
+)
+
+func New(choreo choreo.Choreo) auditpb.AuditServer {
+	return &srv{
+		choreo: choreo,
+	}
+}
+
+type srv struct {
+	auditpb.UnimplementedAuditServer
+	choreo choreo.Choreo
+}
+
+func (r *srv) List(ctx context.Context, req *auditpb.List_Request) (*auditpb.List_Response, error) {
+	return r.choreo.AuditLog().List(req)
+}
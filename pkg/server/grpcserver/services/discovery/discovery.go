@@ -18,6 +18,7 @@ package discovery
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
@@ -29,6 +30,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func New(choreo choreo.Choreo) discoverypb.DiscoveryServer {
@@ -66,6 +69,47 @@ func (r *srv) Get(ctx context.Context, req *discoverypb.Get_Request) (*discovery
 	return &discoverypb.Get_Response{Apiresources: bctx.APIStore.GetAPIResources()}, nil
 }
 
+func (r *srv) Schema(ctx context.Context, req *discoverypb.Schema_Request) (*discoverypb.Schema_Response, error) {
+	bctx, err := r.getBranchContext(req.Branch)
+	if err != nil {
+		return &discoverypb.Schema_Response{}, err
+	}
+	gk := schema.GroupKind{Group: req.Group, Kind: req.Kind}
+	rctx, err := bctx.APIStore.Get(gk)
+	if err != nil {
+		return &discoverypb.Schema_Response{}, status.Errorf(codes.NotFound, "gvk %s not registered", gk.String())
+	}
+	version := req.Version
+	if version == "" {
+		version = rctx.External.Version
+	}
+	if version != rctx.External.Version {
+		return &discoverypb.Schema_Response{}, status.Errorf(codes.NotFound, "version %s of %s not served, served version: %s", version, gk.String(), rctx.External.Version)
+	}
+	openAPISchema := getOpenAPISchema(rctx, version)
+	if openAPISchema == nil {
+		return &discoverypb.Schema_Response{}, status.Errorf(codes.NotFound, "no schema found for %s", gk.WithVersion(version).String())
+	}
+	b, err := json.Marshal(openAPISchema)
+	if err != nil {
+		return &discoverypb.Schema_Response{}, status.Errorf(codes.Internal, "err: %s", err.Error())
+	}
+	return &discoverypb.Schema_Response{ApiResource: rctx.External, Schema: b}, nil
+}
+
+// getOpenAPISchema returns the openapi v3 schema of the crd version
+func getOpenAPISchema(rctx *api.ResourceContext, version string) *apiextensionsv1.JSONSchemaProps {
+	if rctx.CRD == nil {
+		return nil
+	}
+	for _, v := range rctx.CRD.Spec.Versions {
+		if v.Name == version && v.Schema != nil {
+			return v.Schema.OpenAPIV3Schema
+		}
+	}
+	return nil
+}
+
 func (r *srv) Watch(req *discoverypb.Watch_Request, stream discoverypb.Discovery_WatchServer) error {
 	ctx := stream.Context()
 	log := log.FromContext(ctx)
@@ -22,6 +22,7 @@ import (
 	"github.com/kform-dev/choreo/pkg/server/choreo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -56,6 +57,62 @@ func (r *srv) getAPIContext(bctx *choreo.BranchCtx, u *unstructured.Unstructured
 	return rctx, nil
 }
 
+// getNamespace returns the namespace scope of the request;
+// cluster scoped resources have no namespace
+func getNamespace(rctx *api.ResourceContext, namespace string) string {
+	if rctx.External != nil && !rctx.External.Namespaced {
+		return ""
+	}
+	return namespace
+}
+
+// getObjectNamespace returns the namespace of an object of the request;
+// namespaced objects without a namespace are in the default namespace
+func getObjectNamespace(rctx *api.ResourceContext, namespace string) string {
+	if rctx.External != nil && !rctx.External.Namespaced {
+		return ""
+	}
+	if namespace == "" {
+		return metav1.NamespaceDefault
+	}
+	return namespace
+}
+
+// validateDryRun validates the dryRun values of a request; the only supported value
+// is All, which processes the request without persisting the result
+func validateDryRun(dryRun []string) error {
+	for _, value := range dryRun {
+		if value != metav1.DryRunAll {
+			return status.Errorf(codes.InvalidArgument, "invalid dryRun value %q, supported %s", value, metav1.DryRunAll)
+		}
+	}
+	return nil
+}
+
+// validateInputBranch validates that the input files of the branch can be changed;
+// the input files are only stored in the checked out branch, other branches are
+// loaded from their commit
+func validateInputBranch(bctx *choreo.BranchCtx) error {
+	if bctx.State.String() != "CheckedOut" {
+		return status.Errorf(codes.FailedPrecondition, "input of branch %s can only be changed when the branch is checked out", bctx.Branch)
+	}
+	return nil
+}
+
+// getPropagationPolicy returns the deletion propagation policy of the request;
+// by default the dependents are deleted in the background
+func getPropagationPolicy(policy string) (metav1.DeletionPropagation, error) {
+	switch metav1.DeletionPropagation(policy) {
+	case "":
+		return metav1.DeletePropagationBackground, nil
+	case metav1.DeletePropagationForeground, metav1.DeletePropagationBackground, metav1.DeletePropagationOrphan:
+		return metav1.DeletionPropagation(policy), nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid propagationPolicy %q, supported %s, %s or %s", policy,
+			metav1.DeletePropagationForeground, metav1.DeletePropagationBackground, metav1.DeletePropagationOrphan)
+	}
+}
+
 func convertToInternal(rctx *api.ResourceContext, u *unstructured.Unstructured) {
 	if rctx.Internal != nil {
 		u.SetAPIVersion(schema.GroupVersion{Group: rctx.Internal.Group, Version: rctx.Internal.Version}.String())
@@ -21,11 +21,11 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/henderiw/logger/log"
 	"github.com/kform-dev/choreo/pkg/proto/grpcerrors"
 	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
 	"github.com/kform-dev/choreo/pkg/server/api"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/garbagecollector"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
 	"github.com/kform-dev/choreo/pkg/server/apiserver/watch"
 	"github.com/kform-dev/choreo/pkg/server/choreo"
@@ -34,7 +34,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func New(choreo choreo.Choreo) resourcepb.ResourceServer {
@@ -68,17 +70,15 @@ func (r *srv) Get(ctx context.Context, req *resourcepb.Get_Request) (*resourcepb
 		return &resourcepb.Get_Response{}, err
 	}
 	convertToInternal(rctx, u)
+	u.SetNamespace(getObjectNamespace(rctx, u.GetNamespace()))
 
-	var commit *object.Commit
-	if bctx.State.String() != "CheckedOut" {
-		commit, err = r.choreo.GetStatus().Get().RootChoreoInstance.GetRepo().GetBranchCommit(bctx.Branch)
-		if err != nil {
-			return &resourcepb.Get_Response{}, err
-		}
+	commit, err := bctx.GetCommit(r.choreo.GetStatus().Get().RootChoreoInstance.GetRepo())
+	if err != nil {
+		return &resourcepb.Get_Response{}, err
 	}
 
 	// invoke storage
-	obj, err := rctx.Storage.Get(ctx, u.GetName(), &rest.GetOptions{
+	obj, err := rctx.Storage.Get(ctx, types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}, &rest.GetOptions{
 		ShowManagedFields: req.Options.ShowManagedField,
 		Trace:             req.Options.Trace,
 		Origin:            req.Options.Origin,
@@ -127,16 +127,16 @@ func (r *srv) List(ctx context.Context, req *resourcepb.List_Request) (*resource
 	}
 	convertToInternal(rctx, u)
 
-	var commit *object.Commit
-	if bctx.State.String() != "CheckedOut" {
-		commit, err = r.choreo.GetStatus().Get().RootChoreoInstance.GetRepo().GetBranchCommit(bctx.Branch)
-		if err != nil {
-			return &resourcepb.List_Response{}, err
-		}
+	commit, err := bctx.GetCommit(r.choreo.GetStatus().Get().RootChoreoInstance.GetRepo())
+	if err != nil {
+		return &resourcepb.List_Response{}, err
 	}
 
 	// invoke storage
 	obj, err := rctx.Storage.List(ctx, &rest.ListOptions{
+		Namespace:         getNamespace(rctx, req.Options.Namespace),
+		Limit:             req.Options.Limit,
+		Continue:          req.Options.Continue,
 		Selector:          selector,
 		ShowManagedFields: req.Options.ShowManagedField,
 		Trace:             req.Options.Trace,
@@ -163,6 +163,14 @@ func (r *srv) List(ctx context.Context, req *resourcepb.List_Request) (*resource
 }
 
 func (r *srv) Apply(ctx context.Context, req *resourcepb.Apply_Request) (*resourcepb.Apply_Response, error) {
+	return r.apply(ctx, req, "")
+}
+
+func (r *srv) ApplyStatus(ctx context.Context, req *resourcepb.Apply_Request) (*resourcepb.Apply_Response, error) {
+	return r.apply(ctx, req, rest.SubresourceStatus)
+}
+
+func (r *srv) apply(ctx context.Context, req *resourcepb.Apply_Request, subresource string) (*resourcepb.Apply_Response, error) {
 	log := log.FromContext(ctx)
 
 	bctx, err := r.getBranchContext(req.Options.Branch)
@@ -177,16 +185,26 @@ func (r *srv) Apply(ctx context.Context, req *resourcepb.Apply_Request) (*resour
 
 	orig := u.DeepCopy()
 
-	log.Debug("apply", "apiVersion", u.GetAPIVersion(), "kind", u.GetKind(), "name", u.GetName(), "fieldmanager", req.Options.FieldManager, "force", req.Options.Force)
+	log.Debug("apply", "apiVersion", u.GetAPIVersion(), "kind", u.GetKind(), "name", u.GetName(), "subresource", subresource, "fieldmanager", req.Options.FieldManager, "force", req.Options.Force)
 
 	rctx, err := r.getAPIContext(bctx, u)
 	if err != nil {
 		return &resourcepb.Apply_Response{}, err
 	}
 	convertToInternal(rctx, u)
+	u.SetNamespace(getObjectNamespace(rctx, u.GetNamespace()))
 
+	if err := validateDryRun(req.Options.DryRun); err != nil {
+		return &resourcepb.Apply_Response{}, err
+	}
 	dryrun := req.Options.DryRun
-	if req.Options.Origin == "choreoctl" {
+	// choreoctl stores the object in the input files, the storage is updated when the input is loaded;
+	// the status is not part of the input
+	storeInput := req.Options.Origin == "choreoctl" && len(req.Options.DryRun) == 0 && subresource == ""
+	if storeInput {
+		if err := validateInputBranch(bctx); err != nil {
+			return &resourcepb.Apply_Response{}, err
+		}
 		dryrun = []string{"choreoctl"}
 	}
 
@@ -196,12 +214,13 @@ func (r *srv) Apply(ctx context.Context, req *resourcepb.Apply_Request) (*resour
 		Force:        req.Options.Force,
 		Trace:        req.Options.Trace,
 		Origin:       req.Options.Origin,
+		Subresource:  subresource,
 	})
 	if err != nil {
 		return &resourcepb.Apply_Response{}, err
 	}
 
-	if req.Options.Origin == "choreoctl" {
+	if storeInput {
 		if err := r.choreo.Store(orig); err != nil {
 			return &resourcepb.Apply_Response{}, status.Errorf(codes.Internal, "err: %s", err.Error())
 		}
@@ -235,6 +254,10 @@ func (r *srv) Create(ctx context.Context, req *resourcepb.Create_Request) (*reso
 		return &resourcepb.Create_Response{}, err
 	}
 	convertToInternal(rctx, u)
+	u.SetNamespace(getObjectNamespace(rctx, u.GetNamespace()))
+	if err := validateDryRun(req.Options.DryRun); err != nil {
+		return &resourcepb.Create_Response{}, err
+	}
 	obj, err := rctx.Storage.Create(ctx, u, &rest.CreateOptions{
 		DryRun: req.Options.DryRun,
 		Trace:  req.Options.Trace,
@@ -254,6 +277,14 @@ func (r *srv) Create(ctx context.Context, req *resourcepb.Create_Request) (*reso
 }
 
 func (r *srv) Update(ctx context.Context, req *resourcepb.Update_Request) (*resourcepb.Update_Response, error) {
+	return r.update(ctx, req, "")
+}
+
+func (r *srv) UpdateStatus(ctx context.Context, req *resourcepb.Update_Request) (*resourcepb.Update_Response, error) {
+	return r.update(ctx, req, rest.SubresourceStatus)
+}
+
+func (r *srv) update(ctx context.Context, req *resourcepb.Update_Request, subresource string) (*resourcepb.Update_Response, error) {
 	log := log.FromContext(ctx)
 
 	bctx, err := r.getBranchContext(req.Options.Branch)
@@ -266,17 +297,22 @@ func (r *srv) Update(ctx context.Context, req *resourcepb.Update_Request) (*reso
 		return &resourcepb.Update_Response{}, err
 	}
 
-	log.Debug("update", "apiVersion", u.GetAPIVersion(), "kind", u.GetKind(), "name", u.GetName())
+	log.Debug("update", "apiVersion", u.GetAPIVersion(), "kind", u.GetKind(), "name", u.GetName(), "subresource", subresource)
 
 	rctx, err := r.getAPIContext(bctx, u)
 	if err != nil {
 		return &resourcepb.Update_Response{}, err
 	}
 	convertToInternal(rctx, u)
+	u.SetNamespace(getObjectNamespace(rctx, u.GetNamespace()))
+	if err := validateDryRun(req.Options.DryRun); err != nil {
+		return &resourcepb.Update_Response{}, err
+	}
 	obj, err := rctx.Storage.Update(ctx, u, &rest.UpdateOptions{
-		DryRun: req.Options.DryRun,
-		Trace:  req.Options.Trace,
-		Origin: req.Options.Origin,
+		DryRun:      req.Options.DryRun,
+		Trace:       req.Options.Trace,
+		Origin:      req.Options.Origin,
+		Subresource: subresource,
 	})
 	if err != nil {
 		return &resourcepb.Update_Response{}, err
@@ -311,28 +347,89 @@ func (r *srv) Delete(ctx context.Context, req *resourcepb.Delete_Request) (*reso
 		return &resourcepb.Delete_Response{}, err
 	}
 	convertToInternal(rctx, u)
+	u.SetNamespace(getObjectNamespace(rctx, u.GetNamespace()))
+
+	policy, err := getPropagationPolicy(req.Options.PropagationPolicy)
+	if err != nil {
+		return &resourcepb.Delete_Response{}, err
+	}
 
+	if err := validateDryRun(req.Options.DryRun); err != nil {
+		return &resourcepb.Delete_Response{}, err
+	}
 	dryrun := req.Options.DryRun
-	if req.Options.Origin == "choreoctl" {
+	// choreoctl deletes the object from the input files
+	destroyInput := req.Options.Origin == "choreoctl" && len(req.Options.DryRun) == 0
+	if destroyInput {
+		if err := validateInputBranch(bctx); err != nil {
+			return &resourcepb.Delete_Response{}, err
+		}
+	}
+	if destroyInput && req.Options.PropagationPolicy == "" {
+		// without a propagation policy choreoctl only deletes the object from the input files
 		dryrun = []string{"choreoctl"}
 	}
-	if _, err := rctx.Storage.Delete(ctx, u.GetName(), &rest.DeleteOptions{
+	key := types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}
+	// dependents are only handled when the object is deleted from the storage
+	propagate := len(dryrun) == 0 && bctx.GarbageCollector != nil
+
+	if propagate && policy != metav1.DeletePropagationBackground {
+		if err := r.propagateDelete(ctx, bctx, rctx, key, policy); err != nil {
+			return &resourcepb.Delete_Response{}, err
+		}
+	}
+
+	obj, err := rctx.Storage.Delete(ctx, key, &rest.DeleteOptions{
 		DryRun: dryrun,
 		Trace:  req.Options.Trace,
 		Origin: req.Options.Origin,
-	}); err != nil {
+	})
+	if err != nil {
 		if !grpcerrors.IsNotFound(err) {
 			return &resourcepb.Delete_Response{}, err
 		}
+	} else if propagate && policy == metav1.DeletePropagationBackground {
+		bctx.GarbageCollector.Enqueue(garbagecollector.OwnerFromObject(rctx.GV(), &unstructured.Unstructured{Object: obj.UnstructuredContent()}))
 	}
 
-	if req.Options.Origin == "choreoctl" {
+	if destroyInput {
 		if err := r.choreo.Destroy(u); err != nil {
 			return &resourcepb.Delete_Response{}, status.Errorf(codes.Internal, "err: %s", err.Error())
 		}
 	}
+	if obj == nil {
+		// the object was not found
+		return &resourcepb.Delete_Response{}, nil
+	}
 
-	return &resourcepb.Delete_Response{}, nil
+	u = &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	convertFromInternal(rctx, u)
+	b, err := json.Marshal(u.Object)
+	if err != nil {
+		return &resourcepb.Delete_Response{}, status.Errorf(codes.Internal, "err: %s", err.Error())
+	}
+	return &resourcepb.Delete_Response{Object: b}, nil
+}
+
+// propagateDelete handles the dependents of the object before the object itself is deleted:
+// foreground deletes the dependents and waits till they are gone, orphan removes the
+// ownerReferences to the object from its dependents
+func (r *srv) propagateDelete(ctx context.Context, bctx *choreo.BranchCtx, rctx *api.ResourceContext, key types.NamespacedName, policy metav1.DeletionPropagation) error {
+	obj, err := rctx.Storage.Get(ctx, key, &rest.GetOptions{})
+	if err != nil {
+		if grpcerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	owner := garbagecollector.OwnerFromObject(rctx.GV(), &unstructured.Unstructured{Object: obj.UnstructuredContent()})
+	switch policy {
+	case metav1.DeletePropagationForeground:
+		return bctx.GarbageCollector.DeleteDependents(ctx, owner)
+	case metav1.DeletePropagationOrphan:
+		return bctx.GarbageCollector.OrphanDependents(ctx, owner)
+	}
+	return nil
 }
 
 func (r *srv) Watch(req *resourcepb.Watch_Request, stream resourcepb.Resource_WatchServer) error {
@@ -370,10 +467,13 @@ func (r *srv) Watch(req *resourcepb.Watch_Request, stream resourcepb.Resource_Wa
 	ctx, cancel := context.WithCancel(stream.Context())
 	defer cancel()
 	wi, err := rctx.Storage.Watch(ctx, &rest.ListOptions{
-		Watch:    false,
-		Selector: selector,
-		Trace:    req.Options.Trace,
-		Origin:   req.Options.Origin,
+		Watch:           false,
+		Namespace:       getNamespace(rctx, req.Options.Namespace),
+		ResourceVersion: req.Options.ResourceVersion,
+		AllowBookmarks:  req.Options.AllowBookmarks,
+		Selector:        selector,
+		Trace:           req.Options.Trace,
+		Origin:          req.Options.Origin,
 	})
 	if err != nil {
 		return err
@@ -432,8 +532,9 @@ func (r *srv) watch(ctx context.Context, rctx *api.ResourceContext, wi watch.Int
 			}
 
 			if err := clientStream.Send(&resourcepb.Watch_Response{
-				Object:    b,
-				EventType: watchEvent.Type,
+				Object:          b,
+				EventType:       watchEvent.Type,
+				ResourceVersion: watchEvent.ResourceVersion,
 			}); err != nil {
 				p, _ := peer.FromContext(clientStream.Context())
 				addr := "unknown"
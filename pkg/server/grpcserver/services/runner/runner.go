@@ -53,39 +53,70 @@ func (r *srv) getBranchContext(branch string) (*choreo.BranchCtx, error) {
 }
 
 func (r *srv) Start(ctx context.Context, req *runnerpb.Start_Request) (*runnerpb.Start_Response, error) {
-	bctx, err := r.getBranchContext("")
+	bctx, err := r.getBranchContext(req.GetOptions().GetBranch())
 	if err != nil {
 		return &runnerpb.Start_Response{}, err
 	}
-
-	r.choreo.Runner().Start(ctx, bctx)
-	return &runnerpb.Start_Response{}, nil
+	return bctx.Runner.Start(ctx, bctx)
 }
 
 func (r *srv) Stop(ctx context.Context, req *runnerpb.Stop_Request) (*runnerpb.Stop_Response, error) {
-
-	r.choreo.Runner().Stop()
+	bctx, err := r.getBranchContext(req.GetOptions().GetBranch())
+	if err != nil {
+		return &runnerpb.Stop_Response{}, err
+	}
+	bctx.Runner.Stop()
 	return &runnerpb.Stop_Response{}, nil
 }
 
 func (r *srv) Once(req *runnerpb.Once_Request, stream runnerpb.Runner_OnceServer) error {
 	ctx := stream.Context()
-	bctx, err := r.choreo.GetBranchStore().GetCheckedOut()
-	if bctx == nil {
-		return status.Errorf(codes.NotFound, "no checkedout branch found %v", err)
+	bctx, err := r.getBranchContext(req.GetOptions().GetBranch())
+	if err != nil {
+		return err
 	}
 	// blocks
-	return r.choreo.Runner().RunOnce(ctx, bctx, stream)
+	return bctx.Runner.RunOnce(ctx, bctx, req.GetOptions(), stream)
 }
 
 func (r *srv) Load(ctx context.Context, req *runnerpb.Load_Request) (*runnerpb.Load_Response, error) {
-	bctx, err := r.choreo.GetBranchStore().GetCheckedOut()
-	if bctx == nil {
-		return nil, status.Errorf(codes.NotFound, "no checkedout branch found %v", err)
+	bctx, err := r.getBranchContext(req.GetOptions().GetBranch())
+	if err != nil {
+		return nil, err
 	}
-	if err := r.choreo.Runner().Load(ctx, bctx); err != nil {
+	if err := bctx.Runner.Load(ctx, bctx); err != nil {
 		return nil, status.Errorf(codes.Internal, "load data failed %v", err)
 	}
 
 	return &runnerpb.Load_Response{}, nil
 }
+
+func (r *srv) Deps(ctx context.Context, req *runnerpb.Deps_Request) (*runnerpb.Deps_Response, error) {
+	bctx, err := r.getBranchContext(req.GetOptions().GetBranch())
+	if err != nil {
+		return nil, err
+	}
+	return bctx.Runner.Deps(ctx, bctx)
+}
+
+func (r *srv) Status(ctx context.Context, req *runnerpb.Status_Request) (*runnerpb.Status_Response, error) {
+	bctxs := r.choreo.GetBranchStore().List()
+	if branch := req.GetOptions().GetBranch(); branch != "" {
+		bctx, err := r.getBranchContext(branch)
+		if err != nil {
+			return nil, err
+		}
+		bctxs = []*choreo.BranchCtx{bctx}
+	}
+	rsp := &runnerpb.Status_Response{
+		Branches: make([]*runnerpb.Status_BranchStatus, 0, len(bctxs)),
+	}
+	for _, bctx := range bctxs {
+		rsp.Branches = append(rsp.Branches, &runnerpb.Status_BranchStatus{
+			Branch:     bctx.Branch,
+			CheckedOut: bctx.State.String() == "CheckedOut",
+			Status:     bctx.Runner.Status().String(),
+		})
+	}
+	return rsp, nil
+}
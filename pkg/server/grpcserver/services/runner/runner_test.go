@@ -0,0 +1,99 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/proto/runnerpb"
+	"github.com/kform-dev/choreo/pkg/server/choreo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+type testChoreo struct {
+	choreo.Choreo
+	branchStore *choreo.BranchStore
+}
+
+func (r *testChoreo) GetBranchStore() *choreo.BranchStore { return r.branchStore }
+
+type testRunner struct {
+	choreo.Runner
+	status choreo.RunnerStatus
+}
+
+func (r *testRunner) Status() choreo.RunnerStatus { return r.status }
+
+func TestStatus(t *testing.T) {
+	branchStore := choreo.NewBranchStore(nil)
+	for _, bctx := range []*choreo.BranchCtx{
+		{Branch: "main", State: &choreo.CheckedOut{}, Runner: &testRunner{status: choreo.RunnerStatus_Running}},
+		{Branch: "dev", State: &choreo.NotCheckedOut{}, Runner: &testRunner{status: choreo.RunnerStatus_Once}},
+		{Branch: "feature", State: &choreo.NotCheckedOut{}, Runner: &testRunner{status: choreo.RunnerStatus_Stopped}},
+	} {
+		if err := branchStore.GetStore().Apply(store.ToKey(bctx.Branch), bctx); err != nil {
+			t.Fatalf("apply branch %s failed: %v", bctx.Branch, err)
+		}
+	}
+	srv := New(&testChoreo{branchStore: branchStore})
+
+	cases := map[string]struct {
+		branch   string
+		expected []*runnerpb.Status_BranchStatus
+		code     codes.Code
+	}{
+		"AllBranches": {
+			expected: []*runnerpb.Status_BranchStatus{
+				{Branch: "dev", Status: "Once"},
+				{Branch: "feature", Status: "Stopped"},
+				{Branch: "main", CheckedOut: true, Status: "Running"},
+			},
+		},
+		"Branch": {
+			branch: "dev",
+			expected: []*runnerpb.Status_BranchStatus{
+				{Branch: "dev", Status: "Once"},
+			},
+		},
+		"UnknownBranch": {
+			branch: "unknown",
+			code:   codes.NotFound,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rsp, err := srv.Status(context.Background(), &runnerpb.Status_Request{
+				Options: &runnerpb.Status_Options{Branch: tc.branch},
+			})
+			if status.Code(err) != tc.code {
+				t.Fatalf("want code %s, got %v", tc.code, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expected, rsp.GetBranches(), protocmp.Transform()); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
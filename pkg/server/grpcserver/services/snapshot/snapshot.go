@@ -21,6 +21,8 @@ import (
 
 	"github.com/kform-dev/choreo/pkg/proto/snapshotpb"
 	"github.com/kform-dev/choreo/pkg/server/choreo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func New(choreo choreo.Choreo) snapshotpb.SnapshotServer {
@@ -54,6 +56,21 @@ func (r *srv) Result(ctx context.Context, req *snapshotpb.Result_Request) (*snap
 	return r.choreo.SnapshotManager().Result(req)
 }
 
+func (r *srv) Tag(ctx context.Context, req *snapshotpb.Tag_Request) (*snapshotpb.Tag_Response, error) {
+	return r.choreo.SnapshotManager().Tag(req)
+}
+
+func (r *srv) Restore(ctx context.Context, req *snapshotpb.Restore_Request) (*snapshotpb.Restore_Response, error) {
+	bctx, err := r.choreo.GetBranchStore().GetCheckedOut()
+	if bctx == nil {
+		return &snapshotpb.Restore_Response{}, status.Errorf(codes.NotFound, "no checkedout branch found %v", err)
+	}
+	if err := bctx.Runner.Restore(ctx, bctx, req.Id); err != nil {
+		return &snapshotpb.Restore_Response{}, err
+	}
+	return &snapshotpb.Restore_Response{}, nil
+}
+
 /*
 func (r *srv) Watch(req *snapshotpb.Watch_Request, stream snapshotpb.Snapshot_WatchServer) error {
 	ctx := stream.Context()
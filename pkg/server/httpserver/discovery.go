@@ -0,0 +1,184 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"sort"
+
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// verbs supported by the resources served by the http server
+var verbs = metav1.Verbs{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+// statusVerbs supported by the status subresource
+var statusVerbs = metav1.Verbs{"get", "update", "patch"}
+
+func (r *handler) getAPIResources(req *http.Request, info *requestInfo) ([]*discoverypb.APIResource, error) {
+	rsp, err := r.discovery.Get(req.Context(), &discoverypb.Get_Request{Branch: info.Branch})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.GetApiresources(), nil
+}
+
+// getAPIResource returns the api resource of the request
+func (r *handler) getAPIResource(req *http.Request, info *requestInfo) (*discoverypb.APIResource, error) {
+	apiResources, err := r.getAPIResources(req, info)
+	if err != nil {
+		return nil, err
+	}
+	for _, apiResource := range apiResources {
+		if apiResource.Group == info.Group && apiResource.Version == info.Version && apiResource.Resource == info.Resource {
+			return apiResource, nil
+		}
+	}
+	return nil, fmt.Errorf("the server could not find the requested resource %s", info.Resource)
+}
+
+// discover serves the discovery endpoints /api, /apis, /apis/<group> and the resource lists
+// of the group versions
+func (r *handler) discover(w http.ResponseWriter, req *http.Request, info *requestInfo) {
+	apiResources, err := r.getAPIResources(req, info)
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	groups := groupVersions(apiResources)
+
+	switch {
+	case info.Version != "":
+		writeJSON(w, http.StatusOK, apiResourceList(apiResources, info))
+	case info.Prefix == "api":
+		versions := []string{}
+		for _, gv := range groups[""] {
+			versions = append(versions, gv.Version)
+		}
+		writeJSON(w, http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: versions,
+		})
+	case info.Group != "":
+		group, ok := apiGroup(groups, info.Group)
+		if !ok {
+			writeError(w, newStatus(http.StatusNotFound, fmt.Sprintf("group %s not found", info.Group)))
+			return
+		}
+		writeJSON(w, http.StatusOK, group)
+	default:
+		groupList := &metav1.APIGroupList{
+			TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+			Groups:   []metav1.APIGroup{},
+		}
+		names := make([]string, 0, len(groups))
+		for name := range groups {
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			group, _ := apiGroup(groups, name)
+			groupList.Groups = append(groupList.Groups, *group)
+		}
+		writeJSON(w, http.StatusOK, groupList)
+	}
+}
+
+// groupVersions returns the versions per group of the api resources
+func groupVersions(apiResources []*discoverypb.APIResource) map[string][]metav1.GroupVersionForDiscovery {
+	groups := map[string][]metav1.GroupVersionForDiscovery{}
+	for _, apiResource := range apiResources {
+		gv := metav1.GroupVersionForDiscovery{
+			GroupVersion: (&requestInfo{Group: apiResource.Group, Version: apiResource.Version}).apiVersion(),
+			Version:      apiResource.Version,
+		}
+		found := false
+		for _, existing := range groups[apiResource.Group] {
+			if existing == gv {
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups[apiResource.Group] = append(groups[apiResource.Group], gv)
+		}
+	}
+	for _, versions := range groups {
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	}
+	return groups
+}
+
+func apiGroup(groups map[string][]metav1.GroupVersionForDiscovery, name string) (*metav1.APIGroup, bool) {
+	versions, ok := groups[name]
+	if !ok {
+		return nil, false
+	}
+	return &metav1.APIGroup{
+		TypeMeta:         metav1.TypeMeta{Kind: "APIGroup", APIVersion: "v1"},
+		Name:             name,
+		Versions:         versions,
+		PreferredVersion: versions[0],
+	}, true
+}
+
+func apiResourceList(apiResources []*discoverypb.APIResource, info *requestInfo) *metav1.APIResourceList {
+	list := &metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: info.apiVersion(),
+		APIResources: []metav1.APIResource{},
+	}
+	for _, apiResource := range apiResources {
+		if apiResource.Group != info.Group || apiResource.Version != info.Version {
+			continue
+		}
+		list.APIResources = append(list.APIResources, metav1.APIResource{
+			Name:       apiResource.Resource,
+			Namespaced: apiResource.Namespaced,
+			Kind:       apiResource.Kind,
+			Verbs:      verbs,
+			Categories: apiResource.Categories,
+		})
+		if apiResource.StatusEnabled {
+			list.APIResources = append(list.APIResources, metav1.APIResource{
+				Name:       fmt.Sprintf("%s/%s", apiResource.Resource, rest.SubresourceStatus),
+				Namespaced: apiResource.Namespaced,
+				Kind:       apiResource.Kind,
+				Verbs:      statusVerbs,
+			})
+		}
+	}
+	return list
+}
+
+func (r *handler) version(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, &version.Info{
+		Major:      "1",
+		Minor:      "31",
+		GitVersion: "v1.31.0-choreo",
+		GoVersion:  runtime.Version(),
+		Compiler:   runtime.Compiler,
+		Platform:   fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH),
+	})
+}
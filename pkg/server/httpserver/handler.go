@@ -0,0 +1,168 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+)
+
+const (
+	// origin identifies the requests of the http server in the storage layer
+	origin = "httpserver"
+	// branchPrefix selects the branch of the request: /branches/<branch>/apis/...
+	// Without the prefix the checked out branch is used.
+	branchPrefix = "branches"
+)
+
+type handler struct {
+	resource  resourcepb.ResourceServer
+	discovery discoverypb.DiscoveryServer
+}
+
+func newHandler(resource resourcepb.ResourceServer, discovery discoverypb.DiscoveryServer) http.Handler {
+	return &handler{
+		resource:  resource,
+		discovery: discovery,
+	}
+}
+
+// requestInfo holds the information of the request derived from the url path
+type requestInfo struct {
+	Branch string
+	// Prefix is api for the core group and apis for the other groups
+	Prefix      string
+	Group       string
+	Version     string
+	Namespace   string
+	Resource    string
+	Name        string
+	Subresource string
+	// OpenAPI holds the path below /openapi, e.g. v3/apis/<group>/<version>
+	OpenAPI []string
+}
+
+func (r *requestInfo) isDiscovery() bool {
+	return r.Resource == ""
+}
+
+func (r *requestInfo) apiVersion() string {
+	if r.Group == "" {
+		return r.Version
+	}
+	return fmt.Sprintf("%s/%s", r.Group, r.Version)
+}
+
+// parseRequestInfo parses the url path using the kubernetes api conventions
+//
+//	/api/<version>/[namespaces/<namespace>/]<resource>[/<name>[/<subresource>]]
+//	/apis/<group>/<version>/[namespaces/<namespace>/]<resource>[/<name>[/<subresource>]]
+//
+// optionally prefixed with /branches/<branch>
+func parseRequestInfo(path string) (*requestInfo, error) {
+	info := &requestInfo{}
+	parts := splitPath(path)
+	if len(parts) >= 2 && parts[0] == branchPrefix {
+		info.Branch = parts[1]
+		parts = parts[2:]
+	}
+	if len(parts) == 0 {
+		return info, nil
+	}
+	info.Prefix = parts[0]
+	parts = parts[1:]
+	switch info.Prefix {
+	case "api":
+		// the core group has no group in the path
+	case "apis":
+		if len(parts) == 0 {
+			return info, nil
+		}
+		info.Group = parts[0]
+		parts = parts[1:]
+	case "openapi":
+		info.OpenAPI = parts
+		return info, nil
+	default:
+		return info, nil
+	}
+	if len(parts) == 0 {
+		return info, nil
+	}
+	info.Version = parts[0]
+	parts = parts[1:]
+
+	if len(parts) >= 3 && parts[0] == "namespaces" {
+		info.Namespace = parts[1]
+		parts = parts[2:]
+	}
+	switch len(parts) {
+	case 0:
+	case 1:
+		info.Resource = parts[0]
+	case 2:
+		info.Resource, info.Name = parts[0], parts[1]
+	case 3:
+		info.Resource, info.Name, info.Subresource = parts[0], parts[1], parts[2]
+	default:
+		return nil, fmt.Errorf("invalid path %s", path)
+	}
+	return info, nil
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return []string{}
+	}
+	return strings.Split(path, "/")
+}
+
+func (r *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	info, err := parseRequestInfo(req.URL.Path)
+	if err != nil {
+		writeError(w, newStatus(http.StatusNotFound, err.Error()))
+		return
+	}
+	switch {
+	case info.Prefix == "version" && info.Version == "":
+		r.version(w, req)
+	case info.Prefix == "healthz" || info.Prefix == "livez" || info.Prefix == "readyz":
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	case info.Prefix == "openapi":
+		if req.Method != http.MethodGet {
+			writeError(w, newStatus(http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed", req.Method)))
+			return
+		}
+		r.openapi(w, req, info)
+	case info.Prefix != "api" && info.Prefix != "apis":
+		writeError(w, newStatus(http.StatusNotFound, fmt.Sprintf("the server could not find the requested resource %s", req.URL.Path)))
+	case info.isDiscovery():
+		if req.Method != http.MethodGet {
+			writeError(w, newStatus(http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed", req.Method)))
+			return
+		}
+		r.discover(w, req, info)
+	default:
+		r.serveResource(w, req, info)
+	}
+}
@@ -0,0 +1,345 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseRequestInfo(t *testing.T) {
+	cases := map[string]struct {
+		path        string
+		expected    *requestInfo
+		expectedErr bool
+	}{
+		"Root": {
+			path:     "/",
+			expected: &requestInfo{},
+		},
+		"Groups": {
+			path:     "/apis",
+			expected: &requestInfo{Prefix: "apis"},
+		},
+		"CoreGroupVersion": {
+			path:     "/api/v1",
+			expected: &requestInfo{Prefix: "api", Version: "v1"},
+		},
+		"ClusterScopedCollection": {
+			path:     "/apis/example.com/v1alpha1/nodes",
+			expected: &requestInfo{Prefix: "apis", Group: "example.com", Version: "v1alpha1", Resource: "nodes"},
+		},
+		"NamespacedObject": {
+			path:     "/apis/example.com/v1alpha1/namespaces/default/nodes/a",
+			expected: &requestInfo{Prefix: "apis", Group: "example.com", Version: "v1alpha1", Namespace: "default", Resource: "nodes", Name: "a"},
+		},
+		"Subresource": {
+			path:     "/apis/example.com/v1alpha1/namespaces/default/nodes/a/status",
+			expected: &requestInfo{Prefix: "apis", Group: "example.com", Version: "v1alpha1", Namespace: "default", Resource: "nodes", Name: "a", Subresource: "status"},
+		},
+		"Namespace": {
+			path:     "/api/v1/namespaces/default",
+			expected: &requestInfo{Prefix: "api", Version: "v1", Resource: "namespaces", Name: "default"},
+		},
+		"Branch": {
+			path:     "/branches/dev/apis/example.com/v1alpha1/nodes",
+			expected: &requestInfo{Branch: "dev", Prefix: "apis", Group: "example.com", Version: "v1alpha1", Resource: "nodes"},
+		},
+		"OpenAPI": {
+			path:     "/branches/dev/openapi/v3/apis/example.com/v1alpha1",
+			expected: &requestInfo{Branch: "dev", Prefix: "openapi", OpenAPI: []string{"v3", "apis", "example.com", "v1alpha1"}},
+		},
+		"TooLong": {
+			path:        "/apis/example.com/v1alpha1/nodes/a/status/b",
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			info, err := parseRequestInfo(tc.path)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error for %s", tc.path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expected, info); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetExprSelector(t *testing.T) {
+	cases := map[string]struct {
+		labelSelector string
+		fieldSelector string
+		expected      []*resourcepb.ExpressionSelectorRequirement
+		expectedErr   bool
+	}{
+		"Empty": {},
+		"Labels": {
+			labelSelector: "app.kubernetes.io/name=a,tier in (x,y),!b",
+			expected: []*resourcepb.ExpressionSelectorRequirement{
+				{Expression: `metadata.labels["app.kubernetes.io/name"]`, Operator: resourcepb.Operator_Equals, Values: []string{"a"}},
+				{Expression: `metadata.labels["b"]`, Operator: resourcepb.Operator_DoesNotExist},
+				{Expression: `metadata.labels["tier"]`, Operator: resourcepb.Operator_In, Values: []string{"x", "y"}},
+			},
+		},
+		"Fields": {
+			fieldSelector: "metadata.name=a,metadata.namespace!=b",
+			expected: []*resourcepb.ExpressionSelectorRequirement{
+				{Expression: "metadata.name", Operator: resourcepb.Operator_Equals, Values: []string{"a"}},
+				{Expression: "metadata.namespace", Operator: resourcepb.Operator_NotEquals, Values: []string{"b"}},
+			},
+		},
+		"InvalidLabels": {
+			labelSelector: "a in",
+			expectedErr:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			es, err := getExprSelector(tc.labelSelector, tc.fieldSelector)
+			if tc.expectedErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(es.MatchExpressions) != len(tc.expected) {
+				t.Fatalf("expected %d requirements, got %v", len(tc.expected), es.MatchExpressions)
+			}
+			for i, req := range es.MatchExpressions {
+				exp := tc.expected[i]
+				if req.Expression != exp.Expression || req.Operator != exp.Operator || !cmp.Equal(req.Values, exp.Values, cmpopts.EquateEmpty()) {
+					t.Errorf("requirement %d: expected %v, got %v", i, exp, req)
+				}
+			}
+		})
+	}
+}
+
+type fakeDiscovery struct {
+	discoverypb.UnimplementedDiscoveryServer
+	apiResources []*discoverypb.APIResource
+	schemas      map[string]string
+}
+
+func (r *fakeDiscovery) Get(_ context.Context, _ *discoverypb.Get_Request) (*discoverypb.Get_Response, error) {
+	return &discoverypb.Get_Response{Apiresources: r.apiResources}, nil
+}
+
+func (r *fakeDiscovery) Schema(_ context.Context, req *discoverypb.Schema_Request) (*discoverypb.Schema_Response, error) {
+	schema, ok := r.schemas[req.Kind]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no schema found for %s", req.Kind)
+	}
+	return &discoverypb.Schema_Response{Schema: []byte(schema)}, nil
+}
+
+func TestDiscovery(t *testing.T) {
+	h := newHandler(&resourcepb.UnimplementedResourceServer{}, &fakeDiscovery{
+		apiResources: []*discoverypb.APIResource{
+			{Resource: "nodes", Group: "example.com", Version: "v1alpha1", Kind: "Node", Namespaced: true, StatusEnabled: true},
+			{Resource: "links", Group: "example.com", Version: "v1alpha1", Kind: "Link"},
+			{Resource: "configs", Group: "choreo.kform.dev", Version: "v1alpha1", Kind: "Config", Namespaced: true},
+		},
+	})
+
+	cases := map[string]struct {
+		path         string
+		expectedCode int
+		obj          any
+		expected     any
+	}{
+		"Core": {
+			path:         "/api",
+			expectedCode: http.StatusOK,
+			obj:          &metav1.APIVersions{},
+			expected:     &metav1.APIVersions{TypeMeta: metav1.TypeMeta{Kind: "APIVersions"}, Versions: []string{}},
+		},
+		"Groups": {
+			path:         "/apis",
+			expectedCode: http.StatusOK,
+			obj:          &metav1.APIGroupList{},
+			expected: &metav1.APIGroupList{
+				TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+				Groups: []metav1.APIGroup{
+					{
+						TypeMeta:         metav1.TypeMeta{Kind: "APIGroup", APIVersion: "v1"},
+						Name:             "choreo.kform.dev",
+						Versions:         []metav1.GroupVersionForDiscovery{{GroupVersion: "choreo.kform.dev/v1alpha1", Version: "v1alpha1"}},
+						PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "choreo.kform.dev/v1alpha1", Version: "v1alpha1"},
+					},
+					{
+						TypeMeta:         metav1.TypeMeta{Kind: "APIGroup", APIVersion: "v1"},
+						Name:             "example.com",
+						Versions:         []metav1.GroupVersionForDiscovery{{GroupVersion: "example.com/v1alpha1", Version: "v1alpha1"}},
+						PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "example.com/v1alpha1", Version: "v1alpha1"},
+					},
+				},
+			},
+		},
+		"Resources": {
+			path:         "/branches/dev/apis/example.com/v1alpha1",
+			expectedCode: http.StatusOK,
+			obj:          &metav1.APIResourceList{},
+			expected: &metav1.APIResourceList{
+				TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+				GroupVersion: "example.com/v1alpha1",
+				APIResources: []metav1.APIResource{
+					{Name: "nodes", Namespaced: true, Kind: "Node", Verbs: verbs},
+					{Name: "nodes/status", Namespaced: true, Kind: "Node", Verbs: statusVerbs},
+					{Name: "links", Kind: "Link", Verbs: verbs},
+				},
+			},
+		},
+		"UnknownGroup": {
+			path:         "/apis/unknown.com",
+			expectedCode: http.StatusNotFound,
+		},
+		"UnknownResource": {
+			path:         "/apis/example.com/v1alpha1/unknowns",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			if w.Code != tc.expectedCode {
+				t.Fatalf("expected code %d, got %d: %s", tc.expectedCode, w.Code, w.Body.String())
+			}
+			if tc.obj == nil {
+				return
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), tc.obj); err != nil {
+				t.Fatalf("cannot decode response: %v", err)
+			}
+			if diff := cmp.Diff(tc.expected, tc.obj); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	h := newHandler(&resourcepb.UnimplementedResourceServer{}, &fakeDiscovery{
+		apiResources: []*discoverypb.APIResource{
+			{Resource: "nodes", Group: "example.com", Version: "v1alpha1", Kind: "Node", Namespaced: true},
+			{Resource: "links", Group: "example.com", Version: "v1alpha1", Kind: "Link"},
+			{Resource: "configs", Group: "choreo.kform.dev", Version: "v1alpha1", Kind: "Config", Namespaced: true},
+		},
+		schemas: map[string]string{
+			"Node":   `{"type":"object","properties":{"spec":{"type":"object","x-kubernetes-preserve-unknown-fields":true}}}`,
+			"Config": `{"type":"object"}`,
+		},
+	})
+
+	nodeSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"spec": map[string]any{"type": "object", "x-kubernetes-preserve-unknown-fields": true},
+		},
+		"x-kubernetes-group-version-kind": []any{
+			map[string]any{"group": "example.com", "version": "v1alpha1", "kind": "Node"},
+		},
+	}
+	configSchema := map[string]any{
+		"type": "object",
+		"x-kubernetes-group-version-kind": []any{
+			map[string]any{"group": "choreo.kform.dev", "version": "v1alpha1", "kind": "Config"},
+		},
+	}
+
+	cases := map[string]struct {
+		path         string
+		expectedCode int
+		field        string
+		expected     any
+	}{
+		"V3Index": {
+			path:         "/branches/dev/openapi/v3",
+			expectedCode: http.StatusOK,
+			field:        "paths",
+			expected: map[string]any{
+				"apis/example.com/v1alpha1":      map[string]any{"serverRelativeURL": "/branches/dev/openapi/v3/apis/example.com/v1alpha1"},
+				"apis/choreo.kform.dev/v1alpha1": map[string]any{"serverRelativeURL": "/branches/dev/openapi/v3/apis/choreo.kform.dev/v1alpha1"},
+			},
+		},
+		"V3GroupVersion": {
+			path:         "/openapi/v3/apis/example.com/v1alpha1",
+			expectedCode: http.StatusOK,
+			field:        "components",
+			expected:     map[string]any{"schemas": map[string]any{"com.example.v1alpha1.Node": nodeSchema}},
+		},
+		"V2": {
+			path:         "/openapi/v2",
+			expectedCode: http.StatusOK,
+			field:        "definitions",
+			expected: map[string]any{
+				"com.example.v1alpha1.Node":        nodeSchema,
+				"dev.kform.choreo.v1alpha1.Config": configSchema,
+			},
+		},
+		"UnknownGroupVersion": {
+			path:         "/openapi/v3/apis/unknown.com/v1",
+			expectedCode: http.StatusNotFound,
+		},
+		"UnknownPath": {
+			path:         "/openapi/v4",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			if w.Code != tc.expectedCode {
+				t.Fatalf("expected code %d, got %d: %s", tc.expectedCode, w.Code, w.Body.String())
+			}
+			if tc.field == "" {
+				return
+			}
+			doc := map[string]any{}
+			if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+				t.Fatalf("cannot decode response: %v", err)
+			}
+			if diff := cmp.Diff(tc.expected, doc[tc.field]); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/henderiw/logger/log"
+	choreoserver "github.com/kform-dev/choreo/pkg/server/choreo"
+	"github.com/kform-dev/choreo/pkg/server/grpcserver/services/discovery"
+	"github.com/kform-dev/choreo/pkg/server/grpcserver/services/resource"
+)
+
+type Config struct {
+	Name    string
+	Address string
+	Choreo  choreoserver.Choreo
+}
+
+// New returns a http server that exposes the choreo resources with the kubernetes api
+// conventions, such that kubectl and client-go based tools can be used against choreo.
+// The requests are mapped onto the resource and discovery services of the grpc server.
+func New(cfg *Config) *HTTPServer {
+	return &HTTPServer{
+		name:    cfg.Name,
+		address: cfg.Address,
+		server: &http.Server{
+			Handler: newHandler(
+				resource.New(cfg.Choreo),
+				discovery.New(cfg.Choreo),
+			),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+type HTTPServer struct {
+	name    string
+	address string
+	server  *http.Server
+}
+
+func (r *HTTPServer) Run(ctx context.Context) error {
+	log := log.FromContext(ctx).With("name", r.name, "address", r.address)
+
+	l, err := net.Listen("tcp", r.address)
+	if err != nil {
+		return err
+	}
+	r.server.BaseContext = func(net.Listener) context.Context { return ctx }
+
+	go func() {
+		if err := r.server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("http server serve", "error", err)
+		}
+	}()
+	log.Info("http server started")
+
+	<-ctx.Done()
+	log.Info("http server stopped...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return r.server.Shutdown(shutdownCtx)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+// openAPIInfo is the info of the openapi documents served by the http server
+var openAPIInfo = &spec.Info{
+	InfoProps: spec.InfoProps{
+		Title:   "Choreo",
+		Version: "v1.31.0-choreo",
+	},
+}
+
+// openAPIV3Discovery is the index of the openapi v3 documents per group version
+type openAPIV3Discovery struct {
+	Paths map[string]openAPIV3DiscoveryGroupVersion `json:"paths"`
+}
+
+type openAPIV3DiscoveryGroupVersion struct {
+	ServerRelativeURL string `json:"serverRelativeURL"`
+}
+
+// openapi serves the openapi documents build from the crd schemas of the resources
+//
+//	/openapi/v2
+//	/openapi/v3
+//	/openapi/v3/api/<version>
+//	/openapi/v3/apis/<group>/<version>
+func (r *handler) openapi(w http.ResponseWriter, req *http.Request, info *requestInfo) {
+	apiResources, err := r.getAPIResources(req, info)
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	path := info.OpenAPI
+	switch {
+	case len(path) == 1 && path[0] == "v2":
+		schemas, err := r.getSchemas(req, info, apiResources)
+		if err != nil {
+			writeError(w, statusFromError(err))
+			return
+		}
+		definitions := spec.Definitions{}
+		for name, schema := range schemas {
+			definitions[name] = *schema
+		}
+		writeJSON(w, http.StatusOK, &spec.Swagger{
+			SwaggerProps: spec.SwaggerProps{
+				Swagger:     "2.0",
+				Info:        openAPIInfo,
+				Paths:       &spec.Paths{Paths: map[string]spec.PathItem{}},
+				Definitions: definitions,
+			},
+		})
+	case len(path) == 1 && path[0] == "v3":
+		writeJSON(w, http.StatusOK, openAPIV3Index(apiResources, info))
+	case len(path) == 3 && path[0] == "v3" && path[1] == "api":
+		r.openapiV3(w, req, &requestInfo{Branch: info.Branch, Prefix: "api", Version: path[2]}, apiResources)
+	case len(path) == 4 && path[0] == "v3" && path[1] == "apis":
+		r.openapiV3(w, req, &requestInfo{Branch: info.Branch, Prefix: "apis", Group: path[2], Version: path[3]}, apiResources)
+	default:
+		writeError(w, newStatus(http.StatusNotFound, fmt.Sprintf("the server could not find the requested resource %s", req.URL.Path)))
+	}
+}
+
+// openAPIV3Index returns the openapi v3 documents of the group versions of the api resources
+func openAPIV3Index(apiResources []*discoverypb.APIResource, info *requestInfo) *openAPIV3Discovery {
+	prefix := "/openapi/v3/"
+	if info.Branch != "" {
+		prefix = fmt.Sprintf("/%s/%s%s", branchPrefix, info.Branch, prefix)
+	}
+	index := &openAPIV3Discovery{Paths: map[string]openAPIV3DiscoveryGroupVersion{}}
+	for _, apiResource := range apiResources {
+		gvPath := fmt.Sprintf("apis/%s/%s", apiResource.Group, apiResource.Version)
+		if apiResource.Group == "" {
+			gvPath = fmt.Sprintf("api/%s", apiResource.Version)
+		}
+		index.Paths[gvPath] = openAPIV3DiscoveryGroupVersion{ServerRelativeURL: prefix + gvPath}
+	}
+	return index
+}
+
+// openapiV3 serves the openapi v3 document of the group version of the request
+func (r *handler) openapiV3(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResources []*discoverypb.APIResource) {
+	gvResources := []*discoverypb.APIResource{}
+	for _, apiResource := range apiResources {
+		if apiResource.Group == info.Group && apiResource.Version == info.Version {
+			gvResources = append(gvResources, apiResource)
+		}
+	}
+	if len(gvResources) == 0 {
+		writeError(w, newStatus(http.StatusNotFound, fmt.Sprintf("group version %s not found", info.apiVersion())))
+		return
+	}
+	schemas, err := r.getSchemas(req, info, gvResources)
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, &spec3.OpenAPI{
+		Version: "3.0.0",
+		Info:    openAPIInfo,
+		Paths:   &spec3.Paths{Paths: map[string]*spec3.Path{}},
+		Components: &spec3.Components{
+			Schemas: schemas,
+		},
+	})
+}
+
+// getSchemas returns the openapi schemas of the api resources, resources without a
+// schema are skipped. The schemas are named after the reversed group, version and
+// kind and carry the x-kubernetes-group-version-kind extension, such that kubectl
+// can relate them to the resources.
+func (r *handler) getSchemas(req *http.Request, info *requestInfo, apiResources []*discoverypb.APIResource) (map[string]*spec.Schema, error) {
+	schemas := map[string]*spec.Schema{}
+	for _, apiResource := range apiResources {
+		rsp, err := r.discovery.Schema(req.Context(), &discoverypb.Schema_Request{
+			Branch:  info.Branch,
+			Group:   apiResource.Group,
+			Version: apiResource.Version,
+			Kind:    apiResource.Kind,
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return nil, err
+		}
+		schema := &spec.Schema{}
+		if err := json.Unmarshal(rsp.Schema, schema); err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid schema of %s: %s", apiResource.Kind, err.Error())
+		}
+		schema.AddExtension("x-kubernetes-group-version-kind", []any{
+			map[string]any{
+				"group":   apiResource.Group,
+				"version": apiResource.Version,
+				"kind":    apiResource.Kind,
+			},
+		})
+		schemas[schemaName(apiResource)] = schema
+	}
+	return schemas, nil
+}
+
+// schemaName returns the name of the schema, e.g. com.example.v1alpha1.Node
+func schemaName(apiResource *discoverypb.APIResource) string {
+	parts := []string{}
+	if apiResource.Group != "" {
+		parts = strings.Split(apiResource.Group, ".")
+		slices.Reverse(parts)
+	}
+	parts = append(parts, apiResource.Version, apiResource.Kind)
+	return strings.Join(parts, ".")
+}
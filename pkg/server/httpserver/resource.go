@@ -0,0 +1,574 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"github.com/kform-dev/choreo/pkg/server/apiserver/rest"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// maxBodySize limits the size of a request body
+const maxBodySize = 64 << 20 // 64MB, aligned with the grpc server
+
+func (r *handler) serveResource(w http.ResponseWriter, req *http.Request, info *requestInfo) {
+	apiResource, err := r.getAPIResource(req, info)
+	if err != nil {
+		writeError(w, newStatus(http.StatusNotFound, err.Error()))
+		return
+	}
+	// the status subresource supports get, update and patch
+	if info.Subresource != "" && (info.Subresource != rest.SubresourceStatus || !apiResource.StatusEnabled ||
+		(req.Method != http.MethodGet && req.Method != http.MethodPut && req.Method != http.MethodPatch)) {
+		writeError(w, newStatus(http.StatusNotFound, fmt.Sprintf("subresource %s of %s not found", info.Subresource, info.Resource)))
+		return
+	}
+	if !apiResource.Namespaced && info.Namespace != "" {
+		writeError(w, newStatus(http.StatusNotFound, fmt.Sprintf("%s is cluster scoped", info.Resource)))
+		return
+	}
+
+	switch {
+	case req.Method == http.MethodGet && info.Name != "":
+		r.get(w, req, info, apiResource)
+	case req.Method == http.MethodGet && isWatch(req):
+		r.watch(w, req, info, apiResource)
+	case req.Method == http.MethodGet:
+		r.list(w, req, info, apiResource)
+	case req.Method == http.MethodPost && info.Name == "":
+		r.create(w, req, info, apiResource)
+	case req.Method == http.MethodPut && info.Name != "":
+		r.update(w, req, info, apiResource)
+	case req.Method == http.MethodPatch && info.Name != "":
+		r.patch(w, req, info, apiResource)
+	case req.Method == http.MethodDelete && info.Name != "":
+		r.delete(w, req, info, apiResource)
+	default:
+		writeError(w, newStatus(http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed on %s", req.Method, req.URL.Path)))
+	}
+}
+
+func isWatch(req *http.Request) bool {
+	watch, _ := strconv.ParseBool(req.URL.Query().Get("watch"))
+	return watch
+}
+
+// newObject returns the object identifying the resource of the request to the resource service
+func newObject(info *requestInfo, apiResource *discoverypb.APIResource) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(info.apiVersion())
+	u.SetKind(apiResource.Kind)
+	u.SetNamespace(info.Namespace)
+	u.SetName(info.Name)
+	return u
+}
+
+func marshal(u *unstructured.Unstructured) ([]byte, error) {
+	return json.Marshal(u.Object)
+}
+
+// readObject reads the object of the request body
+func readObject(req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource) (*unstructured.Unstructured, *metav1.Status) {
+	b, err := io.ReadAll(io.LimitReader(req.Body, maxBodySize))
+	if err != nil {
+		return nil, newStatus(http.StatusBadRequest, err.Error())
+	}
+	return decodeObject(b, info, apiResource)
+}
+
+// decodeObject decodes the json or yaml object, which is validated against the url path
+func decodeObject(b []byte, info *requestInfo, apiResource *discoverypb.APIResource) (*unstructured.Unstructured, *metav1.Status) {
+	// yaml is a superset of json
+	b, err := yaml.YAMLToJSON(b)
+	if err != nil {
+		return nil, newStatus(http.StatusBadRequest, fmt.Sprintf("cannot decode body, err: %v", err))
+	}
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(b, &u.Object); err != nil || u.Object == nil {
+		return nil, newStatus(http.StatusBadRequest, fmt.Sprintf("cannot decode body, err: %v", err))
+	}
+	if u.GetAPIVersion() != info.apiVersion() || u.GetKind() != apiResource.Kind {
+		return nil, newStatus(http.StatusBadRequest, fmt.Sprintf("the body of the request was in an unknown format, expected %s %s, got %s %s",
+			info.apiVersion(), apiResource.Kind, u.GetAPIVersion(), u.GetKind()))
+	}
+	if err := validateObjectMeta(u, info, apiResource); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func validateObjectMeta(u *unstructured.Unstructured, info *requestInfo, apiResource *discoverypb.APIResource) *metav1.Status {
+	if apiResource.Namespaced {
+		if u.GetNamespace() == "" {
+			u.SetNamespace(info.Namespace)
+		}
+		if u.GetNamespace() != info.Namespace {
+			return newStatus(http.StatusBadRequest, fmt.Sprintf("the namespace of the object (%s) does not match the namespace of the request (%s)", u.GetNamespace(), info.Namespace))
+		}
+	}
+	if info.Name != "" && u.GetName() != info.Name {
+		return newStatus(http.StatusBadRequest, fmt.Sprintf("the name of the object (%s) does not match the name of the request (%s)", u.GetName(), info.Name))
+	}
+	return nil
+}
+
+func getDryRun(req *http.Request) []string {
+	return req.URL.Query()["dryRun"]
+}
+
+func (r *handler) get(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource) {
+	b, err := marshal(newObject(info, apiResource))
+	if err != nil {
+		writeError(w, newStatus(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	rsp, err := r.resource.Get(req.Context(), &resourcepb.Get_Request{
+		Object: b,
+		Options: &resourcepb.Get_Options{
+			Branch:           info.Branch,
+			ShowManagedField: true,
+			Origin:           origin,
+		},
+	})
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	writeRaw(w, http.StatusOK, rsp.Object)
+}
+
+func (r *handler) list(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource) {
+	query := req.URL.Query()
+	exprSelector, err := getExprSelector(query.Get("labelSelector"), query.Get("fieldSelector"))
+	if err != nil {
+		writeError(w, newStatus(http.StatusBadRequest, err.Error()))
+		return
+	}
+	var limit int64
+	if l := query.Get("limit"); l != "" {
+		limit, err = strconv.ParseInt(l, 10, 64)
+		if err != nil || limit < 0 {
+			writeError(w, newStatus(http.StatusBadRequest, fmt.Sprintf("invalid limit %s", l)))
+			return
+		}
+	}
+	b, err := marshal(newObject(info, apiResource))
+	if err != nil {
+		writeError(w, newStatus(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	rsp, err := r.resource.List(req.Context(), &resourcepb.List_Request{
+		Object: b,
+		Options: &resourcepb.List_Options{
+			Branch:           info.Branch,
+			ExprSelector:     exprSelector,
+			ShowManagedField: true,
+			Origin:           origin,
+			Namespace:        info.Namespace,
+			Limit:            limit,
+			Continue:         query.Get("continue"),
+		},
+	})
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	writeRaw(w, http.StatusOK, rsp.Object)
+}
+
+func (r *handler) create(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource) {
+	u, st := readObject(req, info, apiResource)
+	if st != nil {
+		writeError(w, st)
+		return
+	}
+	b, err := marshal(u)
+	if err != nil {
+		writeError(w, newStatus(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	rsp, err := r.resource.Create(req.Context(), &resourcepb.Create_Request{
+		Object: b,
+		Options: &resourcepb.Create_Options{
+			Branch: info.Branch,
+			DryRun: getDryRun(req),
+			Origin: origin,
+		},
+	})
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	writeRaw(w, http.StatusCreated, rsp.Object)
+}
+
+func (r *handler) update(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource) {
+	u, st := readObject(req, info, apiResource)
+	if st != nil {
+		writeError(w, st)
+		return
+	}
+	r.updateObject(w, req, info, u)
+}
+
+func (r *handler) updateObject(w http.ResponseWriter, req *http.Request, info *requestInfo, u *unstructured.Unstructured) {
+	b, err := marshal(u)
+	if err != nil {
+		writeError(w, newStatus(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	update := r.resource.Update
+	if info.Subresource == rest.SubresourceStatus {
+		update = r.resource.UpdateStatus
+	}
+	rsp, err := update(req.Context(), &resourcepb.Update_Request{
+		Object: b,
+		Options: &resourcepb.Update_Options{
+			Branch: info.Branch,
+			DryRun: getDryRun(req),
+			Origin: origin,
+		},
+	})
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	writeRaw(w, http.StatusOK, rsp.Object)
+}
+
+func (r *handler) patch(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource) {
+	contentType, _, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		writeError(w, newStatus(http.StatusUnsupportedMediaType, fmt.Sprintf("invalid content type, err: %v", err)))
+		return
+	}
+	patch, err := io.ReadAll(io.LimitReader(req.Body, maxBodySize))
+	if err != nil {
+		writeError(w, newStatus(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	switch types.PatchType(contentType) {
+	case types.ApplyPatchType:
+		r.apply(w, req, info, apiResource, patch)
+	case types.MergePatchType, types.JSONPatchType:
+		// the patch is applied to the current object, the resourceVersion of the current object
+		// ensures the update fails when the object changed in the meantime
+		b, err := marshal(newObject(info, apiResource))
+		if err != nil {
+			writeError(w, newStatus(http.StatusInternalServerError, err.Error()))
+			return
+		}
+		rsp, err := r.resource.Get(req.Context(), &resourcepb.Get_Request{
+			Object: b,
+			Options: &resourcepb.Get_Options{
+				Branch:           info.Branch,
+				ShowManagedField: true,
+				Origin:           origin,
+			},
+		})
+		if err != nil {
+			writeError(w, statusFromError(err))
+			return
+		}
+		patched, err := applyPatch(types.PatchType(contentType), rsp.Object, patch)
+		if err != nil {
+			writeError(w, newStatus(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+		u := &unstructured.Unstructured{}
+		if err := json.Unmarshal(patched, &u.Object); err != nil {
+			writeError(w, newStatus(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+		if st := validateObjectMeta(u, info, apiResource); st != nil {
+			writeError(w, st)
+			return
+		}
+		r.updateObject(w, req, info, u)
+	default:
+		// strategic merge patches are not supported for custom resources
+		writeError(w, newStatus(http.StatusUnsupportedMediaType, fmt.Sprintf("the body of the request was in an unknown format - accepted media types include: %s, %s, %s",
+			types.JSONPatchType, types.MergePatchType, types.ApplyPatchType)))
+	}
+}
+
+func applyPatch(patchType types.PatchType, orig, patch []byte) ([]byte, error) {
+	switch patchType {
+	case types.MergePatchType:
+		return jsonpatch.MergePatch(orig, patch)
+	case types.JSONPatchType:
+		p, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, err
+		}
+		return p.Apply(orig)
+	default:
+		return nil, fmt.Errorf("unsupported patch type %s", patchType)
+	}
+}
+
+func (r *handler) apply(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource, patch []byte) {
+	query := req.URL.Query()
+	fieldManager := query.Get("fieldManager")
+	if fieldManager == "" {
+		writeError(w, newStatus(http.StatusBadRequest, "fieldManager is required for apply requests"))
+		return
+	}
+	force, _ := strconv.ParseBool(query.Get("force"))
+
+	u, st := decodeObject(patch, info, apiResource)
+	if st != nil {
+		writeError(w, st)
+		return
+	}
+	b, err := marshal(u)
+	if err != nil {
+		writeError(w, newStatus(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	apply := r.resource.Apply
+	if info.Subresource == rest.SubresourceStatus {
+		apply = r.resource.ApplyStatus
+	}
+	rsp, err := apply(req.Context(), &resourcepb.Apply_Request{
+		Object: b,
+		Options: &resourcepb.Apply_Options{
+			Branch:       info.Branch,
+			DryRun:       getDryRun(req),
+			FieldManager: fieldManager,
+			Force:        force,
+			Origin:       origin,
+		},
+	})
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	writeRaw(w, http.StatusOK, rsp.Object)
+}
+
+func (r *handler) delete(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource) {
+	query := req.URL.Query()
+	opts := &metav1.DeleteOptions{
+		DryRun: getDryRun(req),
+	}
+	if p := query.Get("propagationPolicy"); p != "" {
+		policy := metav1.DeletionPropagation(p)
+		opts.PropagationPolicy = &policy
+	}
+	// the delete options can also be provided in the body
+	b, err := io.ReadAll(io.LimitReader(req.Body, maxBodySize))
+	if err != nil {
+		writeError(w, newStatus(http.StatusBadRequest, err.Error()))
+		return
+	}
+	if len(strings.TrimSpace(string(b))) > 0 {
+		if err := json.Unmarshal(b, opts); err != nil {
+			writeError(w, newStatus(http.StatusBadRequest, fmt.Sprintf("invalid delete options, err: %v", err)))
+			return
+		}
+	}
+	policy := ""
+	if opts.PropagationPolicy != nil {
+		policy = string(*opts.PropagationPolicy)
+	}
+
+	b, err = marshal(newObject(info, apiResource))
+	if err != nil {
+		writeError(w, newStatus(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	rsp, err := r.resource.Delete(req.Context(), &resourcepb.Delete_Request{
+		Object: b,
+		Options: &resourcepb.Delete_Options{
+			Branch:            info.Branch,
+			DryRun:            opts.DryRun,
+			Origin:            origin,
+			PropagationPolicy: policy,
+		},
+	})
+	if err != nil {
+		writeError(w, statusFromError(err))
+		return
+	}
+	if len(rsp.Object) == 0 {
+		writeError(w, newStatus(http.StatusNotFound, fmt.Sprintf("%s %q not found", info.Resource, info.Name)))
+		return
+	}
+	writeRaw(w, http.StatusOK, rsp.Object)
+}
+
+var watchEventTypes = map[resourcepb.Watch_EventType]string{
+	resourcepb.Watch_ADDED:    "ADDED",
+	resourcepb.Watch_MODIFIED: "MODIFIED",
+	resourcepb.Watch_DELETED:  "DELETED",
+	resourcepb.Watch_BOOKMARK: "BOOKMARK",
+	resourcepb.Watch_ERROR:    "ERROR",
+}
+
+// watchEvent is the kubernetes watch event encoding
+type watchEvent struct {
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+// watchStream adapts the http response to the grpc stream of the resource service watch;
+// every event is written as a json object followed by a newline
+type watchStream struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	m sync.Mutex
+	// closed is set when the handler returns, the response can no longer be written
+	closed  bool
+	flusher http.Flusher
+	encoder *json.Encoder
+}
+
+func (r *watchStream) Context() context.Context { return r.ctx }
+
+func (r *watchStream) Send(rsp *resourcepb.Watch_Response) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.closed {
+		return fmt.Errorf("watch closed")
+	}
+	object := json.RawMessage(rsp.Object)
+	if len(object) == 0 {
+		object = json.RawMessage("{}")
+	}
+	if rsp.ResourceVersion != "" {
+		// a client resumes the watch from the resourceVersion of the last object it received
+		b, err := setResourceVersion(object, rsp.ResourceVersion)
+		if err != nil {
+			return err
+		}
+		object = b
+	}
+	if err := r.encoder.Encode(&watchEvent{
+		Type:   watchEventTypes[rsp.EventType],
+		Object: object,
+	}); err != nil {
+		return err
+	}
+	r.flusher.Flush()
+	return nil
+}
+
+// setResourceVersion sets the resourceVersion of the event in the json object
+func setResourceVersion(b []byte, resourceVersion string) ([]byte, error) {
+	obj := map[string]any{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	if err := unstructured.SetNestedField(obj, resourceVersion, "metadata", "resourceVersion"); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+func (r *watchStream) close() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.closed = true
+}
+
+func (r *handler) watch(w http.ResponseWriter, req *http.Request, info *requestInfo, apiResource *discoverypb.APIResource) {
+	query := req.URL.Query()
+	exprSelector, err := getExprSelector(query.Get("labelSelector"), query.Get("fieldSelector"))
+	if err != nil {
+		writeError(w, newStatus(http.StatusBadRequest, err.Error()))
+		return
+	}
+	allowBookmarks, _ := strconv.ParseBool(query.Get("allowWatchBookmarks"))
+	resourceVersion := query.Get("resourceVersion")
+	if resourceVersion == "0" {
+		// any resourceVersion: start with the current state of the objects
+		resourceVersion = ""
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, newStatus(http.StatusInternalServerError, "streaming is not supported"))
+		return
+	}
+
+	ctx := req.Context()
+	if t := query.Get("timeoutSeconds"); t != "" {
+		timeout, err := strconv.ParseInt(t, 10, 64)
+		if err != nil || timeout < 0 {
+			writeError(w, newStatus(http.StatusBadRequest, fmt.Sprintf("invalid timeoutSeconds %s", t)))
+			return
+		}
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+			defer cancel()
+		}
+	}
+
+	b, err := marshal(newObject(info, apiResource))
+	if err != nil {
+		writeError(w, newStatus(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Transfer-Encoding", "chunked")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	stream := &watchStream{
+		ctx:     ctx,
+		flusher: flusher,
+		encoder: json.NewEncoder(w),
+	}
+	defer stream.close()
+
+	if err := r.resource.Watch(&resourcepb.Watch_Request{
+		Object: b,
+		Options: &resourcepb.Watch_Options{
+			Branch:          info.Branch,
+			ExprSelector:    exprSelector,
+			Origin:          origin,
+			Namespace:       info.Namespace,
+			ResourceVersion: resourceVersion,
+			AllowBookmarks:  allowBookmarks,
+		},
+	}, stream); err != nil {
+		// the http status is already sent, the error is reported as a watch event
+		b, _ := json.Marshal(statusFromError(err))
+		_ = stream.Send(&resourcepb.Watch_Response{Object: b, EventType: resourcepb.Watch_ERROR})
+	}
+}
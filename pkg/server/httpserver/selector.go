@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kform-dev/choreo/pkg/proto/resourcepb"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+var operators = map[selection.Operator]resourcepb.Operator{
+	selection.Equals:       resourcepb.Operator_Equals,
+	selection.DoubleEquals: resourcepb.Operator_DoubleEquals,
+	selection.NotEquals:    resourcepb.Operator_NotEquals,
+	selection.In:           resourcepb.Operator_In,
+	selection.NotIn:        resourcepb.Operator_NotIn,
+	selection.Exists:       resourcepb.Operator_Exists,
+	selection.DoesNotExist: resourcepb.Operator_DoesNotExist,
+	selection.GreaterThan:  resourcepb.Operator_GreaterThan,
+	selection.LessThan:     resourcepb.Operator_LessThan,
+}
+
+// getExprSelector converts the kubernetes label and field selectors into an expression selector;
+// labels are selected with metadata.labels["<key>"], fields with their path (e.g. metadata.name)
+func getExprSelector(labelSelector, fieldSelector string) (*resourcepb.ExpressionSelector, error) {
+	es := &resourcepb.ExpressionSelector{}
+
+	ls, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector, err: %v", err)
+	}
+	reqs, _ := ls.Requirements()
+	for _, req := range reqs {
+		op, ok := operators[req.Operator()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported label selector operator %s", req.Operator())
+		}
+		es.MatchExpressions = append(es.MatchExpressions, &resourcepb.ExpressionSelectorRequirement{
+			Expression: fmt.Sprintf("metadata.labels[%s]", strconv.Quote(req.Key())),
+			Operator:   op,
+			Values:     req.Values().List(),
+		})
+	}
+
+	fs, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field selector, err: %v", err)
+	}
+	for _, req := range fs.Requirements() {
+		op, ok := operators[req.Operator]
+		if !ok {
+			return nil, fmt.Errorf("unsupported field selector operator %s", req.Operator)
+		}
+		es.MatchExpressions = append(es.MatchExpressions, &resourcepb.ExpressionSelectorRequirement{
+			Expression: req.Field,
+			Operator:   op,
+			Values:     []string{req.Value},
+		})
+	}
+	return es, nil
+}
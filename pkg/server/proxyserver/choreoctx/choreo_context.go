@@ -17,6 +17,7 @@ limitations under the License.
 package choreoctx
 
 import (
+	"github.com/kform-dev/choreo/pkg/client/go/auditclient"
 	"github.com/kform-dev/choreo/pkg/client/go/branchclient"
 	"github.com/kform-dev/choreo/pkg/client/go/choreoclient"
 	"github.com/kform-dev/choreo/pkg/client/go/discoveryclient"
@@ -33,4 +34,5 @@ type ChoreoCtx struct {
 	ChoreoClient    choreoclient.ChoreoClient
 	RunnerClient    runnerclient.RunnerClient
 	SnapshotClient  snapshotclient.SnapshotClient
+	AuditClient     auditclient.AuditClient
 }
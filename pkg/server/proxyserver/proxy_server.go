@@ -24,6 +24,7 @@ import (
 
 	"github.com/henderiw/logger/log"
 	"github.com/henderiw/store"
+	"github.com/kform-dev/choreo/pkg/proto/auditpb"
 	"github.com/kform-dev/choreo/pkg/proto/branchpb"
 	"github.com/kform-dev/choreo/pkg/proto/choreopb"
 	"github.com/kform-dev/choreo/pkg/proto/discoverypb"
@@ -32,6 +33,7 @@ import (
 	"github.com/kform-dev/choreo/pkg/proto/snapshotpb"
 	choreohealth "github.com/kform-dev/choreo/pkg/server/health"
 	"github.com/kform-dev/choreo/pkg/server/proxyserver/choreoctx"
+	"github.com/kform-dev/choreo/pkg/server/proxyserver/services/audit"
 	"github.com/kform-dev/choreo/pkg/server/proxyserver/services/branch"
 	"github.com/kform-dev/choreo/pkg/server/proxyserver/services/choreo"
 	"github.com/kform-dev/choreo/pkg/server/proxyserver/services/discovery"
@@ -124,6 +126,10 @@ func (r *ProxyServer) Run(ctx context.Context) error {
 	snapshotServer := snapshot.New(r.store)
 	snapshotpb.RegisterSnapshotServer(r.server, snapshotServer)
 
+	// Register the audit service
+	auditServer := audit.New(r.store)
+	auditpb.RegisterAuditServer(r.server, auditServer)
+
 	go func() {
 		if err := r.server.Serve(l); err != nil {
 			log.Error("grpc server serve", "error", err)
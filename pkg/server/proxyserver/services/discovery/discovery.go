@@ -62,6 +62,15 @@ func (r *proxy) Get(ctx context.Context, req *discoverypb.Get_Request) (*discove
 	return choreoCtx.DiscoveryClient.Get(ctx, req)
 }
 
+func (r *proxy) Schema(ctx context.Context, req *discoverypb.Schema_Request) (*discoverypb.Schema_Response, error) {
+	choreoCtx, err := r.getChoreoCtx(types.NamespacedName{Namespace: req.ProxyNamespace, Name: req.ProxyName})
+	if err != nil {
+		return &discoverypb.Schema_Response{}, err
+	}
+
+	return choreoCtx.DiscoveryClient.Schema(ctx, req)
+}
+
 func (r *proxy) Watch(req *discoverypb.Watch_Request, stream discoverypb.Discovery_WatchServer) error {
 	ctx := stream.Context()
 	log := log.FromContext(ctx)
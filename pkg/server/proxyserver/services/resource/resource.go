@@ -95,6 +95,22 @@ func (r *proxy) Update(ctx context.Context, req *resourcepb.Update_Request) (*re
 	return choreoCtx.ResourceClient.Update(ctx, req)
 }
 
+func (r *proxy) UpdateStatus(ctx context.Context, req *resourcepb.Update_Request) (*resourcepb.Update_Response, error) {
+	choreoCtx, err := r.getChoreoCtx(types.NamespacedName{Namespace: req.Options.ProxyNamespace, Name: req.Options.ProxyName})
+	if err != nil {
+		return &resourcepb.Update_Response{}, err
+	}
+	return choreoCtx.ResourceClient.UpdateStatus(ctx, req)
+}
+
+func (r *proxy) ApplyStatus(ctx context.Context, req *resourcepb.Apply_Request) (*resourcepb.Apply_Response, error) {
+	choreoCtx, err := r.getChoreoCtx(types.NamespacedName{Namespace: req.Options.ProxyNamespace, Name: req.Options.ProxyName})
+	if err != nil {
+		return &resourcepb.Apply_Response{}, err
+	}
+	return choreoCtx.ResourceClient.ApplyStatus(ctx, req)
+}
+
 func (r *proxy) Delete(ctx context.Context, req *resourcepb.Delete_Request) (*resourcepb.Delete_Response, error) {
 	choreoCtx, err := r.getChoreoCtx(types.NamespacedName{Namespace: req.Options.ProxyNamespace, Name: req.Options.ProxyName})
 	if err != nil {
@@ -78,6 +78,14 @@ func (r *proxy) Load(ctx context.Context, req *runnerpb.Load_Request) (*runnerpb
 	return choreoCtx.RunnerClient.Load(ctx, req)
 }
 
+func (r *proxy) Deps(ctx context.Context, req *runnerpb.Deps_Request) (*runnerpb.Deps_Response, error) {
+	choreoCtx, err := r.getChoreoCtx(types.NamespacedName{Namespace: req.Options.ProxyNamespace, Name: req.Options.ProxyName})
+	if err != nil {
+		return &runnerpb.Deps_Response{}, err
+	}
+	return choreoCtx.RunnerClient.Deps(ctx, req)
+}
+
 func (r *proxy) Once(req *runnerpb.Once_Request, stream runnerpb.Runner_OnceServer) error {
 	ctx := stream.Context()
 	log := log.FromContext(ctx)
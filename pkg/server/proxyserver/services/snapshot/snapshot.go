@@ -90,4 +90,20 @@ func (r *proxy) Result(ctx context.Context, req *snapshotpb.Result_Request) (*sn
 	return choreoCtx.SnapshotClient.Result(ctx, req)
 }
 
+func (r *proxy) Tag(ctx context.Context, req *snapshotpb.Tag_Request) (*snapshotpb.Tag_Response, error) {
+	choreoCtx, err := r.getChoreoCtx(types.NamespacedName{Namespace: req.Options.ProxyNamespace, Name: req.Options.ProxyName})
+	if err != nil {
+		return &snapshotpb.Tag_Response{}, err
+	}
+	return choreoCtx.SnapshotClient.Tag(ctx, req)
+}
+
+func (r *proxy) Restore(ctx context.Context, req *snapshotpb.Restore_Request) (*snapshotpb.Restore_Response, error) {
+	choreoCtx, err := r.getChoreoCtx(types.NamespacedName{Namespace: req.Options.ProxyNamespace, Name: req.Options.ProxyName})
+	if err != nil {
+		return &snapshotpb.Restore_Response{}, err
+	}
+	return choreoCtx.SnapshotClient.Restore(ctx, req)
+}
+
 // TODO implement watch
@@ -31,15 +31,30 @@ func getCelEnv(varName string) (*cel.Env, error) {
 }
 
 func GetValue(data map[string]any, celExpression string) (string, bool, error) {
+	expr, err := Compile(celExpression)
+	if err != nil {
+		return "", false, err
+	}
+	return expr.GetValue(data)
+}
+
+// Expression is a compiled cel expression, which can be evaluated repeatedly
+// without the compilation cost, e.g. to maintain an index
+type Expression struct {
+	prog cel.Program
+}
+
+// Compile compiles the cel expression relative to the input data
+func Compile(celExpression string) (*Expression, error) {
 	env, err := getCelEnv("input")
 	if err != nil {
-		return "", false, fmt.Errorf("cel environment initialization failed %s", err.Error())
+		return nil, fmt.Errorf("cel environment initialization failed %s", err.Error())
 	}
 	// we add input to the cell variable
 	expr := fmt.Sprintf("input.%s", celExpression)
 	ast, iss := env.Compile(expr)
 	if iss.Err() != nil {
-		return "", false, fmt.Errorf("compilation failed for expression '%s': %s", celExpression, iss.Err().Error())
+		return nil, fmt.Errorf("compilation failed for expression '%s': %s", celExpression, iss.Err().Error())
 	}
 	//_, err = cel.AstToCheckedExpr(ast)
 	//if err != nil {
@@ -47,10 +62,15 @@ func GetValue(data map[string]any, celExpression string) (string, bool, error) {
 	//}
 	prog, err := env.Program(ast, cel.EvalOptions(cel.OptOptimize))
 	if err != nil {
-		return "", false, fmt.Errorf("cel program creation failed for expression '%s': %s", celExpression, err.Error())
+		return nil, fmt.Errorf("cel program creation failed for expression '%s': %s", celExpression, err.Error())
 	}
+	return &Expression{prog: prog}, nil
+}
+
+// GetValue evaluates the expression against the data and returns the value as a string
+func (r *Expression) GetValue(data map[string]any) (string, bool, error) {
 	vars := map[string]any{"input": data}
-	val, _, err := prog.Eval(vars)
+	val, _, err := r.prog.Eval(vars)
 	if err != nil {
 		return "", false, nil
 	}
@@ -35,6 +35,11 @@ type Selector interface {
 
 	// String returns a human readable string that represents this selector.
 	String() string
+	// Requirements converts this interface into Requirements to expose
+	// more detailed selection information.
+	// If there are querying parameters, it will return converted requirements and selectable=true.
+	// If this selector doesn't want to select anything, it will return selectable=false.
+	Requirements() (requirements Requirements, selectable bool)
 }
 
 // Nothing returns a selector that matches no labels
@@ -47,9 +52,10 @@ var sharedNothingSelector Selector = nothingSelector{}
 
 type nothingSelector struct{}
 
-func (nothingSelector) Matches(map[string]any) bool     { return false }
-func (n nothingSelector) Add(_ ...Requirement) Selector { return n }
-func (n nothingSelector) String() string                { return "" }
+func (nothingSelector) Matches(map[string]any) bool        { return false }
+func (n nothingSelector) Add(_ ...Requirement) Selector    { return n }
+func (n nothingSelector) String() string                   { return "" }
+func (nothingSelector) Requirements() (Requirements, bool) { return nil, false }
 
 // NewSelector returns a nil selector
 func NewSelector() Selector {
@@ -85,6 +91,9 @@ func (s internalSelector) String() string {
 	return strings.Join(reqs, ",")
 }
 
+// Requirements returns the requirements of the selector
+func (s internalSelector) Requirements() (Requirements, bool) { return Requirements(s), true }
+
 func (r internalSelector) Matches(data map[string]any) bool {
 	for i := range r {
 		if matches := r[i].Matches(data); !matches {
@@ -92,39 +92,66 @@ func (inv Inventory) Build(ctx context.Context, client resourceclient.Client, ap
 
 		ul.EachListItem(func(o runtime.Object) error {
 			u, _ := o.(*unstructured.Unstructured)
+			inv.add(u, apiResource.ChoreoAPI)
+			return nil
+		})
+	}
+	inv.link()
+	inv.sortChildren()
+	return nil
+}
 
-			objRef := object.GetObjectRefFromUnstructured(u)
-			if _, exists := inv[objRef]; !exists {
-				inv[objRef] = &treeNode{
-					Children: []*treeNode{},
-				}
-			}
-			inv[objRef].Resource = u
-			inv[objRef].ChoreoAPI = apiResource.ChoreoAPI
+// add inserts the resource in the inventory
+func (inv Inventory) add(u *unstructured.Unstructured, choreoAPI bool) {
+	objRef := object.GetObjectRefFromUnstructured(u)
+	if _, exists := inv[objRef]; !exists {
+		inv[objRef] = &treeNode{
+			Children: []*treeNode{},
+		}
+	}
+	inv[objRef].Resource = u
+	inv[objRef].ChoreoAPI = choreoAPI
+}
 
-			for _, ref := range u.GetOwnerReferences() {
-				ownerObjRef := object.GetObjectRefFromOwnerRef(ref)
-				if _, exists := inv[ownerObjRef]; !exists {
+// link attaches the resources to their owners. Since owner references don't carry
+// a namespace, the owner is looked up in the namespace of the resource first and
+// as a cluster scoped resource otherwise. Owners that are not found get a node
+// without a resource in the namespace of the resource.
+func (inv Inventory) link() {
+	nodes := make([]*treeNode, 0, len(inv))
+	for _, node := range inv {
+		if node.Resource != nil {
+			nodes = append(nodes, node)
+		}
+	}
+	for _, node := range nodes {
+		for _, ref := range node.Resource.GetOwnerReferences() {
+			ownerObjRef := object.GetObjectRefFromOwnerRef(node.Resource.GetNamespace(), ref)
+			if _, exists := inv[ownerObjRef]; !exists {
+				clusterObjRef := object.GetObjectRefFromOwnerRef("", ref)
+				if _, exists := inv[clusterObjRef]; exists {
+					ownerObjRef = clusterObjRef
+				} else {
 					inv[ownerObjRef] = &treeNode{
 						Children: []*treeNode{},
 					}
 				}
-				inv[ownerObjRef].Children = append(inv[ownerObjRef].Children, inv[objRef])
 			}
-
-			return nil
-		})
+			inv[ownerObjRef].Children = append(inv[ownerObjRef].Children, node)
+		}
 	}
+}
 
-	// sort the children
+func (inv Inventory) sortChildren() {
 	for _, node := range inv {
 		sort.Slice(node.Children, func(i, j int) bool {
-			namei := fmt.Sprintf("%s.%s %s", node.Children[i].Resource.GetKind(), node.Children[i].Resource.GetAPIVersion(), node.Children[i].Resource.GetName())
-			namej := fmt.Sprintf("%s.%s %s", node.Children[j].Resource.GetKind(), node.Children[j].Resource.GetAPIVersion(), node.Children[j].Resource.GetName())
-			return strings.ToLower(namei) < strings.ToLower(namej)
+			return strings.ToLower(sortKey(node.Children[i].Resource)) < strings.ToLower(sortKey(node.Children[j].Resource))
 		})
 	}
-	return nil
+}
+
+func sortKey(u *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s.%s %s %s", u.GetKind(), u.GetAPIVersion(), u.GetName(), u.GetNamespace())
 }
 
 func (r Inventory) Print() {
@@ -144,9 +171,7 @@ func (r Inventory) getRoots() []*treeNode {
 		}
 	}
 	sort.Slice(roots, func(i, j int) bool {
-		namei := fmt.Sprintf("%s.%s %s", roots[i].Resource.GetKind(), roots[i].Resource.GetAPIVersion(), roots[i].Resource.GetName())
-		namej := fmt.Sprintf("%s.%s %s", roots[j].Resource.GetKind(), roots[j].Resource.GetAPIVersion(), roots[j].Resource.GetName())
-		return strings.ToLower(namei) < strings.ToLower(namej)
+		return strings.ToLower(sortKey(roots[i].Resource)) < strings.ToLower(sortKey(roots[j].Resource))
 	})
 
 	return roots
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"encoding/json"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// item is the serialized representation of an inventory entry.
+// The tree is not serialized, since it is rebuilt from the owner references.
+type item struct {
+	ChoreoAPI bool                       `json:"choreoAPI,omitempty"`
+	Resource  *unstructured.Unstructured `json:"resource"`
+}
+
+func (inv Inventory) MarshalJSON() ([]byte, error) {
+	items := make([]item, 0, len(inv))
+	for _, node := range inv {
+		// owners that are referenced but not present have no resource
+		if node.Resource == nil {
+			continue
+		}
+		items = append(items, item{
+			ChoreoAPI: node.ChoreoAPI,
+			Resource:  node.Resource,
+		})
+	}
+	// sort to provide a stable output
+	sort.Slice(items, func(i, j int) bool {
+		return objectKey(items[i].Resource) < objectKey(items[j].Resource)
+	})
+	return json.Marshal(items)
+}
+
+func (inv *Inventory) UnmarshalJSON(b []byte) error {
+	items := []item{}
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	if *inv == nil {
+		*inv = Inventory{}
+	}
+	for _, item := range items {
+		if item.Resource == nil {
+			continue
+		}
+		inv.add(item.Resource, item.ChoreoAPI)
+	}
+	inv.link()
+	inv.sortChildren()
+	return nil
+}
+
+func objectKey(u *unstructured.Unstructured) string {
+	return u.GetAPIVersion() + "/" + u.GetKind() + "/" + u.GetNamespace() + "/" + u.GetName()
+}
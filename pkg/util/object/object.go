@@ -351,19 +351,21 @@ func GetObjectRefFromUnstructured(u *unstructured.Unstructured) corev1.ObjectRef
 	return corev1.ObjectReference{
 		APIVersion: u.GetObjectKind().GroupVersionKind().GroupVersion().String(),
 		Kind:       u.GetObjectKind().GroupVersionKind().Kind,
-		//Namespace:  u.GetNamespace(),
-		Name: u.GetName(),
-		UID:  u.GetUID(),
+		Namespace:  u.GetNamespace(),
+		Name:       u.GetName(),
+		UID:        u.GetUID(),
 	}
 }
 
-func GetObjectRefFromOwnerRef(ownref metav1.OwnerReference) corev1.ObjectReference {
+// GetObjectRefFromOwnerRef returns the object reference of the owner; owner references
+// don't carry a namespace, so the namespace is provided by the caller
+func GetObjectRefFromOwnerRef(namespace string, ownref metav1.OwnerReference) corev1.ObjectReference {
 	return corev1.ObjectReference{
 		APIVersion: ownref.APIVersion,
 		Kind:       ownref.Kind,
-		//Namespace:  namespace,
-		Name: ownref.Name,
-		UID:  ownref.UID,
+		Namespace:  namespace,
+		Name:       ownref.Name,
+		UID:        ownref.UID,
 	}
 }
 
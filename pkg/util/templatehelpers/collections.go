@@ -0,0 +1,273 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatehelpers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+func toList(v any) ([]any, error) {
+	switch v := v.(type) {
+	case nil:
+		return []any{}, nil
+	case []any:
+		return v, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected a list, got %T", v)
+	}
+	l := make([]any, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		l = append(l, rv.Index(i).Interface())
+	}
+	return l, nil
+}
+
+func toDict(v any) (map[string]any, error) {
+	switch v := v.(type) {
+	case nil:
+		return map[string]any{}, nil
+	case map[string]any:
+		return v, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf("expected a dict, got %T", v)
+	}
+	d := make(map[string]any, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		d[iter.Key().String()] = iter.Value().Interface()
+	}
+	return d, nil
+}
+
+func list(v ...any) []any { return v }
+
+func first(v any) (any, error) {
+	l, err := toList(v)
+	if err != nil || len(l) == 0 {
+		return nil, err
+	}
+	return l[0], nil
+}
+
+func last(v any) (any, error) {
+	l, err := toList(v)
+	if err != nil || len(l) == 0 {
+		return nil, err
+	}
+	return l[len(l)-1], nil
+}
+
+// appendList returns a new list with the item appended
+func appendList(v any, item any) ([]any, error) {
+	l, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(make([]any, 0, len(l)+1), l...), item), nil
+}
+
+func concat(lists ...any) ([]any, error) {
+	res := []any{}
+	for _, v := range lists {
+		l, err := toList(v)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, l...)
+	}
+	return res, nil
+}
+
+func uniq(v any) ([]any, error) {
+	l, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+	res := []any{}
+	for _, item := range l {
+		if !contain(res, item) {
+			res = append(res, item)
+		}
+	}
+	return res, nil
+}
+
+func has(item any, v any) (bool, error) {
+	l, err := toList(v)
+	if err != nil {
+		return false, err
+	}
+	return contain(l, item), nil
+}
+
+func contain(l []any, item any) bool {
+	for _, v := range l {
+		if reflect.DeepEqual(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortAlpha(v any) ([]string, error) {
+	l, err := toList(v)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(l))
+	for _, item := range l {
+		res = append(res, toString(item))
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// until returns the list of integers from 0 up to but not including count
+func until(count any) ([]int64, error) {
+	n, err := toInt64(count)
+	if err != nil {
+		return nil, err
+	}
+	res := []int64{}
+	for i := int64(0); i < n; i++ {
+		res = append(res, i)
+	}
+	return res, nil
+}
+
+// dict creates a dict from a list of key value pairs
+func dict(kv ...any) (map[string]any, error) {
+	if len(kv)%2 != 0 {
+		return nil, fmt.Errorf("dict expects an even number of arguments, got %d", len(kv))
+	}
+	d := make(map[string]any, len(kv)/2)
+	for i := 0; i < len(kv); i += 2 {
+		d[toString(kv[i])] = kv[i+1]
+	}
+	return d, nil
+}
+
+func get(v any, key string) (any, error) {
+	d, err := toDict(v)
+	if err != nil {
+		return nil, err
+	}
+	return d[key], nil
+}
+
+// set sets the key in the dict and returns the dict
+func set(v any, key string, value any) (map[string]any, error) {
+	d, err := toDict(v)
+	if err != nil {
+		return nil, err
+	}
+	d[key] = value
+	return d, nil
+}
+
+// unset removes the key from the dict and returns the dict
+func unset(v any, key string) (map[string]any, error) {
+	d, err := toDict(v)
+	if err != nil {
+		return nil, err
+	}
+	delete(d, key)
+	return d, nil
+}
+
+func hasKey(v any, key string) (bool, error) {
+	d, err := toDict(v)
+	if err != nil {
+		return false, err
+	}
+	_, ok := d[key]
+	return ok, nil
+}
+
+// keys returns the sorted keys of the dict
+func keys(v any) ([]string, error) {
+	d, err := toDict(v)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(d))
+	for k := range d {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// values returns the values of the dict sorted by key
+func values(v any) ([]any, error) {
+	d, err := toDict(v)
+	if err != nil {
+		return nil, err
+	}
+	ks, _ := keys(d)
+	res := make([]any, 0, len(d))
+	for _, k := range ks {
+		res = append(res, d[k])
+	}
+	return res, nil
+}
+
+// merge returns a new dict with the keys of all dicts; the keys of
+// later dicts override the keys of earlier dicts
+func merge(dicts ...any) (map[string]any, error) {
+	res := map[string]any{}
+	for _, v := range dicts {
+		d, err := toDict(v)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range d {
+			res[k] = v
+		}
+	}
+	return res, nil
+}
+
+// dig safely looks up a nested value, e.g. {{ dig "spec" "name" "default" . }}
+// The arguments are the path, the default value and the dict; the default is
+// returned when any element of the path is not present.
+func dig(args ...any) (any, error) {
+	if len(args) < 3 {
+		return nil, fmt.Errorf("dig expects at least 3 arguments, got %d", len(args))
+	}
+	path := args[:len(args)-2]
+	d := args[len(args)-2]
+	var current any = args[len(args)-1]
+	for _, p := range path {
+		m, err := toDict(current)
+		if err != nil {
+			return d, nil
+		}
+		v, ok := m[toString(p)]
+		if !ok || v == nil {
+			return d, nil
+		}
+		current = v
+	}
+	return current, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatehelpers
+
+import (
+	"text/template"
+)
+
+// FuncMap returns the helper functions that are available to the go templates
+// of the gotemplate reconcilers and the config generators.
+// The functions follow the sprig conventions: the value that is typically
+// piped into the function is the last argument, e.g. {{ .name | default "x" }}
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		// strings
+		"lower":      lower,
+		"upper":      upper,
+		"trim":       trim,
+		"trimPrefix": trimPrefix,
+		"trimSuffix": trimSuffix,
+		"replace":    replace,
+		"contains":   contains,
+		"hasPrefix":  hasPrefix,
+		"hasSuffix":  hasSuffix,
+		"split":      split,
+		"join":       join,
+		"repeat":     repeat,
+		"indent":     indent,
+		"nindent":    nindent,
+		"quote":      quote,
+		"squote":     squote,
+		"toString":   toString,
+		// general
+		"default":  defaultValue,
+		"empty":    empty,
+		"coalesce": coalesce,
+		"ternary":  ternary,
+		"required": required,
+		// math
+		"add": add,
+		"sub": sub,
+		"mul": mul,
+		"div": div,
+		"mod": mod,
+		// lists
+		"list":      list,
+		"first":     first,
+		"last":      last,
+		"append":    appendList,
+		"concat":    concat,
+		"uniq":      uniq,
+		"has":       has,
+		"sortAlpha": sortAlpha,
+		"until":     until,
+		// dicts
+		"dict":   dict,
+		"get":    get,
+		"set":    set,
+		"unset":  unset,
+		"hasKey": hasKey,
+		"keys":   keys,
+		"values": values,
+		"merge":  merge,
+		"dig":    dig,
+		// encoding
+		"toYaml":       toYaml,
+		"fromYaml":     fromYaml,
+		"toJson":       toJson,
+		"toPrettyJson": toPrettyJson,
+		"fromJson":     fromJson,
+		"b64enc":       b64enc,
+		"b64dec":       b64dec,
+		"sha256sum":    sha256sum,
+		// ip/cidr
+		"ipAddress":    ipAddress,
+		"prefixLength": prefixLength,
+		"subnetName":   subnetName,
+		"isIPv4":       isIPv4,
+		"isIPv6":       isIPv6,
+		"cidrNetwork":  cidrNetwork,
+		"cidrHost":     cidrHost,
+		"cidrSubnet":   cidrSubnet,
+		"cidrContains": cidrContains,
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatehelpers
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+)
+
+func TestFuncMap(t *testing.T) {
+	data := map[string]any{
+		"name":   "Eth-1",
+		"prefix": "10.0.0.1/24",
+		"vlans":  []any{int64(10), int64(20), int64(10)},
+		"spec": map[string]any{
+			"mtu": int64(1500),
+		},
+	}
+	cases := map[string]struct {
+		template    string
+		expected    string
+		expectedErr bool
+	}{
+		"Strings": {
+			template: `{{ .name | lower | replace "-" "/" | quote }}`,
+			expected: `"eth/1"`,
+		},
+		"Default": {
+			template: `{{ .description | default "none" }}`,
+			expected: `none`,
+		},
+		"Join": {
+			template: `{{ .vlans | uniq | join "," }}`,
+			expected: `10,20`,
+		},
+		"Dig": {
+			template: `{{ dig "spec" "mtu" 9000 . }} {{ dig "spec" "speed" "auto" . }}`,
+			expected: `1500 auto`,
+		},
+		"Indent": {
+			template: `{{ dict "a" 1 "b" (list "x") | toYaml | nindent 2 }}`,
+			expected: "\n  a: 1\n  b:\n  - x",
+		},
+		"Math": {
+			template: `{{ add .spec.mtu 100 }}`,
+			expected: `1600`,
+		},
+		"CIDR": {
+			template: `{{ cidrNetwork .prefix }} {{ cidrHost 5 .prefix }} {{ cidrSubnet 2 3 .prefix }} {{ prefixLength .prefix }}`,
+			expected: `10.0.0.0/24 10.0.0.5 10.0.0.192/26 24`,
+		},
+		"CIDRv6": {
+			template: `{{ cidrHost 16 "2001:db8::/64" }} {{ cidrContains "2001:db8::/64" "2001:db8::1" }}`,
+			expected: `2001:db8::10 true`,
+		},
+		"Required": {
+			template:    `{{ required "description is required" .description }}`,
+			expectedErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tmpl, err := template.New(name).Funcs(FuncMap()).Parse(tc.template)
+			if err != nil {
+				t.Fatalf("cannot parse template: %v", err)
+			}
+			buf := new(bytes.Buffer)
+			if err := tmpl.Execute(buf, data); err != nil {
+				if !tc.expectedErr {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.expectedErr {
+				t.Errorf("expected an error, got %q", buf.String())
+				return
+			}
+			if buf.String() != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatehelpers
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/henderiw/iputil"
+)
+
+func ipAddress(prefix string) (string, error) {
+	pi, err := iputil.New(prefix)
+	if err != nil {
+		return "", err
+	}
+	return pi.GetIPAddress().String(), nil
+}
+
+func prefixLength(prefix string) (int, error) {
+	pi, err := iputil.New(prefix)
+	if err != nil {
+		return 0, err
+	}
+	return pi.GetPrefixLength().Int(), nil
+}
+
+func subnetName(prefix string) (string, error) {
+	pi, err := iputil.New(prefix)
+	if err != nil {
+		return "", err
+	}
+	return pi.GetSubnetName(), nil
+}
+
+func isIPv4(prefix string) (bool, error) {
+	pi, err := iputil.New(prefix)
+	if err != nil {
+		return false, err
+	}
+	return pi.IsIpv4(), nil
+}
+
+func isIPv6(prefix string) (bool, error) {
+	pi, err := iputil.New(prefix)
+	if err != nil {
+		return false, err
+	}
+	return pi.IsIpv6(), nil
+}
+
+// cidrNetwork returns the network of the prefix, e.g. 10.0.0.1/24 -> 10.0.0.0/24
+func cidrNetwork(prefix string) (string, error) {
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+	return p.Masked().String(), nil
+}
+
+// cidrHost returns the address of the given host number within the prefix,
+// e.g. cidrHost 5 "10.0.0.0/24" -> 10.0.0.5
+func cidrHost(num any, prefix string) (string, error) {
+	n, err := toInt64(num)
+	if err != nil {
+		return "", err
+	}
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+	p = p.Masked()
+	hostBits := p.Addr().BitLen() - p.Bits()
+	if n < 0 || (hostBits < 63 && n >= int64(1)<<hostBits) {
+		return "", fmt.Errorf("host number %d does not fit in prefix %s", n, prefix)
+	}
+	return addToAddr(p.Addr(), big.NewInt(n)).String(), nil
+}
+
+// cidrSubnet returns the subnet with the given number when extending the
+// prefix length with newBits, e.g. cidrSubnet 8 2 "10.0.0.0/16" -> 10.0.2.0/24
+func cidrSubnet(newBits, num any, prefix string) (string, error) {
+	bits, err := toInt64(newBits)
+	if err != nil {
+		return "", err
+	}
+	n, err := toInt64(num)
+	if err != nil {
+		return "", err
+	}
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+	p = p.Masked()
+	newLength := p.Bits() + int(bits)
+	if bits < 0 || newLength > p.Addr().BitLen() {
+		return "", fmt.Errorf("cannot extend prefix %s by %d bits", prefix, bits)
+	}
+	if n < 0 || (bits < 63 && n >= int64(1)<<bits) {
+		return "", fmt.Errorf("subnet number %d does not fit in %d bits", n, bits)
+	}
+	offset := new(big.Int).Lsh(big.NewInt(n), uint(p.Addr().BitLen()-newLength))
+	return netip.PrefixFrom(addToAddr(p.Addr(), offset), newLength).String(), nil
+}
+
+// cidrContains reports if the address or prefix is part of the prefix
+func cidrContains(prefix, ip string) (bool, error) {
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return false, err
+	}
+	if other, err := netip.ParsePrefix(ip); err == nil {
+		return p.Bits() <= other.Bits() && p.Contains(other.Addr()), nil
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false, err
+	}
+	return p.Contains(addr), nil
+}
+
+func addToAddr(addr netip.Addr, n *big.Int) netip.Addr {
+	v := new(big.Int).SetBytes(addr.AsSlice())
+	v.Add(v, n)
+	b := make([]byte, addr.BitLen()/8)
+	v.FillBytes(b)
+	res, _ := netip.AddrFromSlice(b)
+	return res
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatehelpers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+func lower(s any) string { return strings.ToLower(toString(s)) }
+
+func upper(s any) string { return strings.ToUpper(toString(s)) }
+
+func trim(s any) string { return strings.TrimSpace(toString(s)) }
+
+func trimPrefix(prefix string, s any) string { return strings.TrimPrefix(toString(s), prefix) }
+
+func trimSuffix(suffix string, s any) string { return strings.TrimSuffix(toString(s), suffix) }
+
+func replace(old, new string, s any) string { return strings.ReplaceAll(toString(s), old, new) }
+
+func contains(substr string, s any) bool { return strings.Contains(toString(s), substr) }
+
+func hasPrefix(prefix string, s any) bool { return strings.HasPrefix(toString(s), prefix) }
+
+func hasSuffix(suffix string, s any) bool { return strings.HasSuffix(toString(s), suffix) }
+
+func split(sep string, s any) []string { return strings.Split(toString(s), sep) }
+
+func repeat(count int, s any) string { return strings.Repeat(toString(s), count) }
+
+func join(sep string, v any) (string, error) {
+	l, err := toList(v)
+	if err != nil {
+		return "", err
+	}
+	items := make([]string, 0, len(l))
+	for _, item := range l {
+		items = append(items, toString(item))
+	}
+	return strings.Join(items, sep), nil
+}
+
+// indent indents every line of the string with the given amount of spaces
+func indent(spaces int, s any) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(toString(s), "\n", "\n"+pad)
+}
+
+// nindent is like indent but prepends a newline
+func nindent(spaces int, s any) string {
+	return "\n" + indent(spaces, s)
+}
+
+func quote(s any) string { return fmt.Sprintf("%q", toString(s)) }
+
+func squote(s any) string { return "'" + toString(s) + "'" }
+
+func toString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// defaultValue returns the given value unless it is empty
+func defaultValue(d any, given ...any) any {
+	if len(given) == 0 || empty(given[0]) {
+		return d
+	}
+	return given[0]
+}
+
+// empty reports if the value is nil or the zero value of its type
+func empty(v any) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return rv.IsZero()
+	}
+}
+
+// coalesce returns the first non empty value
+func coalesce(v ...any) any {
+	for _, item := range v {
+		if !empty(item) {
+			return item
+		}
+	}
+	return nil
+}
+
+func ternary(vt, vf any, condition bool) any {
+	if condition {
+		return vt
+	}
+	return vf
+}
+
+// required fails the rendering with the message when the value is empty
+func required(msg string, v any) (any, error) {
+	if empty(v) {
+		return nil, fmt.Errorf("%s", msg)
+	}
+	return v, nil
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 Nokia.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testhelper
+
+import (
+	choreov1alpha1 "github.com/kform-dev/choreo/apis/choreo/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewReconciler returns a reconciler for the kind that owns and watches the given kinds,
+// all kinds are part of the example.com/v1alpha1 group version
+func NewReconciler(name, forKind string, owns, watches []string) *choreov1alpha1.Reconciler {
+	resource := func(kind string) choreov1alpha1.ReconcilerResource {
+		return choreov1alpha1.ReconcilerResource{
+			ResourceGVK: choreov1alpha1.ResourceGVK{Group: "example.com", Version: "v1alpha1", Kind: kind},
+		}
+	}
+	r := &choreov1alpha1.Reconciler{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: choreov1alpha1.ReconcilerSpec{
+			For: resource(forKind),
+		},
+	}
+	for _, kind := range owns {
+		own := resource(kind)
+		r.Spec.Owns = append(r.Spec.Owns, &own)
+	}
+	for _, kind := range watches {
+		watch := resource(kind)
+		r.Spec.Watches = append(r.Spec.Watches, &watch)
+	}
+	return r
+}